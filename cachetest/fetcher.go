@@ -0,0 +1,102 @@
+// Package cachetest provides utilities for hermetic tests against the
+// download cache: a Fetcher that serves canned pages instead of rendering
+// them, an in-process gRPC server to run it behind, and an Origin website
+// for integration tests that render real pages.
+package cachetest
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	pb "downloadcache/pb"
+	"downloadcache/server"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Fetcher is a server.Fetcher that serves canned pages from a map or a
+// directory, without a browser or network access.  Fetching a URL it has no
+// page for fails with NotFound.  It is safe for concurrent use.
+type Fetcher struct {
+	dir string // Optional; consulted for URLs not in pages
+
+	mu      sync.Mutex
+	pages   map[string]string
+	errs    map[string]error
+	fetches map[string]int
+}
+
+// NewFetcher returns a Fetcher serving pages, a map from URL to HTML.
+func NewFetcher(pages map[string]string) *Fetcher {
+	f := &Fetcher{pages: make(map[string]string), errs: make(map[string]error), fetches: make(map[string]int)}
+	for u, content := range pages {
+		f.pages[u] = content
+	}
+	return f
+}
+
+// NewDirFetcher returns a Fetcher serving the files in dir.  Each file holds
+// the HTML for one URL and is named with the path-escaped URL
+// (url.PathEscape), e.g. "https:%2F%2Fexample.com%2F".
+func NewDirFetcher(dir string) *Fetcher {
+	f := NewFetcher(nil)
+	f.dir = dir
+	return f
+}
+
+// Set adds or replaces the page for a URL.
+func (f *Fetcher) Set(rawURL, content string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pages[rawURL] = content
+	delete(f.errs, rawURL)
+}
+
+// SetError makes fetching a URL fail with err, e.g. a status error with
+// codes.Unavailable, until Set gives it a page.
+func (f *Fetcher) SetError(rawURL string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.errs[rawURL] = err
+}
+
+// Fetches returns how many times a URL has been fetched, which is how a test
+// tells a cache hit from a miss.
+func (f *Fetcher) Fetches(rawURL string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.fetches[rawURL]
+}
+
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*server.FetchResult, error) {
+	f.mu.Lock()
+	f.fetches[rawURL]++
+	content, ok := f.pages[rawURL]
+	err := f.errs[rawURL]
+	f.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	if !ok && f.dir != "" {
+		data, err := os.ReadFile(filepath.Join(f.dir, url.PathEscape(rawURL)))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, status.Errorf(codes.Internal, "failed to read canned page for %s: %v", rawURL, err)
+		}
+		content, ok = string(data), err == nil
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no canned page for %s", rawURL)
+	}
+	return &server.FetchResult{
+		Content:       []byte(content),
+		RedirectChain: []server.RedirectHop{{URL: rawURL, StatusCode: http.StatusOK}},
+	}, nil
+}
@@ -0,0 +1,172 @@
+package cachetest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// JSMarker is the text the origin's /js page renders with JavaScript.
+	// It isn't in the page's source, so finding it in a fetched page shows
+	// the page was rendered.
+	JSMarker = "Rendered by JavaScript"
+	// GzipBombSize is the size the origin's /gzip-bomb page decompresses
+	// to.
+	GzipBombSize = 1 << 30
+
+	maxOriginRedirects = 20
+	maxOriginDelay     = 5 * time.Minute
+)
+
+// Origin is a website to fetch in integration tests, with the kinds of
+// pages that exercise a renderer, so tests don't depend on the public
+// internet:
+//
+//   - /static: a plain page.
+//   - /js: a page whose content is written by a script (see JSMarker).
+//   - /redirect/N: redirects N times, then lands on /static.
+//   - /slow?delay=D: waits for the duration D (e.g. "10s") before answering.
+//   - /gzip-bomb: a small gzip-encoded response that decompresses to
+//     GzipBombSize bytes.
+//
+// / links to each of them.  It is safe for concurrent use.
+type Origin struct {
+	URL string // The origin's base URL, e.g. "http://127.0.0.1:34567"
+
+	server  *httptest.Server
+	handler *originHandler
+}
+
+// NewOrigin starts an origin on a local port.  Call Close when done.
+func NewOrigin() *Origin {
+	h := newOriginHandler()
+	srv := httptest.NewServer(h)
+	return &Origin{URL: srv.URL, server: srv, handler: h}
+}
+
+// NewOriginHandler returns the handler behind an Origin, to serve it on a
+// port of your choosing, as cmd/mockorigin does.
+func NewOriginHandler() http.Handler {
+	return newOriginHandler()
+}
+
+// Requests returns how many times a path has been requested.
+func (o *Origin) Requests(path string) int {
+	return o.handler.requests(path)
+}
+
+// Close shuts the origin down.
+func (o *Origin) Close() {
+	o.server.Close()
+}
+
+type originHandler struct {
+	mux *http.ServeMux
+
+	mu    sync.Mutex
+	count map[string]int
+
+	bombOnce sync.Once
+	bomb     []byte // The gzipped /gzip-bomb body, built on first use
+}
+
+func newOriginHandler() *originHandler {
+	h := &originHandler{mux: http.NewServeMux(), count: make(map[string]int)}
+	h.mux.HandleFunc("GET /{$}", h.index)
+	h.mux.HandleFunc("GET /static", h.static)
+	h.mux.HandleFunc("GET /js", h.js)
+	h.mux.HandleFunc("GET /redirect/{n}", h.redirect)
+	h.mux.HandleFunc("GET /slow", h.slow)
+	h.mux.HandleFunc("GET /gzip-bomb", h.gzipBomb)
+	return h
+}
+
+func (h *originHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	h.count[r.URL.Path]++
+	h.mu.Unlock()
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *originHandler) requests(path string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count[path]
+}
+
+func writeHTML(w http.ResponseWriter, title, body string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintf(w, "<!DOCTYPE html><html><head><title>%s</title></head><body>%s</body></html>", title, body)
+}
+
+func (h *originHandler) index(w http.ResponseWriter, r *http.Request) {
+	writeHTML(w, "Mock origin", `<ul>`+
+		`<li><a href="/static">Static page</a></li>`+
+		`<li><a href="/js">JavaScript-rendered page</a></li>`+
+		`<li><a href="/redirect/3">Redirects</a></li>`+
+		`<li><a href="/slow?delay=5s">Slow page</a></li>`+
+		`<li><a href="/gzip-bomb">Gzip bomb</a></li>`+
+		`</ul>`)
+}
+
+func (h *originHandler) static(w http.ResponseWriter, r *http.Request) {
+	writeHTML(w, "Static page", `<h1>Static page</h1><p>This page needs no JavaScript.</p><a href="/">Home</a>`)
+}
+
+func (h *originHandler) js(w http.ResponseWriter, r *http.Request) {
+	words := strings.Fields(JSMarker)
+	writeHTML(w, "JavaScript-rendered page", `<div id="content">Loading...</div><script>`+
+		fmt.Sprintf(`document.getElementById("content").textContent = ["%s"].join(" ");`, strings.Join(words, `", "`))+
+		`</script>`)
+}
+
+func (h *originHandler) redirect(w http.ResponseWriter, r *http.Request) {
+	n, err := strconv.Atoi(r.PathValue("n"))
+	if err != nil || n < 1 || n > maxOriginRedirects {
+		http.Error(w, fmt.Sprintf("the number of redirects must be between 1 and %d", maxOriginRedirects), http.StatusBadRequest)
+		return
+	}
+	if n == 1 {
+		http.Redirect(w, r, "/static", http.StatusFound)
+		return
+	}
+	http.Redirect(w, r, fmt.Sprintf("/redirect/%d", n-1), http.StatusFound)
+}
+
+func (h *originHandler) slow(w http.ResponseWriter, r *http.Request) {
+	delay, err := time.ParseDuration(r.URL.Query().Get("delay"))
+	if err != nil || delay < 0 || delay > maxOriginDelay {
+		http.Error(w, fmt.Sprintf("delay must be a duration up to %v", maxOriginDelay), http.StatusBadRequest)
+		return
+	}
+	select {
+	case <-time.After(delay):
+	case <-r.Context().Done():
+		return
+	}
+	writeHTML(w, "Slow page", fmt.Sprintf("<p>This page took %v.</p>", delay))
+}
+
+func (h *originHandler) gzipBomb(w http.ResponseWriter, r *http.Request) {
+	h.bombOnce.Do(func() {
+		var b bytes.Buffer
+		zw := gzip.NewWriter(&b)
+		zeros := make([]byte, 1<<20)
+		for i := 0; i < GzipBombSize/len(zeros); i++ {
+			zw.Write(zeros)
+		}
+		zw.Close()
+		h.bomb = b.Bytes()
+	})
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Encoding", "gzip")
+	w.Header().Set("Content-Length", strconv.Itoa(len(h.bomb)))
+	w.Write(h.bomb)
+}
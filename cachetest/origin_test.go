@@ -0,0 +1,145 @@
+package cachetest
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestOrigin(t *testing.T) *Origin {
+	t.Helper()
+	o := NewOrigin()
+	t.Cleanup(o.Close)
+	return o
+}
+
+func TestOriginRedirects(t *testing.T) {
+	o := newTestOrigin(t)
+	for _, n := range []int{1, 3, maxOriginRedirects} {
+		var hops int
+		client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			hops = len(via)
+			return nil
+		}}
+		resp, err := client.Get(fmt.Sprintf("%s/redirect/%d", o.URL, n))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if hops != n || resp.Request.URL.Path != "/static" || resp.StatusCode != http.StatusOK {
+			t.Errorf("/redirect/%d: %d redirects to %s (%s), want %d to /static", n, hops, resp.Request.URL.Path, resp.Status, n)
+		}
+	}
+
+	for _, n := range []string{"0", "-1", fmt.Sprint(maxOriginRedirects + 1), "x"} {
+		resp, err := http.Get(o.URL + "/redirect/" + n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("/redirect/%s: %s, want 400", n, resp.Status)
+		}
+	}
+}
+
+func TestOriginSlowCanceled(t *testing.T) {
+	o := newTestOrigin(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.URL+"/slow?delay=1m", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	_, err = http.DefaultClient.Do(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("request: %v, want the deadline to be exceeded", err)
+	}
+	// The handler gives up when the request is canceled, so closing the
+	// origin doesn't wait out the delay.
+	o.Close()
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("the slow page held the origin open for %v after it was canceled", elapsed)
+	}
+}
+
+func TestOriginSlowDelay(t *testing.T) {
+	o := newTestOrigin(t)
+	start := time.Now()
+	resp, err := http.Get(o.URL + "/slow?delay=50ms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if elapsed := time.Since(start); resp.StatusCode != http.StatusOK || elapsed < 50*time.Millisecond {
+		t.Errorf("/slow?delay=50ms: %s after %v, want 200 after at least 50ms", resp.Status, elapsed)
+	}
+	resp, err = http.Get(o.URL + "/slow?delay=1h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("/slow?delay=1h: %s, want 400", resp.Status)
+	}
+}
+
+func TestOriginGzipBomb(t *testing.T) {
+	if testing.Short() {
+		t.Skip("decompresses a gigabyte")
+	}
+	o := newTestOrigin(t)
+	// Asking for gzip ourselves keeps the transport from decompressing it.
+	req, err := http.NewRequest(http.MethodGet, o.URL+"/gzip-bomb", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Encoding") != "gzip" || resp.ContentLength <= 0 || resp.ContentLength >= GzipBombSize/100 {
+		t.Errorf("/gzip-bomb: Content-Encoding %q, %d bytes, want a small gzip body", resp.Header.Get("Content-Encoding"), resp.ContentLength)
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := io.Copy(io.Discard, zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != GzipBombSize {
+		t.Errorf("/gzip-bomb decompressed to %d bytes, want %d", n, GzipBombSize)
+	}
+}
+
+func TestOriginRequests(t *testing.T) {
+	o := newTestOrigin(t)
+	for range 3 {
+		resp, err := http.Get(o.URL + "/static")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	resp, err := http.Get(o.URL + "/redirect/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	for path, want := range map[string]int{"/static": 4, "/redirect/2": 1, "/redirect/1": 1, "/js": 0} {
+		if got := o.Requests(path); got != want {
+			t.Errorf("Requests(%s) = %d, want %d", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package cachetest
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	pb "downloadcache/pb"
+	"downloadcache/server"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1 << 20
+
+// Server is a download cache serving gRPC in-process, over an in-memory
+// connection, so tests need no ports, Selenium or writable disk.
+type Server struct {
+	Cache  *server.Server // The server behind Client, for calling it directly
+	Client pb.DownloadCacheClient
+
+	conn   *grpc.ClientConn
+	grpc   *grpc.Server
+	cancel context.CancelFunc
+}
+
+// NewServer starts a cache that fetches pages with f and keeps its entries in
+// memory.  opts are applied after those defaults, so they can replace either.
+// Call Close when done.
+func NewServer(f server.Fetcher, opts ...server.Option) (*Server, error) {
+	defaults := []server.Option{server.WithFetcher(f), server.WithStorage(server.NewMemoryStorage(0))}
+	cache, err := server.NewServer(server.DefaultConfig(), append(defaults, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cache.Start(ctx)
+
+	lis := bufconn.Listen(bufSize)
+	g := grpc.NewServer()
+	pb.RegisterDownloadCacheServer(g, cache)
+	go g.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		g.Stop()
+		cancel()
+		return nil, fmt.Errorf("failed to connect to in-process server: %w", err)
+	}
+	return &Server{
+		Cache:  cache,
+		Client: pb.NewDownloadCacheClient(conn),
+		conn:   conn,
+		grpc:   g,
+		cancel: cancel,
+	}, nil
+}
+
+// Close shuts the server down and closes the client connection.
+func (s *Server) Close() {
+	s.conn.Close()
+	s.grpc.Stop()
+	s.cancel()
+}
@@ -0,0 +1,102 @@
+package cachetest
+
+import (
+	"context"
+	"testing"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testURL's pages are plain text, which minifying leaves alone.
+const testURL = "https://example.com/"
+
+func newTestServer(t *testing.T, f *Fetcher) *Server {
+	t.Helper()
+	s, err := NewServer(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+	return s
+}
+
+func get(t *testing.T, s *Server, req *pb.DownloadCacheRequest) string {
+	t.Helper()
+	resp, err := s.Client.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", req.GetUrl(), err)
+	}
+	return resp.GetPageContents()
+}
+
+func TestMissThenHit(t *testing.T) {
+	f := NewFetcher(map[string]string{testURL: "page v1"})
+	s := newTestServer(t, f)
+
+	for i := range 2 {
+		if got := get(t, s, &pb.DownloadCacheRequest{Url: testURL}); got != "page v1" {
+			t.Errorf("Get #%d = %q, want the canned page", i+1, got)
+		}
+	}
+	if n := f.Fetches(testURL); n != 1 {
+		t.Errorf("fetched %d times, want 1: the second Get should be a hit", n)
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	f := NewFetcher(map[string]string{testURL: "page v1"})
+	s := newTestServer(t, f)
+	get(t, s, &pb.DownloadCacheRequest{Url: testURL})
+
+	f.Set(testURL, "page v2")
+	if got := get(t, s, &pb.DownloadCacheRequest{Url: testURL}); got != "page v1" {
+		t.Errorf("Get = %q, want the cached page", got)
+	}
+	got := get(t, s, &pb.DownloadCacheRequest{Url: testURL, CacheOptions: &pb.CacheOptions{Invalidate: true}})
+	if got != "page v2" {
+		t.Errorf("Get with invalidate = %q, want the new page", got)
+	}
+	if got := get(t, s, &pb.DownloadCacheRequest{Url: testURL}); got != "page v2" {
+		t.Errorf("Get after invalidate = %q, want the new page", got)
+	}
+	if n := f.Fetches(testURL); n != 2 {
+		t.Errorf("fetched %d times, want 2", n)
+	}
+}
+
+func TestCacheOnlyMiss(t *testing.T) {
+	f := NewFetcher(map[string]string{testURL: "page v1"})
+	s := newTestServer(t, f)
+
+	_, err := s.Client.Get(context.Background(), &pb.DownloadCacheRequest{Url: testURL, CacheOptions: &pb.CacheOptions{CacheOnly: true}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Get with cache_only on a miss: %v, want NotFound", err)
+	}
+	if n := f.Fetches(testURL); n != 0 {
+		t.Errorf("fetched %d times, want 0", n)
+	}
+}
+
+func TestCannedError(t *testing.T) {
+	f := NewFetcher(nil)
+	f.SetError(testURL, status.Errorf(codes.Unavailable, "origin down"))
+	s := newTestServer(t, f)
+
+	_, err := s.Client.Get(context.Background(), &pb.DownloadCacheRequest{Url: testURL})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Get: %v, want Unavailable", err)
+	}
+	// Failures aren't cached: once the page is there, it is fetched.
+	f.Set(testURL, "page back")
+	if got := get(t, s, &pb.DownloadCacheRequest{Url: testURL}); got != "page back" {
+		t.Errorf("Get after recovery = %q, want the canned page", got)
+	}
+
+	_, err = s.Client.Get(context.Background(), &pb.DownloadCacheRequest{Url: "https://example.com/missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Get of a URL without a page: %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,24 @@
+// Command mockorigin serves the cachetest origin, a website with
+// JavaScript-rendered, redirecting, slow and gzip-bombed pages, for
+// integration tests without the public internet (see README.md).  It
+// listens on ORIGIN_PORT (default 8080).
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+
+	"downloadcache/cachetest"
+)
+
+func main() {
+	port := os.Getenv("ORIGIN_PORT")
+	if port == "" {
+		port = "8080"
+	}
+	log.Printf("Mock origin listening on port %s", port)
+	if err := http.ListenAndServe(":"+port, cachetest.NewOriginHandler()); err != nil {
+		log.Fatalf("mock origin failed: %v", err)
+	}
+}
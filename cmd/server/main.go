@@ -0,0 +1,107 @@
+// Command server runs the download cache as a gRPC service, configured from
+// the environment (see README.md).
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	pb "downloadcache/pb" // Adjust to your actual go module path
+	"downloadcache/server"
+
+	"google.golang.org/grpc"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	cfg, err := server.LoadConfig()
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := server.RunMigrate(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("migration failed: %v", err)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "--self-test" {
+		srv, err := server.NewServer(cfg)
+		if err != nil {
+			log.Fatalf("failed to create server: %v", err)
+		}
+		var url string
+		if len(os.Args) > 2 {
+			url = os.Args[2]
+		}
+		if err := srv.SelfTestCommand(url); err != nil {
+			log.Fatalf("self-test failed: %v", err)
+		}
+		return
+	}
+
+	// --- Start gRPC Server ---
+	listeners, err := server.Listen(cfg)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	srv, err := server.NewServer(cfg)
+	if err != nil {
+		log.Fatalf("failed to create server: %v", err)
+	}
+	srv.Start(context.Background())
+	// SIGQUIT logs where every goroutine is, without stopping the server.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGQUIT)
+	go func() {
+		for range quit {
+			srv.DumpGoroutines("SIGQUIT")
+		}
+	}()
+	grpcServer := grpc.NewServer(srv.ServerOptions()...)
+
+	// The HTTP gateway and metrics bind the same interfaces as gRPC.
+	if cfg.HTTPPort != "" {
+		serveHTTP(cfg, cfg.HTTPPort, "HTTP gateway", srv.GatewayHandler())
+	}
+	if cfg.MetricsPort != "" {
+		serveHTTP(cfg, cfg.MetricsPort, "metrics", srv.MetricsHandler())
+	}
+
+	pb.RegisterDownloadCacheServer(grpcServer, srv)
+	healthgrpc.RegisterHealthServer(grpcServer, srv.HealthServer())
+	// Enable reflection for tools like grpcurl to inspect the service.
+	reflection.Register(grpcServer)
+
+	errs := make(chan error, len(listeners))
+	for _, lis := range listeners {
+		log.Printf("gRPC server listening on %s", lis.Addr())
+		go func() { errs <- grpcServer.Serve(lis) }()
+	}
+	if err := <-errs; err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// serveHTTP serves handler on port in the background, exiting if it fails.
+func serveHTTP(cfg server.Config, port, name string, handler http.Handler) {
+	listeners, err := server.ListenPort(cfg, port)
+	if err != nil {
+		log.Fatalf("failed to listen for %s: %v", name, err)
+	}
+	for _, lis := range listeners {
+		log.Printf("Serving %s on %s", name, lis.Addr())
+		go func() {
+			if err := http.Serve(lis, handler); err != nil {
+				log.Fatalf("%s failed: %v", name, err)
+			}
+		}()
+	}
+}
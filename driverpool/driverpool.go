@@ -0,0 +1,232 @@
+// Package driverpool keeps WebDriver sessions open between page loads, so a
+// fetch doesn't pay for starting a browser every time.  Sessions opened with
+// the same capabilities are interchangeable, and are told apart by a key the
+// caller derives from them.  Each session is lent to one borrower at a time,
+// health-checked before it is lent again, and closed after a number of uses
+// or once it has been idle too long.
+package driverpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/tebeka/selenium"
+)
+
+// healthCheckTimeout bounds how long an idle session has to answer before it
+// is taken to be dead.
+const healthCheckTimeout = 5 * time.Second
+
+// ErrClosed is returned by Get once the pool has been closed.
+var ErrClosed = errors.New("driverpool: pool closed")
+
+// Opener opens a new session.  quit ends the session and frees whatever it
+// holds; it is called with the number of times the session was used.
+type Opener func(ctx context.Context) (wd selenium.WebDriver, url string, quit func(uses int), err error)
+
+// Options configure a Pool.  Zero fields don't apply.
+type Options struct {
+	Size        int           // Sessions open at once, borrowed or idle; at least 1
+	MaxUses     int           // Sessions are closed after this many uses
+	IdleTimeout time.Duration // Idle sessions are closed after this long
+}
+
+// Session is a WebDriver session borrowed from a Pool.
+type Session struct {
+	selenium.WebDriver
+	URL string // The WebDriver endpoint the session is open on
+
+	key       string
+	uses      int
+	idleSince time.Time
+	quit      func(uses int)
+}
+
+// Uses returns how many times the session has been borrowed, counting the
+// current loan.
+func (s *Session) Uses() int {
+	return s.uses
+}
+
+// Pool lends out WebDriver sessions.  It is safe for concurrent use.
+type Pool struct {
+	opts Options
+
+	mu     sync.Mutex
+	idle   []*Session    // Least recently returned first
+	open   int           // Sessions open or being opened, borrowed or idle
+	wake   chan struct{} // Closed and replaced whenever a session is returned or closed
+	closed bool
+	done   chan struct{} // Closed by Close, stopping the idle reaper
+}
+
+// New returns a pool with no sessions open.
+func New(opts Options) *Pool {
+	opts.Size = max(opts.Size, 1)
+	p := &Pool{opts: opts, wake: make(chan struct{}), done: make(chan struct{})}
+	if opts.IdleTimeout > 0 {
+		go p.reapIdle()
+	}
+	return p
+}
+
+// Get lends out an idle session opened under key, or opens one with open if
+// there is none and the pool has room.  If the pool is full, an idle session
+// of another key is closed to make room; if every session is borrowed, Get
+// waits for one to be returned.  Sessions must be returned with Put.
+func (p *Pool) Get(ctx context.Context, key string, open Opener) (*Session, error) {
+	for {
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return nil, ErrClosed
+		}
+		if s := p.takeIdleLocked(key); s != nil {
+			p.mu.Unlock()
+			if !healthy(s) {
+				p.discard(s)
+				continue
+			}
+			s.uses++
+			return s, nil
+		}
+		if p.open < p.opts.Size {
+			p.open++
+			p.mu.Unlock()
+			wd, url, quit, err := open(ctx)
+			if err != nil {
+				p.mu.Lock()
+				p.open--
+				p.broadcastLocked()
+				p.mu.Unlock()
+				return nil, err
+			}
+			return &Session{WebDriver: wd, URL: url, key: key, uses: 1, quit: quit}, nil
+		}
+		if len(p.idle) > 0 {
+			// Make room by closing the session idle longest.
+			s := p.idle[0]
+			p.idle = p.idle[1:]
+			p.mu.Unlock()
+			p.discard(s)
+			continue
+		}
+		wake := p.wake
+		p.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// takeIdleLocked removes and returns the most recently returned idle session
+// opened under key, or nil if there is none.  The caller holds p.mu.
+func (p *Pool) takeIdleLocked(key string) *Session {
+	for i := len(p.idle) - 1; i >= 0; i-- {
+		if s := p.idle[i]; s.key == key {
+			p.idle = append(p.idle[:i], p.idle[i+1:]...)
+			return s
+		}
+	}
+	return nil
+}
+
+// Put returns a borrowed session.  It is kept for the next borrower if it is
+// reusable and hasn't reached MaxUses, and closed otherwise.
+func (p *Pool) Put(s *Session, reusable bool) {
+	p.mu.Lock()
+	if !reusable || p.closed || p.opts.MaxUses > 0 && s.uses >= p.opts.MaxUses {
+		p.mu.Unlock()
+		p.discard(s)
+		return
+	}
+	s.idleSince = time.Now()
+	p.idle = append(p.idle, s)
+	p.broadcastLocked()
+	p.mu.Unlock()
+}
+
+// discard closes a session taken out of the pool, making room for another.
+func (p *Pool) discard(s *Session) {
+	s.quit(s.uses)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.open--
+	p.broadcastLocked()
+}
+
+// broadcastLocked wakes every Get waiting for a session.  The caller holds
+// p.mu.
+func (p *Pool) broadcastLocked() {
+	close(p.wake)
+	p.wake = make(chan struct{})
+}
+
+// reapIdle closes sessions idle longer than IdleTimeout until the pool is
+// closed.
+func (p *Pool) reapIdle() {
+	ticker := time.NewTicker(max(p.opts.IdleTimeout/2, time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+		}
+		cutoff := time.Now().Add(-p.opts.IdleTimeout)
+		p.mu.Lock()
+		var expired []*Session
+		kept := p.idle[:0]
+		for _, s := range p.idle {
+			if s.idleSince.Before(cutoff) {
+				expired = append(expired, s)
+			} else {
+				kept = append(kept, s)
+			}
+		}
+		p.idle = kept
+		p.mu.Unlock()
+		for _, s := range expired {
+			p.discard(s)
+		}
+	}
+}
+
+// Close closes the idle sessions, and borrowed ones as they are returned.
+// Get fails from then on.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.done)
+	idle := p.idle
+	p.idle = nil
+	p.broadcastLocked()
+	p.mu.Unlock()
+	for _, s := range idle {
+		p.discard(s)
+	}
+}
+
+// healthy reports whether an idle session still answers.
+func healthy(s *Session) bool {
+	answered := make(chan error, 1)
+	go func() {
+		_, err := s.CurrentURL()
+		answered <- err
+	}()
+	select {
+	case err := <-answered:
+		return err == nil
+	case <-time.After(healthCheckTimeout):
+		return false
+	}
+}
@@ -0,0 +1,215 @@
+package driverpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tebeka/selenium"
+)
+
+// fakeDriver is a session that only answers health checks, failing them
+// once it is killed.
+type fakeDriver struct {
+	selenium.WebDriver
+	dead atomic.Bool
+}
+
+func (d *fakeDriver) CurrentURL() (string, error) {
+	if d.dead.Load() {
+		return "", errors.New("session is gone")
+	}
+	return "about:blank", nil
+}
+
+// opener opens fake sessions and records which have been quit, and after
+// how many uses.
+type opener struct {
+	mu     sync.Mutex
+	opened []*fakeDriver
+	quits  map[*fakeDriver]int
+}
+
+func (o *opener) open(ctx context.Context) (selenium.WebDriver, string, func(int), error) {
+	d := &fakeDriver{}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.opened = append(o.opened, d)
+	if o.quits == nil {
+		o.quits = make(map[*fakeDriver]int)
+	}
+	return d, "http://driver.invalid", func(uses int) {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		o.quits[d] = uses
+	}, nil
+}
+
+func (o *opener) count() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.opened)
+}
+
+// quitUses returns how many uses a session was quit after, and whether it
+// has been.
+func (o *opener) quitUses(s *Session) (int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	uses, ok := o.quits[s.WebDriver.(*fakeDriver)]
+	return uses, ok
+}
+
+func get(t *testing.T, p *Pool, key string, o *opener) *Session {
+	t.Helper()
+	s, err := p.Get(context.Background(), key, o.open)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestReuse(t *testing.T) {
+	p := New(Options{Size: 2})
+	defer p.Close()
+	o := &opener{}
+
+	s := get(t, p, "a", o)
+	p.Put(s, true)
+	again := get(t, p, "a", o)
+	if again != s || again.Uses() != 2 {
+		t.Errorf("got session with %d uses, want the returned one with 2", again.Uses())
+	}
+	// A session of another key is not lent out.
+	other := get(t, p, "b", o)
+	if other == s {
+		t.Error("lent a session to another key")
+	}
+	p.Put(again, false)
+	if _, ok := o.quitUses(s); !ok {
+		t.Error("a session returned as not reusable was kept")
+	}
+	if opened := o.count(); opened != 2 {
+		t.Errorf("opened %d sessions, want 2", opened)
+	}
+}
+
+func TestMaxUses(t *testing.T) {
+	p := New(Options{Size: 1, MaxUses: 2})
+	defer p.Close()
+	o := &opener{}
+
+	first := get(t, p, "a", o)
+	p.Put(first, true)
+	p.Put(get(t, p, "a", o), true)
+	if uses, ok := o.quitUses(first); !ok || uses != 2 {
+		t.Errorf("session quit: %v after %d uses, want after 2", ok, uses)
+	}
+	if s := get(t, p, "a", o); s == first || s.Uses() != 1 {
+		t.Errorf("got a session with %d uses, want a new one", s.Uses())
+	}
+}
+
+func TestReapIdle(t *testing.T) {
+	p := New(Options{Size: 1, IdleTimeout: 10 * time.Millisecond})
+	defer p.Close()
+	o := &opener{}
+
+	s := get(t, p, "a", o)
+	p.Put(s, true)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := o.quitUses(s); ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("idle session was never reaped")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if get(t, p, "a", o) == s {
+		t.Error("lent out a reaped session")
+	}
+}
+
+func TestUnhealthy(t *testing.T) {
+	p := New(Options{Size: 1})
+	defer p.Close()
+	o := &opener{}
+
+	s := get(t, p, "a", o)
+	p.Put(s, true)
+	s.WebDriver.(*fakeDriver).dead.Store(true)
+	if get(t, p, "a", o) == s {
+		t.Error("lent out a session that failed its health check")
+	}
+	if _, ok := o.quitUses(s); !ok {
+		t.Error("a session that failed its health check wasn't quit")
+	}
+}
+
+func TestFullPool(t *testing.T) {
+	p := New(Options{Size: 1})
+	defer p.Close()
+	o := &opener{}
+
+	// An idle session of another key is closed to make room.
+	a := get(t, p, "a", o)
+	p.Put(a, true)
+	b := get(t, p, "b", o)
+	if _, ok := o.quitUses(a); !ok {
+		t.Error("the idle session wasn't closed to make room")
+	}
+
+	// With every session borrowed, Get waits for one to be returned.
+	got := make(chan *Session)
+	go func() {
+		s, err := p.Get(context.Background(), "b", o.open)
+		if err != nil {
+			t.Error(err)
+		}
+		got <- s
+	}()
+	select {
+	case <-got:
+		t.Fatal("Get didn't wait for a full pool")
+	case <-time.After(20 * time.Millisecond):
+	}
+	p.Put(b, true)
+	if s := <-got; s != b {
+		t.Error("the waiting Get didn't get the returned session")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := p.Get(ctx, "b", o.open); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get on a full pool: %v, want the context's error", err)
+	}
+}
+
+func TestCloseWhileBorrowed(t *testing.T) {
+	p := New(Options{Size: 2})
+	o := &opener{}
+
+	idle := get(t, p, "a", o)
+	borrowed := get(t, p, "a", o)
+	p.Put(idle, true)
+	p.Close()
+	if _, ok := o.quitUses(idle); !ok {
+		t.Error("Close didn't quit the idle session")
+	}
+	if _, ok := o.quitUses(borrowed); ok {
+		t.Error("Close quit a borrowed session")
+	}
+	if _, err := p.Get(context.Background(), "a", o.open); !errors.Is(err, ErrClosed) {
+		t.Errorf("Get after Close: %v, want ErrClosed", err)
+	}
+	p.Put(borrowed, true)
+	if _, ok := o.quitUses(borrowed); !ok {
+		t.Error("a session returned after Close was kept")
+	}
+	p.Close() // Closing twice is harmless.
+}
@@ -9,6 +9,8 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,14 +22,556 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// The request message containing the URL and an invalidation flag.
+// How the browser waits for a page's scripts before capturing it.
+type WaitStrategy int32
+
+const (
+	// Wait render_wait_ms.
+	WaitStrategy_WAIT_STRATEGY_UNSPECIFIED WaitStrategy = 0
+	// Wait until no XHR or fetch request has been in flight for
+	// network_idle_ms, at most render_wait_ms.  Suits single-page apps that
+	// load their content with API calls.
+	WaitStrategy_WAIT_STRATEGY_NETWORK_IDLE WaitStrategy = 1
+)
+
+// Enum value maps for WaitStrategy.
+var (
+	WaitStrategy_name = map[int32]string{
+		0: "WAIT_STRATEGY_UNSPECIFIED",
+		1: "WAIT_STRATEGY_NETWORK_IDLE",
+	}
+	WaitStrategy_value = map[string]int32{
+		"WAIT_STRATEGY_UNSPECIFIED":  0,
+		"WAIT_STRATEGY_NETWORK_IDLE": 1,
+	}
+)
+
+func (x WaitStrategy) Enum() *WaitStrategy {
+	p := new(WaitStrategy)
+	*p = x
+	return p
+}
+
+func (x WaitStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[0].Descriptor()
+}
+
+func (WaitStrategy) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[0]
+}
+
+func (x WaitStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitStrategy.Descriptor instead.
+func (WaitStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{0}
+}
+
+// Network conditions the browser can emulate, as in Chrome's DevTools.
+type NetworkProfile int32
+
+const (
+	// No emulation.
+	NetworkProfile_NETWORK_PROFILE_UNSPECIFIED NetworkProfile = 0
+	// 2s latency, 50 KB/s down and up.
+	NetworkProfile_NETWORK_PROFILE_SLOW_3G NetworkProfile = 1
+	// 563ms latency, 180 KB/s down, 84 KB/s up.
+	NetworkProfile_NETWORK_PROFILE_FAST_3G NetworkProfile = 2
+	// 165ms latency, 1 MB/s down, 169 KB/s up.
+	NetworkProfile_NETWORK_PROFILE_FAST_4G NetworkProfile = 3
+)
+
+// Enum value maps for NetworkProfile.
+var (
+	NetworkProfile_name = map[int32]string{
+		0: "NETWORK_PROFILE_UNSPECIFIED",
+		1: "NETWORK_PROFILE_SLOW_3G",
+		2: "NETWORK_PROFILE_FAST_3G",
+		3: "NETWORK_PROFILE_FAST_4G",
+	}
+	NetworkProfile_value = map[string]int32{
+		"NETWORK_PROFILE_UNSPECIFIED": 0,
+		"NETWORK_PROFILE_SLOW_3G":     1,
+		"NETWORK_PROFILE_FAST_3G":     2,
+		"NETWORK_PROFILE_FAST_4G":     3,
+	}
+)
+
+func (x NetworkProfile) Enum() *NetworkProfile {
+	p := new(NetworkProfile)
+	*p = x
+	return p
+}
+
+func (x NetworkProfile) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NetworkProfile) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[1].Descriptor()
+}
+
+func (NetworkProfile) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[1]
+}
+
+func (x NetworkProfile) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NetworkProfile.Descriptor instead.
+func (NetworkProfile) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{1}
+}
+
+// How a page is fetched: rendered in the browser, or downloaded over plain
+// HTTP as served.
+type RenderMode int32
+
+const (
+	// The browser (or the domain's escalation ladder), falling back to plain
+	// HTTP while no browser session can be opened, unless the fetch needs the
+	// browser.
+	RenderMode_RENDER_MODE_AUTO RenderMode = 0
+	// Always the browser, with no fallback.
+	RenderMode_RENDER_MODE_JS RenderMode = 1
+	// Always plain HTTP, for static pages.  Options only the browser can
+	// honor fail with INVALID_ARGUMENT.
+	RenderMode_RENDER_MODE_STATIC RenderMode = 2
+)
+
+// Enum value maps for RenderMode.
+var (
+	RenderMode_name = map[int32]string{
+		0: "RENDER_MODE_AUTO",
+		1: "RENDER_MODE_JS",
+		2: "RENDER_MODE_STATIC",
+	}
+	RenderMode_value = map[string]int32{
+		"RENDER_MODE_AUTO":   0,
+		"RENDER_MODE_JS":     1,
+		"RENDER_MODE_STATIC": 2,
+	}
+)
+
+func (x RenderMode) Enum() *RenderMode {
+	p := new(RenderMode)
+	*p = x
+	return p
+}
+
+func (x RenderMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RenderMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[2].Descriptor()
+}
+
+func (RenderMode) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[2]
+}
+
+func (x RenderMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RenderMode.Descriptor instead.
+func (RenderMode) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{2}
+}
+
+// Log severities, lowest first.
+type LogLevel int32
+
+const (
+	LogLevel_LOG_LEVEL_UNSPECIFIED LogLevel = 0
+	LogLevel_LOG_LEVEL_INFO        LogLevel = 1
+	LogLevel_LOG_LEVEL_WARNING     LogLevel = 2
+	LogLevel_LOG_LEVEL_ERROR       LogLevel = 3
+)
+
+// Enum value maps for LogLevel.
+var (
+	LogLevel_name = map[int32]string{
+		0: "LOG_LEVEL_UNSPECIFIED",
+		1: "LOG_LEVEL_INFO",
+		2: "LOG_LEVEL_WARNING",
+		3: "LOG_LEVEL_ERROR",
+	}
+	LogLevel_value = map[string]int32{
+		"LOG_LEVEL_UNSPECIFIED": 0,
+		"LOG_LEVEL_INFO":        1,
+		"LOG_LEVEL_WARNING":     2,
+		"LOG_LEVEL_ERROR":       3,
+	}
+)
+
+func (x LogLevel) Enum() *LogLevel {
+	p := new(LogLevel)
+	*p = x
+	return p
+}
+
+func (x LogLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[3].Descriptor()
+}
+
+func (LogLevel) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[3]
+}
+
+func (x LogLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogLevel.Descriptor instead.
+func (LogLevel) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{3}
+}
+
+type CrawlState int32
+
+const (
+	CrawlState_CRAWL_STATE_UNSPECIFIED CrawlState = 0
+	CrawlState_CRAWL_STATE_RUNNING     CrawlState = 1
+	CrawlState_CRAWL_STATE_PAUSED      CrawlState = 2
+	// Nothing left to fetch, or max_pages reached.
+	CrawlState_CRAWL_STATE_DONE CrawlState = 3
+)
+
+// Enum value maps for CrawlState.
+var (
+	CrawlState_name = map[int32]string{
+		0: "CRAWL_STATE_UNSPECIFIED",
+		1: "CRAWL_STATE_RUNNING",
+		2: "CRAWL_STATE_PAUSED",
+		3: "CRAWL_STATE_DONE",
+	}
+	CrawlState_value = map[string]int32{
+		"CRAWL_STATE_UNSPECIFIED": 0,
+		"CRAWL_STATE_RUNNING":     1,
+		"CRAWL_STATE_PAUSED":      2,
+		"CRAWL_STATE_DONE":        3,
+	}
+)
+
+func (x CrawlState) Enum() *CrawlState {
+	p := new(CrawlState)
+	*p = x
+	return p
+}
+
+func (x CrawlState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CrawlState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[4].Descriptor()
+}
+
+func (CrawlState) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[4]
+}
+
+func (x CrawlState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CrawlState.Descriptor instead.
+func (CrawlState) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{4}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// One JSON object per page: url, fetched_at, status, content_type, text
+	// (the page's visible text), duplicate_of, for near-duplicate pages, and
+	// error, for pages that failed.
+	ExportFormat_EXPORT_FORMAT_JSONL ExportFormat = 1
+	// A WARC 1.1 archive with a resource record per page, as cached.
+	// Near-duplicate pages are left out.
+	ExportFormat_EXPORT_FORMAT_WARC ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_JSONL",
+		2: "EXPORT_FORMAT_WARC",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_JSONL":       1,
+		"EXPORT_FORMAT_WARC":        2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[5].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[5]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{5}
+}
+
+type WarmFormat int32
+
+const (
+	WarmFormat_WARM_FORMAT_UNSPECIFIED WarmFormat = 0
+	// One URL per row.  An optional header row names the columns: url, and
+	// optionally invalidate, render_wait_ms and max_redirects.  Without one,
+	// the first column is the URL and the rest are ignored.
+	WarmFormat_WARM_FORMAT_CSV WarmFormat = 1
+	// One DownloadCacheRequest per line, in its JSON form, e.g.
+	// {"url": "https://example.com/", "cache_options": {"invalidate": true}}.
+	// Options set on a line take precedence over the stream's.
+	WarmFormat_WARM_FORMAT_JSONL WarmFormat = 2
+)
+
+// Enum value maps for WarmFormat.
+var (
+	WarmFormat_name = map[int32]string{
+		0: "WARM_FORMAT_UNSPECIFIED",
+		1: "WARM_FORMAT_CSV",
+		2: "WARM_FORMAT_JSONL",
+	}
+	WarmFormat_value = map[string]int32{
+		"WARM_FORMAT_UNSPECIFIED": 0,
+		"WARM_FORMAT_CSV":         1,
+		"WARM_FORMAT_JSONL":       2,
+	}
+)
+
+func (x WarmFormat) Enum() *WarmFormat {
+	p := new(WarmFormat)
+	*p = x
+	return p
+}
+
+func (x WarmFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WarmFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[6].Descriptor()
+}
+
+func (WarmFormat) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[6]
+}
+
+func (x WarmFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WarmFormat.Descriptor instead.
+func (WarmFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{6}
+}
+
+type JobKind int32
+
+const (
+	JobKind_JOB_KIND_UNSPECIFIED JobKind = 0
+	// A page being fetched for Get, or for one of the other jobs.
+	JobKind_JOB_KIND_FETCH JobKind = 1
+	// A WarmFromFile call.
+	JobKind_JOB_KIND_WARM JobKind = 2
+	// A running crawl; its job_id is its crawl_id.
+	JobKind_JOB_KIND_CRAWL JobKind = 3
+	// A RunAdminJob call.
+	JobKind_JOB_KIND_ADMIN JobKind = 4
+	// A RetryFailed call for a batch or warm.
+	JobKind_JOB_KIND_RETRY JobKind = 5
+)
+
+// Enum value maps for JobKind.
+var (
+	JobKind_name = map[int32]string{
+		0: "JOB_KIND_UNSPECIFIED",
+		1: "JOB_KIND_FETCH",
+		2: "JOB_KIND_WARM",
+		3: "JOB_KIND_CRAWL",
+		4: "JOB_KIND_ADMIN",
+		5: "JOB_KIND_RETRY",
+	}
+	JobKind_value = map[string]int32{
+		"JOB_KIND_UNSPECIFIED": 0,
+		"JOB_KIND_FETCH":       1,
+		"JOB_KIND_WARM":        2,
+		"JOB_KIND_CRAWL":       3,
+		"JOB_KIND_ADMIN":       4,
+		"JOB_KIND_RETRY":       5,
+	}
+)
+
+func (x JobKind) Enum() *JobKind {
+	p := new(JobKind)
+	*p = x
+	return p
+}
+
+func (x JobKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[7].Descriptor()
+}
+
+func (JobKind) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[7]
+}
+
+func (x JobKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobKind.Descriptor instead.
+func (JobKind) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{7}
+}
+
+type AdminOperation int32
+
+const (
+	AdminOperation_ADMIN_OPERATION_UNSPECIFIED AdminOperation = 0
+	// Deletes the entries whose URL matches url_pattern, with their aliases
+	// and cold copies.  Held entries are kept.  With TRASH_RETENTION set,
+	// purged copies can be put back with RestoreInvalidated.
+	AdminOperation_ADMIN_OPERATION_PURGE AdminOperation = 1
+	// Rewrites entries stored with another codec than the server's
+	// CACHE_CODEC, or without their domain's compression dictionary.
+	AdminOperation_ADMIN_OPERATION_RECOMPRESS AdminOperation = 2
+	// Moves entries from the layout the cache used before (from_key_scheme
+	// and from_shard_depth) to the server's CACHE_KEY_SCHEME and
+	// CACHE_SHARD_DEPTH.  Until an entry is moved, requests for it miss.
+	AdminOperation_ADMIN_OPERATION_RESHARD AdminOperation = 3
+	// Reads every entry back and checks it against its content hash,
+	// reporting those that can't be read or don't match as failures.
+	AdminOperation_ADMIN_OPERATION_VERIFY AdminOperation = 4
+)
+
+// Enum value maps for AdminOperation.
+var (
+	AdminOperation_name = map[int32]string{
+		0: "ADMIN_OPERATION_UNSPECIFIED",
+		1: "ADMIN_OPERATION_PURGE",
+		2: "ADMIN_OPERATION_RECOMPRESS",
+		3: "ADMIN_OPERATION_RESHARD",
+		4: "ADMIN_OPERATION_VERIFY",
+	}
+	AdminOperation_value = map[string]int32{
+		"ADMIN_OPERATION_UNSPECIFIED": 0,
+		"ADMIN_OPERATION_PURGE":       1,
+		"ADMIN_OPERATION_RECOMPRESS":  2,
+		"ADMIN_OPERATION_RESHARD":     3,
+		"ADMIN_OPERATION_VERIFY":      4,
+	}
+)
+
+func (x AdminOperation) Enum() *AdminOperation {
+	p := new(AdminOperation)
+	*p = x
+	return p
+}
+
+func (x AdminOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdminOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_downloadcache_proto_enumTypes[8].Descriptor()
+}
+
+func (AdminOperation) Type() protoreflect.EnumType {
+	return &file_pb_downloadcache_proto_enumTypes[8]
+}
+
+func (x AdminOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdminOperation.Descriptor instead.
+func (AdminOperation) EnumDescriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{8}
+}
+
+// The request message containing the URL and options.  Unset options take
+// the server defaults.
 type DownloadCacheRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url        string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Invalidate bool   `protobuf:"varint,2,opt,name=invalidate,proto3" json:"invalidate,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Deprecated: use cache_options.invalidate.  Either one invalidates.
+	Invalidate bool `protobuf:"varint,2,opt,name=invalidate,proto3" json:"invalidate,omitempty"`
+	// Rewrites relative links and asset URLs in the returned page to absolute
+	// URLs, so it can be viewed away from its origin.  The cached copy is
+	// unchanged.
+	AbsoluteUrls bool `protobuf:"varint,3,opt,name=absolute_urls,json=absoluteUrls,proto3" json:"absolute_urls,omitempty"`
+	// With absolute_urls, points <a> and <area> links at this prefix followed
+	// by the query-escaped absolute URL (e.g. "http://viewer/page?url="), so
+	// navigation stays within a cache viewer.
+	LinkPrefix   string        `protobuf:"bytes,4,opt,name=link_prefix,json=linkPrefix,proto3" json:"link_prefix,omitempty"`
+	FetchOptions *FetchOptions `protobuf:"bytes,5,opt,name=fetch_options,json=fetchOptions,proto3" json:"fetch_options,omitempty"`
+	CacheOptions *CacheOptions `protobuf:"bytes,6,opt,name=cache_options,json=cacheOptions,proto3" json:"cache_options,omitempty"`
+	// Return a breakdown of where the request spent its time.
+	Debug bool `protobuf:"varint,7,opt,name=debug,proto3" json:"debug,omitempty"`
+	// If set, page_contents is cut to at most this many bytes (at a character
+	// boundary) and truncated is set.  The cached copy is unchanged.
+	MaxResponseBytes int64 `protobuf:"varint,8,opt,name=max_response_bytes,json=maxResponseBytes,proto3" json:"max_response_bytes,omitempty"`
+	// Caches the page separately for this tenant, encrypted with the tenant's
+	// key (see TENANT_KEYS).  Use it for pages fetched with credentials.
+	Tenant string `protobuf:"bytes,9,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// The largest message the client accepts, if not GRPC_MAX_MESSAGE_BYTES.
+	// Larger responses are spilled: see DownloadCacheResponse.spilled.
+	MaxMessageBytes int64 `protobuf:"varint,10,opt,name=max_message_bytes,json=maxMessageBytes,proto3" json:"max_message_bytes,omitempty"`
+	// If the page is fetched (not a cache hit), fetch up to this many of the
+	// pages it links to on the same host in the background, so requests for
+	// them are hits (at most 20).  Links already cached are skipped.
+	PrefetchLinks int32 `protobuf:"varint,11,opt,name=prefetch_links,json=prefetchLinks,proto3" json:"prefetch_links,omitempty"`
+	// Treat a cached copy fetched more than this many seconds ago as a miss,
+	// in place of the server's TTL (CACHE_TTL, or the TTL learned for the
+	// page).  Zero uses the server's TTL.
+	MaxAgeSeconds int64 `protobuf:"varint,12,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	// For GetStream: the most content bytes sent in one message.  Zero uses
+	// the server's STREAM_CHUNK_BYTES.
+	ChunkBytes int32 `protobuf:"varint,13,opt,name=chunk_bytes,json=chunkBytes,proto3" json:"chunk_bytes,omitempty"`
 }
 
 func (x *DownloadCacheRequest) Reset() {
@@ -76,17 +620,159 @@ func (x *DownloadCacheRequest) GetInvalidate() bool {
 	return false
 }
 
-// The response message containing the page contents.
-type DownloadCacheResponse struct {
+func (x *DownloadCacheRequest) GetAbsoluteUrls() bool {
+	if x != nil {
+		return x.AbsoluteUrls
+	}
+	return false
+}
+
+func (x *DownloadCacheRequest) GetLinkPrefix() string {
+	if x != nil {
+		return x.LinkPrefix
+	}
+	return ""
+}
+
+func (x *DownloadCacheRequest) GetFetchOptions() *FetchOptions {
+	if x != nil {
+		return x.FetchOptions
+	}
+	return nil
+}
+
+func (x *DownloadCacheRequest) GetCacheOptions() *CacheOptions {
+	if x != nil {
+		return x.CacheOptions
+	}
+	return nil
+}
+
+func (x *DownloadCacheRequest) GetDebug() bool {
+	if x != nil {
+		return x.Debug
+	}
+	return false
+}
+
+func (x *DownloadCacheRequest) GetMaxResponseBytes() int64 {
+	if x != nil {
+		return x.MaxResponseBytes
+	}
+	return 0
+}
+
+func (x *DownloadCacheRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *DownloadCacheRequest) GetMaxMessageBytes() int64 {
+	if x != nil {
+		return x.MaxMessageBytes
+	}
+	return 0
+}
+
+func (x *DownloadCacheRequest) GetPrefetchLinks() int32 {
+	if x != nil {
+		return x.PrefetchLinks
+	}
+	return 0
+}
+
+func (x *DownloadCacheRequest) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *DownloadCacheRequest) GetChunkBytes() int32 {
+	if x != nil {
+		return x.ChunkBytes
+	}
+	return 0
+}
+
+// Controls how a page is fetched on a cache miss.
+type FetchOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageContents string `protobuf:"bytes,1,opt,name=page_contents,json=pageContents,proto3" json:"page_contents,omitempty"`
+	// How long to let scripts run after the page loads before capturing it
+	// (default 2000, at most 30000).  With WAIT_STRATEGY_NETWORK_IDLE, the
+	// longest to wait for the network to go idle (default 30000).
+	RenderWaitMs *int32 `protobuf:"varint,1,opt,name=render_wait_ms,json=renderWaitMs,proto3,oneof" json:"render_wait_ms,omitempty"`
+	// Reject pages that follow more redirects than this.  Defaults to, and may
+	// not exceed, the server's MAX_REDIRECTS.
+	MaxRedirects *int32 `protobuf:"varint,2,opt,name=max_redirects,json=maxRedirects,proto3,oneof" json:"max_redirects,omitempty"`
+	// Extra Chrome command line switches, e.g. "--lang=de".  Each switch must
+	// be allowed by the server's BROWSER_CONFIG.
+	ChromeArgs []string `protobuf:"bytes,3,rep,name=chrome_args,json=chromeArgs,proto3" json:"chrome_args,omitempty"`
+	// Extra WebDriver capabilities, as JSON values keyed by capability name,
+	// e.g. {"pageLoadStrategy": "\"eager\""}.  Must be allowed by BROWSER_CONFIG.
+	Capabilities map[string]string `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Extra goog:chromeOptions entries (e.g. "prefs", "mobileEmulation"), as
+	// JSON values.  Must be allowed by BROWSER_CONFIG.
+	ChromeOptions map[string]string `protobuf:"bytes,5,rep,name=chrome_options,json=chromeOptions,proto3" json:"chrome_options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Emulates a slower network in the browser, to capture what visitors on
+	// that network receive.
+	NetworkProfile NetworkProfile `protobuf:"varint,6,opt,name=network_profile,json=networkProfile,proto3,enum=downloadcache.NetworkProfile" json:"network_profile,omitempty"`
+	// If the page can't be fetched (the site is down or gone, or answers with
+	// an error status), cache its most recent copy from the web archive
+	// configured with ARCHIVE_URL instead.
+	ArchiveFallback bool `protobuf:"varint,7,opt,name=archive_fallback,json=archiveFallback,proto3" json:"archive_fallback,omitempty"`
+	// Close the cookie consent banners of common consent management
+	// platforms before capturing the page, so they don't cover its content.
+	// Defaults to the page's domain setting in BROWSER_CONFIG, or false.
+	DismissConsent *bool `protobuf:"varint,8,opt,name=dismiss_consent,json=dismissConsent,proto3,oneof" json:"dismiss_consent,omitempty"`
+	// How to decide the page has rendered once it loads.
+	WaitStrategy WaitStrategy `protobuf:"varint,9,opt,name=wait_strategy,json=waitStrategy,proto3,enum=downloadcache.WaitStrategy" json:"wait_strategy,omitempty"`
+	// With WAIT_STRATEGY_NETWORK_IDLE, how long no XHR or fetch request may
+	// be in flight before the page is captured (default 500).
+	NetworkIdleMs *int32 `protobuf:"varint,10,opt,name=network_idle_ms,json=networkIdleMs,proto3,oneof" json:"network_idle_ms,omitempty"`
+	// Inline the rendered content of same-origin iframes into the captured
+	// page, as their srcdoc, for pages whose content is inside a frame.
+	// Defaults to the page's domain setting in BROWSER_CONFIG, or false.
+	InlineIframes *bool `protobuf:"varint,11,opt,name=inline_iframes,json=inlineIframes,proto3,oneof" json:"inline_iframes,omitempty"`
+	// Save open shadow roots in the captured page as declarative shadow roots,
+	// for pages built from web components.  Defaults to the page's domain
+	// setting in BROWSER_CONFIG, or false.
+	SerializeShadowRoots *bool `protobuf:"varint,12,opt,name=serialize_shadow_roots,json=serializeShadowRoots,proto3,oneof" json:"serialize_shadow_roots,omitempty"`
+	// Load the page without running its scripts, to capture the markup the
+	// server sent.  render_wait_ms then defaults to 0.
+	DisableJavascript bool `protobuf:"varint,13,opt,name=disable_javascript,json=disableJavascript,proto3" json:"disable_javascript,omitempty"`
+	// Capture the page's accessibility tree as Chrome computed it, to be read
+	// with GetAccessibilityTree.
+	CaptureAccessibilityTree bool `protobuf:"varint,14,opt,name=capture_accessibility_tree,json=captureAccessibilityTree,proto3" json:"capture_accessibility_tree,omitempty"`
+	// Measure how fast the page loaded (see WebVitals), and keep the
+	// measurements with the cached copy.
+	CollectWebVitals bool `protobuf:"varint,15,opt,name=collect_web_vitals,json=collectWebVitals,proto3" json:"collect_web_vitals,omitempty"`
+	// If the fetch fails once the browser has started loading the page (e.g.
+	// it times out), save what had rendered and a screenshot, to be read with
+	// GetFailureArtifact.
+	CaptureFailureArtifact bool `protobuf:"varint,16,opt,name=capture_failure_artifact,json=captureFailureArtifact,proto3" json:"capture_failure_artifact,omitempty"`
+	// Hide the signs of browser automation that bot detection looks for
+	// (navigator.webdriver, the headless user agent, Chrome's automation
+	// switches).  Also used by the stealth step of a domain's escalation
+	// ladder.
+	Stealth bool `protobuf:"varint,17,opt,name=stealth,proto3" json:"stealth,omitempty"`
+	// The egress pool (see EGRESS_CONFIG) to fetch through.  Empty uses the
+	// pool configured for the page's domain, or the default pool.
+	EgressPool string `protobuf:"bytes,18,opt,name=egress_pool,json=egressPool,proto3" json:"egress_pool,omitempty"`
+	// Whether the page is rendered in the browser.
+	RenderMode RenderMode `protobuf:"varint,19,opt,name=render_mode,json=renderMode,proto3,enum=downloadcache.RenderMode" json:"render_mode,omitempty"`
+	// Render the page in a new browser session rather than one from the
+	// session pool (see SESSION_POOL_SIZE), and close it afterwards.
+	FreshSession bool `protobuf:"varint,20,opt,name=fresh_session,json=freshSession,proto3" json:"fresh_session,omitempty"`
 }
 
-func (x *DownloadCacheResponse) Reset() {
-	*x = DownloadCacheResponse{}
+func (x *FetchOptions) Reset() {
+	*x = FetchOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_downloadcache_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -94,13 +780,13 @@ func (x *DownloadCacheResponse) Reset() {
 	}
 }
 
-func (x *DownloadCacheResponse) String() string {
+func (x *FetchOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadCacheResponse) ProtoMessage() {}
+func (*FetchOptions) ProtoMessage() {}
 
-func (x *DownloadCacheResponse) ProtoReflect() protoreflect.Message {
+func (x *FetchOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_downloadcache_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -112,91 +798,9134 @@ func (x *DownloadCacheResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadCacheResponse.ProtoReflect.Descriptor instead.
-func (*DownloadCacheResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FetchOptions.ProtoReflect.Descriptor instead.
+func (*FetchOptions) Descriptor() ([]byte, []int) {
 	return file_pb_downloadcache_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *DownloadCacheResponse) GetPageContents() string {
-	if x != nil {
-		return x.PageContents
+func (x *FetchOptions) GetRenderWaitMs() int32 {
+	if x != nil && x.RenderWaitMs != nil {
+		return *x.RenderWaitMs
 	}
-	return ""
+	return 0
 }
 
-var File_pb_downloadcache_proto protoreflect.FileDescriptor
+func (x *FetchOptions) GetMaxRedirects() int32 {
+	if x != nil && x.MaxRedirects != nil {
+		return *x.MaxRedirects
+	}
+	return 0
+}
 
-var file_pb_downloadcache_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x22, 0x48, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x22, 0x3c, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x32,
-	0x61, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x12, 0x50, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x79, 0x6f, 0x75, 0x72, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *FetchOptions) GetChromeArgs() []string {
+	if x != nil {
+		return x.ChromeArgs
+	}
+	return nil
 }
 
-var (
-	file_pb_downloadcache_proto_rawDescOnce sync.Once
-	file_pb_downloadcache_proto_rawDescData = file_pb_downloadcache_proto_rawDesc
-)
+func (x *FetchOptions) GetCapabilities() map[string]string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
 
-func file_pb_downloadcache_proto_rawDescGZIP() []byte {
-	file_pb_downloadcache_proto_rawDescOnce.Do(func() {
-		file_pb_downloadcache_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_downloadcache_proto_rawDescData)
-	})
-	return file_pb_downloadcache_proto_rawDescData
+func (x *FetchOptions) GetChromeOptions() map[string]string {
+	if x != nil {
+		return x.ChromeOptions
+	}
+	return nil
 }
 
-var file_pb_downloadcache_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_pb_downloadcache_proto_goTypes = []interface{}{
-	(*DownloadCacheRequest)(nil),  // 0: downloadcache.DownloadCacheRequest
-	(*DownloadCacheResponse)(nil), // 1: downloadcache.DownloadCacheResponse
+func (x *FetchOptions) GetNetworkProfile() NetworkProfile {
+	if x != nil {
+		return x.NetworkProfile
+	}
+	return NetworkProfile_NETWORK_PROFILE_UNSPECIFIED
 }
-var file_pb_downloadcache_proto_depIdxs = []int32{
-	0, // 0: downloadcache.DownloadCache.Get:input_type -> downloadcache.DownloadCacheRequest
-	1, // 1: downloadcache.DownloadCache.Get:output_type -> downloadcache.DownloadCacheResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *FetchOptions) GetArchiveFallback() bool {
+	if x != nil {
+		return x.ArchiveFallback
+	}
+	return false
 }
 
-func init() { file_pb_downloadcache_proto_init() }
-func file_pb_downloadcache_proto_init() {
-	if File_pb_downloadcache_proto != nil {
-		return
+func (x *FetchOptions) GetDismissConsent() bool {
+	if x != nil && x.DismissConsent != nil {
+		return *x.DismissConsent
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pb_downloadcache_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadCacheRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return false
+}
+
+func (x *FetchOptions) GetWaitStrategy() WaitStrategy {
+	if x != nil {
+		return x.WaitStrategy
+	}
+	return WaitStrategy_WAIT_STRATEGY_UNSPECIFIED
+}
+
+func (x *FetchOptions) GetNetworkIdleMs() int32 {
+	if x != nil && x.NetworkIdleMs != nil {
+		return *x.NetworkIdleMs
+	}
+	return 0
+}
+
+func (x *FetchOptions) GetInlineIframes() bool {
+	if x != nil && x.InlineIframes != nil {
+		return *x.InlineIframes
+	}
+	return false
+}
+
+func (x *FetchOptions) GetSerializeShadowRoots() bool {
+	if x != nil && x.SerializeShadowRoots != nil {
+		return *x.SerializeShadowRoots
+	}
+	return false
+}
+
+func (x *FetchOptions) GetDisableJavascript() bool {
+	if x != nil {
+		return x.DisableJavascript
+	}
+	return false
+}
+
+func (x *FetchOptions) GetCaptureAccessibilityTree() bool {
+	if x != nil {
+		return x.CaptureAccessibilityTree
+	}
+	return false
+}
+
+func (x *FetchOptions) GetCollectWebVitals() bool {
+	if x != nil {
+		return x.CollectWebVitals
+	}
+	return false
+}
+
+func (x *FetchOptions) GetCaptureFailureArtifact() bool {
+	if x != nil {
+		return x.CaptureFailureArtifact
+	}
+	return false
+}
+
+func (x *FetchOptions) GetStealth() bool {
+	if x != nil {
+		return x.Stealth
+	}
+	return false
+}
+
+func (x *FetchOptions) GetEgressPool() string {
+	if x != nil {
+		return x.EgressPool
+	}
+	return ""
+}
+
+func (x *FetchOptions) GetRenderMode() RenderMode {
+	if x != nil {
+		return x.RenderMode
+	}
+	return RenderMode_RENDER_MODE_AUTO
+}
+
+func (x *FetchOptions) GetFreshSession() bool {
+	if x != nil {
+		return x.FreshSession
+	}
+	return false
+}
+
+// How fast a page loaded in the browser, measured from the start of its
+// navigation.  Measurements the browser didn't report are unset.
+type WebVitals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time to first byte: until the page's response started arriving.
+	Ttfb *durationpb.Duration `protobuf:"bytes,1,opt,name=ttfb,proto3" json:"ttfb,omitempty"`
+	// First contentful paint: until any text or image was painted.
+	FirstContentfulPaint *durationpb.Duration `protobuf:"bytes,2,opt,name=first_contentful_paint,json=firstContentfulPaint,proto3" json:"first_contentful_paint,omitempty"`
+	// Largest contentful paint: until the largest text block or image was
+	// painted, as of when the page was captured.
+	LargestContentfulPaint *durationpb.Duration `protobuf:"bytes,3,opt,name=largest_contentful_paint,json=largestContentfulPaint,proto3" json:"largest_contentful_paint,omitempty"`
+	// Until the DOMContentLoaded event finished.
+	DomContentLoaded *durationpb.Duration `protobuf:"bytes,4,opt,name=dom_content_loaded,json=domContentLoaded,proto3" json:"dom_content_loaded,omitempty"`
+	// Until the load event finished.
+	Load *durationpb.Duration `protobuf:"bytes,5,opt,name=load,proto3" json:"load,omitempty"`
+	// Cumulative layout shift up to when the page was captured.
+	CumulativeLayoutShift float64 `protobuf:"fixed64,6,opt,name=cumulative_layout_shift,json=cumulativeLayoutShift,proto3" json:"cumulative_layout_shift,omitempty"`
+}
+
+func (x *WebVitals) Reset() {
+	*x = WebVitals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebVitals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebVitals) ProtoMessage() {}
+
+func (x *WebVitals) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_downloadcache_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadCacheResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebVitals.ProtoReflect.Descriptor instead.
+func (*WebVitals) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebVitals) GetTtfb() *durationpb.Duration {
+	if x != nil {
+		return x.Ttfb
+	}
+	return nil
+}
+
+func (x *WebVitals) GetFirstContentfulPaint() *durationpb.Duration {
+	if x != nil {
+		return x.FirstContentfulPaint
+	}
+	return nil
+}
+
+func (x *WebVitals) GetLargestContentfulPaint() *durationpb.Duration {
+	if x != nil {
+		return x.LargestContentfulPaint
+	}
+	return nil
+}
+
+func (x *WebVitals) GetDomContentLoaded() *durationpb.Duration {
+	if x != nil {
+		return x.DomContentLoaded
+	}
+	return nil
+}
+
+func (x *WebVitals) GetLoad() *durationpb.Duration {
+	if x != nil {
+		return x.Load
+	}
+	return nil
+}
+
+func (x *WebVitals) GetCumulativeLayoutShift() float64 {
+	if x != nil {
+		return x.CumulativeLayoutShift
+	}
+	return 0
+}
+
+// Controls how the cache is used.
+type CacheOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fetch the page even if it is cached, replacing the cached copy.
+	Invalidate bool `protobuf:"varint,1,opt,name=invalidate,proto3" json:"invalidate,omitempty"`
+	// Don't write the fetched page to the cache.
+	NoStore bool `protobuf:"varint,2,opt,name=no_store,json=noStore,proto3" json:"no_store,omitempty"`
+	// With invalidate, replace the cached copy even if it is under legal hold.
+	// The new copy stays on hold.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// Attached to the entry when the page is fetched and stored, e.g. the ID
+	// of the pipeline run or task that fetched it, and returned by
+	// GetMetadata and ListEntries.  Cache hits leave the entry's annotations
+	// as they are.  At most 32, with keys of up to 128 bytes and values of up
+	// to 1024.
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Only return the page from the cache: if it isn't cached, or its cached
+	// copy has expired, fail with NOT_FOUND rather than fetching it.
+	CacheOnly bool `protobuf:"varint,5,opt,name=cache_only,json=cacheOnly,proto3" json:"cache_only,omitempty"`
+}
+
+func (x *CacheOptions) Reset() {
+	*x = CacheOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheOptions) ProtoMessage() {}
+
+func (x *CacheOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheOptions.ProtoReflect.Descriptor instead.
+func (*CacheOptions) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CacheOptions) GetInvalidate() bool {
+	if x != nil {
+		return x.Invalidate
+	}
+	return false
+}
+
+func (x *CacheOptions) GetNoStore() bool {
+	if x != nil {
+		return x.NoStore
+	}
+	return false
+}
+
+func (x *CacheOptions) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *CacheOptions) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *CacheOptions) GetCacheOnly() bool {
+	if x != nil {
+		return x.CacheOnly
+	}
+	return false
+}
+
+// The response message containing the page contents.
+type DownloadCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageContents string `protobuf:"bytes,1,opt,name=page_contents,json=pageContents,proto3" json:"page_contents,omitempty"`
+	// The documents the browser loaded on its way to the page, starting with
+	// the requested URL and ending with the captured page.  Empty if unknown.
+	RedirectChain []*RedirectHop `protobuf:"bytes,2,rep,name=redirect_chain,json=redirectChain,proto3" json:"redirect_chain,omitempty"`
+	// Set when the page declared a different rel=canonical URL and the server
+	// stored it under that URL (see CANONICAL_ALIASING).
+	CanonicalUrl string `protobuf:"bytes,3,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`
+	// When the cached copy was fetched.  Unset for entries cached before fetch
+	// times were recorded.
+	FetchedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	// Set if the request asked for debug output.
+	Timing *Timing `protobuf:"bytes,5,opt,name=timing,proto3" json:"timing,omitempty"`
+	// The size of the full page in bytes, whether or not it was truncated.
+	TotalBytes int64 `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// Set if page_contents was cut short by max_response_bytes.
+	Truncated bool `protobuf:"varint,7,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// The Content-Type the page was served with, e.g. "application/json".
+	// Empty if unknown, which is usually HTML.
+	ContentType string `protobuf:"bytes,8,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Set instead of page_contents for documents that aren't text, such as
+	// PDFs or images downloaded directly (see RAW_DOWNLOAD_MAX_BYTES).
+	BinaryContents []byte `protobuf:"bytes,9,opt,name=binary_contents,json=binaryContents,proto3" json:"binary_contents,omitempty"`
+	// Set if the page came from the web archive (see
+	// FetchOptions.archive_fallback): when the archive captured it.
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	// The archive's URL for the copy, if archived_at is set.
+	ArchiveUrl string `protobuf:"bytes,11,opt,name=archive_url,json=archiveUrl,proto3" json:"archive_url,omitempty"`
+	// The hex SHA-256 of the page's full contents as cached, for GetByHash.
+	// Empty for entries cached before hashes were recorded.
+	ContentHash string `protobuf:"bytes,12,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// Set if the response would have been larger than the client accepts
+	// (see max_message_bytes), in which case page_contents and
+	// binary_contents are empty and the contents are read with ReadSpilled.
+	Spilled *SpilledContents `protobuf:"bytes,13,opt,name=spilled,proto3" json:"spilled,omitempty"`
+	// When the copy expires: its fetch time plus the server's TTL, or the TTL
+	// learned for the page (see ADAPTIVE_TTL_MAX).  Requests after then fetch
+	// the page again, so refetching sooner with invalidate is rarely worth
+	// it.  Unset if the copy never expires.
+	RevalidateAfter *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=revalidate_after,json=revalidateAfter,proto3" json:"revalidate_after,omitempty"`
+	// Set if the copy was fetched with collect_web_vitals.
+	WebVitals *WebVitals `protobuf:"bytes,15,opt,name=web_vitals,json=webVitals,proto3" json:"web_vitals,omitempty"`
+	// The TLS connection the page was served over.  Unset if unknown, as for
+	// pages served over plain HTTP.
+	Tls *TLSInfo `protobuf:"bytes,16,opt,name=tls,proto3" json:"tls,omitempty"`
+	// How the copy was fetched.  Unset for entries cached before receipts
+	// were recorded.
+	Receipt *FetchReceipt `protobuf:"bytes,17,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *DownloadCacheResponse) Reset() {
+	*x = DownloadCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCacheResponse) ProtoMessage() {}
+
+func (x *DownloadCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCacheResponse.ProtoReflect.Descriptor instead.
+func (*DownloadCacheResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownloadCacheResponse) GetPageContents() string {
+	if x != nil {
+		return x.PageContents
+	}
+	return ""
+}
+
+func (x *DownloadCacheResponse) GetRedirectChain() []*RedirectHop {
+	if x != nil {
+		return x.RedirectChain
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *DownloadCacheResponse) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetTiming() *Timing {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *DownloadCacheResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *DownloadCacheResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DownloadCacheResponse) GetBinaryContents() []byte {
+	if x != nil {
+		return x.BinaryContents
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetArchiveUrl() string {
+	if x != nil {
+		return x.ArchiveUrl
+	}
+	return ""
+}
+
+func (x *DownloadCacheResponse) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *DownloadCacheResponse) GetSpilled() *SpilledContents {
+	if x != nil {
+		return x.Spilled
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetRevalidateAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevalidateAfter
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetWebVitals() *WebVitals {
+	if x != nil {
+		return x.WebVitals
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetTls() *TLSInfo {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *DownloadCacheResponse) GetReceipt() *FetchReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// How a page was fetched, so that datasets built from the cache can
+// document how each page was obtained.
+type FetchReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// What fetched the page: "browser", "http" (binary documents, and the
+	// http step of escalation ladders), "plugin", "replay" or "archive".
+	// Empty if the fetcher doesn't say.
+	Fetcher string `protobuf:"bytes,1,opt,name=fetcher,proto3" json:"fetcher,omitempty"`
+	// The steps of the domain's escalation ladder that were tried, the last
+	// of them fetching the page.  Empty if the domain has no ladder.
+	Escalation []string `protobuf:"bytes,2,rep,name=escalation,proto3" json:"escalation,omitempty"`
+	// The browser that rendered the page, e.g. "HeadlessChrome" and
+	// "126.0.6478.126".  Empty if the page wasn't rendered.
+	BrowserName    string `protobuf:"bytes,3,opt,name=browser_name,json=browserName,proto3" json:"browser_name,omitempty"`
+	BrowserVersion string `protobuf:"bytes,4,opt,name=browser_version,json=browserVersion,proto3" json:"browser_version,omitempty"`
+	// The local IP address the page was fetched from.  Empty if unknown, as
+	// for pages fetched by the browser.
+	EgressIp string `protobuf:"bytes,5,opt,name=egress_ip,json=egressIp,proto3" json:"egress_ip,omitempty"`
+	// The fetch options in effect, with the domain's and the server's
+	// defaults applied.
+	Options   *FetchOptions          `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// How long the fetch took, and the phases of it the fetcher measured;
+	// the other fields are unset.
+	Timing *Timing `protobuf:"bytes,8,opt,name=timing,proto3" json:"timing,omitempty"`
+	// The egress pool the page was fetched through, and the proxy of the
+	// pool it went through, without credentials.  Empty if it was fetched
+	// directly, or from one of the pool's bind addresses (see egress_ip).
+	EgressPool  string `protobuf:"bytes,9,opt,name=egress_pool,json=egressPool,proto3" json:"egress_pool,omitempty"`
+	EgressProxy string `protobuf:"bytes,10,opt,name=egress_proxy,json=egressProxy,proto3" json:"egress_proxy,omitempty"`
+}
+
+func (x *FetchReceipt) Reset() {
+	*x = FetchReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchReceipt) ProtoMessage() {}
+
+func (x *FetchReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchReceipt.ProtoReflect.Descriptor instead.
+func (*FetchReceipt) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FetchReceipt) GetFetcher() string {
+	if x != nil {
+		return x.Fetcher
+	}
+	return ""
+}
+
+func (x *FetchReceipt) GetEscalation() []string {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+func (x *FetchReceipt) GetBrowserName() string {
+	if x != nil {
+		return x.BrowserName
+	}
+	return ""
+}
+
+func (x *FetchReceipt) GetBrowserVersion() string {
+	if x != nil {
+		return x.BrowserVersion
+	}
+	return ""
+}
+
+func (x *FetchReceipt) GetEgressIp() string {
+	if x != nil {
+		return x.EgressIp
+	}
+	return ""
+}
+
+func (x *FetchReceipt) GetOptions() *FetchOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *FetchReceipt) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *FetchReceipt) GetTiming() *Timing {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *FetchReceipt) GetEgressPool() string {
+	if x != nil {
+		return x.EgressPool
+	}
+	return ""
+}
+
+func (x *FetchReceipt) GetEgressProxy() string {
+	if x != nil {
+		return x.EgressProxy
+	}
+	return ""
+}
+
+// A TLS connection.
+type TLSInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. "TLS 1.3".
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Cipher   string `protobuf:"bytes,2,opt,name=cipher,proto3" json:"cipher,omitempty"`
+	// The server's certificate, then the chain it was verified with, as far
+	// as it is known.
+	Certificates []*Certificate `protobuf:"bytes,3,rep,name=certificates,proto3" json:"certificates,omitempty"`
+}
+
+func (x *TLSInfo) Reset() {
+	*x = TLSInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSInfo) ProtoMessage() {}
+
+func (x *TLSInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSInfo.ProtoReflect.Descriptor instead.
+func (*TLSInfo) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TLSInfo) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *TLSInfo) GetCipher() string {
+	if x != nil {
+		return x.Cipher
+	}
+	return ""
+}
+
+func (x *TLSInfo) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+// An X.509 certificate.
+type Certificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The common names of the subject and issuer, or their full names if
+	// they have none.
+	Subject   string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer    string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// The subject alternative names.
+	DnsNames []string `protobuf:"bytes,5,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Certificate) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Certificate) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Certificate) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *Certificate) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *Certificate) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+// Where to read the contents of a response that was too large to send.
+type SpilledContents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpillId string `protobuf:"bytes,1,opt,name=spill_id,json=spillId,proto3" json:"spill_id,omitempty"`
+	// The size of the contents in bytes.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// The contents can't be read after this.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *SpilledContents) Reset() {
+	*x = SpilledContents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpilledContents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpilledContents) ProtoMessage() {}
+
+func (x *SpilledContents) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpilledContents.ProtoReflect.Descriptor instead.
+func (*SpilledContents) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SpilledContents) GetSpillId() string {
+	if x != nil {
+		return x.SpillId
+	}
+	return ""
+}
+
+func (x *SpilledContents) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SpilledContents) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// The request message for spilled contents.
+type ReadSpilledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpillId string `protobuf:"bytes,1,opt,name=spill_id,json=spillId,proto3" json:"spill_id,omitempty"`
+	// The tenant of the request that spilled them.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *ReadSpilledRequest) Reset() {
+	*x = ReadSpilledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSpilledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSpilledRequest) ProtoMessage() {}
+
+func (x *ReadSpilledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSpilledRequest.ProtoReflect.Descriptor instead.
+func (*ReadSpilledRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReadSpilledRequest) GetSpillId() string {
+	if x != nil {
+		return x.SpillId
+	}
+	return ""
+}
+
+func (x *ReadSpilledRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type GetBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most 1000.
+	Urls []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Options for every URL, as in DownloadCacheRequest.
+	Tenant       string        `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	FetchOptions *FetchOptions `protobuf:"bytes,3,opt,name=fetch_options,json=fetchOptions,proto3" json:"fetch_options,omitempty"`
+	CacheOptions *CacheOptions `protobuf:"bytes,4,opt,name=cache_options,json=cacheOptions,proto3" json:"cache_options,omitempty"`
+	// How many URLs to get at once.  Zero means the server's
+	// BATCH_CONCURRENCY, which is also the most allowed.
+	Concurrency int32 `protobuf:"varint,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// As in DownloadCacheRequest, for each URL's response.
+	MaxMessageBytes int64 `protobuf:"varint,6,opt,name=max_message_bytes,json=maxMessageBytes,proto3" json:"max_message_bytes,omitempty"`
+}
+
+func (x *GetBatchRequest) Reset() {
+	*x = GetBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchRequest) ProtoMessage() {}
+
+func (x *GetBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBatchRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *GetBatchRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetBatchRequest) GetFetchOptions() *FetchOptions {
+	if x != nil {
+		return x.FetchOptions
+	}
+	return nil
+}
+
+func (x *GetBatchRequest) GetCacheOptions() *CacheOptions {
+	if x != nil {
+		return x.CacheOptions
+	}
+	return nil
+}
+
+func (x *GetBatchRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *GetBatchRequest) GetMaxMessageBytes() int64 {
+	if x != nil {
+		return x.MaxMessageBytes
+	}
+	return 0
+}
+
+// The result of one URL of a GetBatch request.
+type GetBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL's position in the request's urls.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Whether the URL succeeded (code zero), and how long it took.
+	Result *ItemResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// The URL's response, as from Get, if it succeeded.
+	Response *DownloadCacheResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	// The batch's ID, the same on every result.  The URLs that failed are
+	// kept for a week under it, for ListCrawlResults and RetryFailed.
+	BatchId string `protobuf:"bytes,4,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *GetBatchResult) Reset() {
+	*x = GetBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchResult) ProtoMessage() {}
+
+func (x *GetBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchResult.ProtoReflect.Descriptor instead.
+func (*GetBatchResult) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBatchResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetBatchResult) GetResult() *ItemResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetBatchResult) GetResponse() *DownloadCacheResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetBatchResult) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+// A message of a GetStream response.
+type DownloadCacheChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set on the first message only: the response, with page_contents and
+	// binary_contents empty.  Its content_type says whether the chunks that
+	// follow are text.
+	Response *DownloadCacheResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// A piece of the contents, in order, on the messages after the first.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DownloadCacheChunk) Reset() {
+	*x = DownloadCacheChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadCacheChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCacheChunk) ProtoMessage() {}
+
+func (x *DownloadCacheChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCacheChunk.ProtoReflect.Descriptor instead.
+func (*DownloadCacheChunk) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DownloadCacheChunk) GetResponse() *DownloadCacheResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *DownloadCacheChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A piece of spilled contents, in order.
+type SpilledChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SpilledChunk) Reset() {
+	*x = SpilledChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpilledChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpilledChunk) ProtoMessage() {}
+
+func (x *SpilledChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpilledChunk.ProtoReflect.Descriptor instead.
+func (*SpilledChunk) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SpilledChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message for looking up a page by the hash of its contents.
+type GetByHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex SHA-256, as in DownloadCacheResponse.content_hash.
+	ContentHash string `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// The tenant the page was fetched for, if any.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetByHashRequest) Reset() {
+	*x = GetByHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByHashRequest) ProtoMessage() {}
+
+func (x *GetByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetByHashRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetByHashRequest) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *GetByHashRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// The response message with the page whose contents have the hash.  If
+// several cached URLs have the same contents, it is the last one fetched.
+type GetByHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url  string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Page *DownloadCacheResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetByHashResponse) Reset() {
+	*x = GetByHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByHashResponse) ProtoMessage() {}
+
+func (x *GetByHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByHashResponse.ProtoReflect.Descriptor instead.
+func (*GetByHashResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetByHashResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetByHashResponse) GetPage() *DownloadCacheResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Where a request spent its time.  Phases that didn't happen (e.g. all of
+// the fetch phases on a cache hit) are unset.  The browser phases are only
+// measured by the built-in Selenium fetcher.
+type Timing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total *durationpb.Duration `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Waiting for another request that was fetching the same URL.
+	LockWait *durationpb.Duration `protobuf:"bytes,2,opt,name=lock_wait,json=lockWait,proto3" json:"lock_wait,omitempty"`
+	// Waiting for a free local chromedriver worker.
+	QueueWait *durationpb.Duration `protobuf:"bytes,3,opt,name=queue_wait,json=queueWait,proto3" json:"queue_wait,omitempty"`
+	// Opening a WebDriver session, including time queued on a Selenium grid
+	// that is at capacity.
+	SessionCreate *durationpb.Duration `protobuf:"bytes,4,opt,name=session_create,json=sessionCreate,proto3" json:"session_create,omitempty"`
+	// Loading the page.
+	Navigation *durationpb.Duration `protobuf:"bytes,5,opt,name=navigation,proto3" json:"navigation,omitempty"`
+	// Letting scripts run before capturing the page (render_wait_ms).
+	RenderWait *durationpb.Duration `protobuf:"bytes,6,opt,name=render_wait,json=renderWait,proto3" json:"render_wait,omitempty"`
+	// Reading the page source and redirect chain from the browser.
+	Capture  *durationpb.Duration `protobuf:"bytes,7,opt,name=capture,proto3" json:"capture,omitempty"`
+	Minify   *durationpb.Duration `protobuf:"bytes,8,opt,name=minify,proto3" json:"minify,omitempty"`
+	Compress *durationpb.Duration `protobuf:"bytes,9,opt,name=compress,proto3" json:"compress,omitempty"`
+	// Writing the entry and its metadata to storage.
+	Store *durationpb.Duration `protobuf:"bytes,10,opt,name=store,proto3" json:"store,omitempty"`
+	// Reading and decompressing a cached entry.
+	CacheRead *durationpb.Duration `protobuf:"bytes,11,opt,name=cache_read,json=cacheRead,proto3" json:"cache_read,omitempty"`
+	// Running content processors.
+	Processors *durationpb.Duration `protobuf:"bytes,12,opt,name=processors,proto3" json:"processors,omitempty"`
+	// What rendering the page cost the browser, if the fetcher measured it:
+	// the time the page's main thread spent running tasks, mostly on the CPU,
+	BrowserCpuTime *durationpb.Duration `protobuf:"bytes,13,opt,name=browser_cpu_time,json=browserCpuTime,proto3" json:"browser_cpu_time,omitempty"`
+	// the JavaScript heap in use once the page rendered,
+	BrowserHeapBytes int64 `protobuf:"varint,14,opt,name=browser_heap_bytes,json=browserHeapBytes,proto3" json:"browser_heap_bytes,omitempty"`
+	// and the bytes received for the page and its resources, as sent over
+	// the network.
+	BytesTransferred int64 `protobuf:"varint,15,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+}
+
+func (x *Timing) Reset() {
+	*x = Timing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timing) ProtoMessage() {}
+
+func (x *Timing) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timing.ProtoReflect.Descriptor instead.
+func (*Timing) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Timing) GetTotal() *durationpb.Duration {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Timing) GetLockWait() *durationpb.Duration {
+	if x != nil {
+		return x.LockWait
+	}
+	return nil
+}
+
+func (x *Timing) GetQueueWait() *durationpb.Duration {
+	if x != nil {
+		return x.QueueWait
+	}
+	return nil
+}
+
+func (x *Timing) GetSessionCreate() *durationpb.Duration {
+	if x != nil {
+		return x.SessionCreate
+	}
+	return nil
+}
+
+func (x *Timing) GetNavigation() *durationpb.Duration {
+	if x != nil {
+		return x.Navigation
+	}
+	return nil
+}
+
+func (x *Timing) GetRenderWait() *durationpb.Duration {
+	if x != nil {
+		return x.RenderWait
+	}
+	return nil
+}
+
+func (x *Timing) GetCapture() *durationpb.Duration {
+	if x != nil {
+		return x.Capture
+	}
+	return nil
+}
+
+func (x *Timing) GetMinify() *durationpb.Duration {
+	if x != nil {
+		return x.Minify
+	}
+	return nil
+}
+
+func (x *Timing) GetCompress() *durationpb.Duration {
+	if x != nil {
+		return x.Compress
+	}
+	return nil
+}
+
+func (x *Timing) GetStore() *durationpb.Duration {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *Timing) GetCacheRead() *durationpb.Duration {
+	if x != nil {
+		return x.CacheRead
+	}
+	return nil
+}
+
+func (x *Timing) GetProcessors() *durationpb.Duration {
+	if x != nil {
+		return x.Processors
+	}
+	return nil
+}
+
+func (x *Timing) GetBrowserCpuTime() *durationpb.Duration {
+	if x != nil {
+		return x.BrowserCpuTime
+	}
+	return nil
+}
+
+func (x *Timing) GetBrowserHeapBytes() int64 {
+	if x != nil {
+		return x.BrowserHeapBytes
+	}
+	return 0
+}
+
+func (x *Timing) GetBytesTransferred() int64 {
+	if x != nil {
+		return x.BytesTransferred
+	}
+	return 0
+}
+
+// A single step of a redirect chain.
+type RedirectHop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	StatusCode int32  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+}
+
+func (x *RedirectHop) Reset() {
+	*x = RedirectHop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectHop) ProtoMessage() {}
+
+func (x *RedirectHop) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectHop.ProtoReflect.Descriptor instead.
+func (*RedirectHop) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RedirectHop) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RedirectHop) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+// The request message for a sitemap.  Results are paginated; pass the
+// next_page_token from a previous response to continue.
+type ParseSitemapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Invalidate bool   `protobuf:"varint,2,opt,name=invalidate,proto3" json:"invalidate,omitempty"`
+	PageSize   int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ParseSitemapRequest) Reset() {
+	*x = ParseSitemapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseSitemapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseSitemapRequest) ProtoMessage() {}
+
+func (x *ParseSitemapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseSitemapRequest.ProtoReflect.Descriptor instead.
+func (*ParseSitemapRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ParseSitemapRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ParseSitemapRequest) GetInvalidate() bool {
+	if x != nil {
+		return x.Invalidate
+	}
+	return false
+}
+
+func (x *ParseSitemapRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ParseSitemapRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// A single <url> (or <sitemap>, for sitemap indexes) entry.
+type SitemapEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loc        string  `protobuf:"bytes,1,opt,name=loc,proto3" json:"loc,omitempty"`
+	Lastmod    string  `protobuf:"bytes,2,opt,name=lastmod,proto3" json:"lastmod,omitempty"`
+	Changefreq string  `protobuf:"bytes,3,opt,name=changefreq,proto3" json:"changefreq,omitempty"`
+	Priority   float64 `protobuf:"fixed64,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// True if the entry points at another sitemap (from a sitemap index).
+	IsSitemap bool `protobuf:"varint,5,opt,name=is_sitemap,json=isSitemap,proto3" json:"is_sitemap,omitempty"`
+}
+
+func (x *SitemapEntry) Reset() {
+	*x = SitemapEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitemapEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitemapEntry) ProtoMessage() {}
+
+func (x *SitemapEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitemapEntry.ProtoReflect.Descriptor instead.
+func (*SitemapEntry) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SitemapEntry) GetLoc() string {
+	if x != nil {
+		return x.Loc
+	}
+	return ""
+}
+
+func (x *SitemapEntry) GetLastmod() string {
+	if x != nil {
+		return x.Lastmod
+	}
+	return ""
+}
+
+func (x *SitemapEntry) GetChangefreq() string {
+	if x != nil {
+		return x.Changefreq
+	}
+	return ""
+}
+
+func (x *SitemapEntry) GetPriority() float64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SitemapEntry) GetIsSitemap() bool {
+	if x != nil {
+		return x.IsSitemap
+	}
+	return false
+}
+
+// The response message containing one page of sitemap entries.
+type ParseSitemapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*SitemapEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalEntries  int32           `protobuf:"varint,3,opt,name=total_entries,json=totalEntries,proto3" json:"total_entries,omitempty"`
+}
+
+func (x *ParseSitemapResponse) Reset() {
+	*x = ParseSitemapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseSitemapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseSitemapResponse) ProtoMessage() {}
+
+func (x *ParseSitemapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseSitemapResponse.ProtoReflect.Descriptor instead.
+func (*ParseSitemapResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ParseSitemapResponse) GetEntries() []*SitemapEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ParseSitemapResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ParseSitemapResponse) GetTotalEntries() int32 {
+	if x != nil {
+		return x.TotalEntries
+	}
+	return 0
+}
+
+// The request message for a backup.
+type BackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only entries fetched after this time are included.  Unset backs up everything.
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRequest) ProtoMessage() {}
+
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BackupRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// A single cache entry, independent of the server's cache layout.
+type BackupEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The entry's metadata, as JSON.
+	Metadata []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The content exactly as stored, i.e. compressed with the codec named in
+	// the metadata.  Empty for alias entries.
+	Content   []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	FetchedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+}
+
+func (x *BackupEntry) Reset() {
+	*x = BackupEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupEntry) ProtoMessage() {}
+
+func (x *BackupEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupEntry.ProtoReflect.Descriptor instead.
+func (*BackupEntry) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BackupEntry) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BackupEntry) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BackupEntry) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *BackupEntry) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+// The response message summarizing a restore.
+type RestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Restored int32 `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
+	// Entries skipped because the cache already held a newer copy.
+	Skipped int32 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreResponse) GetRestored() int32 {
+	if x != nil {
+		return x.Restored
+	}
+	return 0
+}
+
+func (x *RestoreResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// The request message for a garbage collection pass.
+type CollectGarbageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Report what would be removed without removing anything.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CollectGarbageRequest) Reset() {
+	*x = CollectGarbageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectGarbageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbageRequest) ProtoMessage() {}
+
+func (x *CollectGarbageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbageRequest.ProtoReflect.Descriptor instead.
+func (*CollectGarbageRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CollectGarbageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// The response message summarizing a garbage collection pass.
+type CollectGarbageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilesRemoved   int32 `protobuf:"varint,1,opt,name=files_removed,json=filesRemoved,proto3" json:"files_removed,omitempty"`
+	BytesReclaimed int64 `protobuf:"varint,2,opt,name=bytes_reclaimed,json=bytesReclaimed,proto3" json:"bytes_reclaimed,omitempty"`
+}
+
+func (x *CollectGarbageResponse) Reset() {
+	*x = CollectGarbageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectGarbageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbageResponse) ProtoMessage() {}
+
+func (x *CollectGarbageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbageResponse.ProtoReflect.Descriptor instead.
+func (*CollectGarbageResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CollectGarbageResponse) GetFilesRemoved() int32 {
+	if x != nil {
+		return x.FilesRemoved
+	}
+	return 0
+}
+
+func (x *CollectGarbageResponse) GetBytesReclaimed() int64 {
+	if x != nil {
+		return x.BytesReclaimed
+	}
+	return 0
+}
+
+// The request message for an entry's metadata.
+type GetMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetMetadataRequest) Reset() {
+	*x = GetMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataRequest) ProtoMessage() {}
+
+func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetMetadataRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetMetadataRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// The response message describing an entry.
+type GetMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// For a URL stored as an alias, the entry it is an alias of.
+	Entry *CacheEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *GetMetadataResponse) Reset() {
+	*x = GetMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataResponse) ProtoMessage() {}
+
+func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMetadataResponse) GetEntry() *CacheEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// The request message for a full-text search.
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Words the pages must all contain, in any case.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only pages from this hostname or its subdomains, e.g. "example.com".
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Only pages fetched at or after this time.
+	FetchedAfter *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fetched_after,json=fetchedAfter,proto3" json:"fetched_after,omitempty"`
+	// Only pages fetched before this time.
+	FetchedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fetched_before,json=fetchedBefore,proto3" json:"fetched_before,omitempty"`
+	// Results per page (default 20, at most 100).
+	PageSize  int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetFetchedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAfter
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetFetchedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedBefore
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// The response message containing one page of search results, best first.
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Set while the index is still being built from the cache after a
+	// restart, so results may be missing pages.
+	Indexing bool `protobuf:"varint,3,opt,name=indexing,proto3" json:"indexing,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetIndexing() bool {
+	if x != nil {
+		return x.Indexing
+	}
+	return false
+}
+
+// A page matching a search.
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url       string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	FetchedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	// How well the page matches, by TF-IDF; only comparable within a search.
+	Score float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	// The text around the first match.
+	Snippet string `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SearchResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+// The request message for listing entries.  Results are paginated; pass the
+// next_page_token from a previous response to continue.
+type ListEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only list entries whose URL starts with this prefix.
+	UrlPrefix string `protobuf:"bytes,3,opt,name=url_prefix,json=urlPrefix,proto3" json:"url_prefix,omitempty"`
+	// Only list entries with all of these annotations.
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Only list entries served with a certificate (the server's, or one in
+	// its chain) that expires before this time, for expiry monitoring.
+	CertificateExpiresBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=certificate_expires_before,json=certificateExpiresBefore,proto3" json:"certificate_expires_before,omitempty"`
+	// Return each entry's thumbnail, if it has one, for scanning what is in
+	// the cache by eye.
+	IncludeThumbnails bool `protobuf:"varint,6,opt,name=include_thumbnails,json=includeThumbnails,proto3" json:"include_thumbnails,omitempty"`
+}
+
+func (x *ListEntriesRequest) Reset() {
+	*x = ListEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesRequest) ProtoMessage() {}
+
+func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetUrlPrefix() string {
+	if x != nil {
+		return x.UrlPrefix
+	}
+	return ""
+}
+
+func (x *ListEntriesRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ListEntriesRequest) GetCertificateExpiresBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CertificateExpiresBefore
+	}
+	return nil
+}
+
+func (x *ListEntriesRequest) GetIncludeThumbnails() bool {
+	if x != nil {
+		return x.IncludeThumbnails
+	}
+	return false
+}
+
+// A cache entry.  Entries cached before metadata was recorded are not
+// listed until the migrate subcommand has recorded metadata for them.
+type CacheEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL that was requested.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The file name the entry is stored under; depends on CACHE_KEY_SCHEME.
+	CacheKey       string                 `protobuf:"bytes,2,opt,name=cache_key,json=cacheKey,proto3" json:"cache_key,omitempty"`
+	FetchedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"`
+	// Stored (compressed) size of the content.  Zero for aliases and entries
+	// in cold storage.
+	SizeBytes    int64  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Codec        string `protobuf:"bytes,6,opt,name=codec,proto3" json:"codec,omitempty"`
+	CanonicalUrl string `protobuf:"bytes,7,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"`
+	// Set if this entry is an alias for the entry of another URL.
+	AliasOf string `protobuf:"bytes,8,opt,name=alias_of,json=aliasOf,proto3" json:"alias_of,omitempty"`
+	Cold    bool   `protobuf:"varint,9,opt,name=cold,proto3" json:"cold,omitempty"`
+	// Set if the entry is under legal hold; see SetLegalHold.
+	LegalHold bool `protobuf:"varint,10,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	// The annotations it was stored with; see CacheOptions.annotations.
+	Annotations map[string]string `protobuf:"bytes,11,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// How long the entry stays fresh, as learned from how often the page
+	// changes (see ADAPTIVE_TTL_MAX).  Unset if the server's TTL applies.
+	Ttl *durationpb.Duration `protobuf:"bytes,12,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Set if the entry was fetched with collect_web_vitals.
+	WebVitals *WebVitals `protobuf:"bytes,13,opt,name=web_vitals,json=webVitals,proto3" json:"web_vitals,omitempty"`
+	// The TLS connection the page was served over, if known.
+	Tls *TLSInfo `protobuf:"bytes,14,opt,name=tls,proto3" json:"tls,omitempty"`
+	// How the entry was fetched, if recorded.
+	Receipt *FetchReceipt `protobuf:"bytes,15,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// A small JPEG of how the page looked when it was fetched (see
+	// THUMBNAIL_WIDTH).  Returned by GetMetadata, and by ListEntries with
+	// include_thumbnails.
+	Thumbnail []byte `protobuf:"bytes,16,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+}
+
+func (x *CacheEntry) Reset() {
+	*x = CacheEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEntry) ProtoMessage() {}
+
+func (x *CacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEntry.ProtoReflect.Descriptor instead.
+func (*CacheEntry) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CacheEntry) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetCacheKey() string {
+	if x != nil {
+		return x.CacheKey
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetAliasOf() string {
+	if x != nil {
+		return x.AliasOf
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetCold() bool {
+	if x != nil {
+		return x.Cold
+	}
+	return false
+}
+
+func (x *CacheEntry) GetLegalHold() bool {
+	if x != nil {
+		return x.LegalHold
+	}
+	return false
+}
+
+func (x *CacheEntry) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetWebVitals() *WebVitals {
+	if x != nil {
+		return x.WebVitals
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetTls() *TLSInfo {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetReceipt() *FetchReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *CacheEntry) GetThumbnail() []byte {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return nil
+}
+
+// The response message containing one page of entries.
+type ListEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*CacheEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListEntriesResponse) Reset() {
+	*x = ListEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntriesResponse) ProtoMessage() {}
+
+func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListEntriesResponse) GetEntries() []*CacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request message for per-domain statistics.
+type GetDomainStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only report this hostname.  Empty reports every hostname.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Only report the largest hostnames, by stored bytes.  Zero reports all.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetDomainStatsRequest) Reset() {
+	*x = GetDomainStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDomainStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainStatsRequest) ProtoMessage() {}
+
+func (x *GetDomainStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDomainStatsRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetDomainStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Statistics for one hostname.  Traffic counters cover the time since the
+// server started; entries and bytes cover the whole cache.
+type DomainStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host    string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Entries int64  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	// Stored (compressed) bytes of the host's local entries.
+	Bytes               int64   `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Hits                int64   `protobuf:"varint,4,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses              int64   `protobuf:"varint,5,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors              int64   `protobuf:"varint,6,opt,name=errors,proto3" json:"errors,omitempty"`
+	AverageFetchSeconds float64 `protobuf:"fixed64,7,opt,name=average_fetch_seconds,json=averageFetchSeconds,proto3" json:"average_fetch_seconds,omitempty"`
+	// errors / (misses + errors), i.e. the fraction of downloads that failed.
+	FailureRate float64 `protobuf:"fixed64,8,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+}
+
+func (x *DomainStats) Reset() {
+	*x = DomainStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainStats) ProtoMessage() {}
+
+func (x *DomainStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainStats.ProtoReflect.Descriptor instead.
+func (*DomainStats) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DomainStats) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *DomainStats) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *DomainStats) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *DomainStats) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *DomainStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *DomainStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *DomainStats) GetAverageFetchSeconds() float64 {
+	if x != nil {
+		return x.AverageFetchSeconds
+	}
+	return 0
+}
+
+func (x *DomainStats) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+// The response message containing per-domain statistics.
+type GetDomainStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domains []*DomainStats `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+}
+
+func (x *GetDomainStatsResponse) Reset() {
+	*x = GetDomainStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDomainStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainStatsResponse) ProtoMessage() {}
+
+func (x *GetDomainStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDomainStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetDomainStatsResponse) GetDomains() []*DomainStats {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// The request message for render load.
+type GetRenderLoadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRenderLoadRequest) Reset() {
+	*x = GetRenderLoadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRenderLoadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRenderLoadRequest) ProtoMessage() {}
+
+func (x *GetRenderLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRenderLoadRequest.ProtoReflect.Descriptor instead.
+func (*GetRenderLoadRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{37}
+}
+
+// The response message describing demand for browser sessions.
+type GetRenderLoadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Downloads waiting for the grid to hand out a session.
+	QueuedSessions int32 `protobuf:"varint,1,opt,name=queued_sessions,json=queuedSessions,proto3" json:"queued_sessions,omitempty"`
+	// Downloads currently holding a session.
+	ActiveSessions int32 `protobuf:"varint,2,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"`
+	// The highest queued + active count seen over the recent window.
+	PeakDemand int32 `protobuf:"varint,3,opt,name=peak_demand,json=peakDemand,proto3" json:"peak_demand,omitempty"`
+	// peak_demand scaled by AUTOSCALE_HEADROOM, rounded up: the number of
+	// concurrent sessions the grid should be able to serve.
+	DesiredSessions int32 `protobuf:"varint,4,opt,name=desired_sessions,json=desiredSessions,proto3" json:"desired_sessions,omitempty"`
+	WindowSeconds   int64 `protobuf:"varint,5,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (x *GetRenderLoadResponse) Reset() {
+	*x = GetRenderLoadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRenderLoadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRenderLoadResponse) ProtoMessage() {}
+
+func (x *GetRenderLoadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRenderLoadResponse.ProtoReflect.Descriptor instead.
+func (*GetRenderLoadResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRenderLoadResponse) GetQueuedSessions() int32 {
+	if x != nil {
+		return x.QueuedSessions
+	}
+	return 0
+}
+
+func (x *GetRenderLoadResponse) GetActiveSessions() int32 {
+	if x != nil {
+		return x.ActiveSessions
+	}
+	return 0
+}
+
+func (x *GetRenderLoadResponse) GetPeakDemand() int32 {
+	if x != nil {
+		return x.PeakDemand
+	}
+	return 0
+}
+
+func (x *GetRenderLoadResponse) GetDesiredSessions() int32 {
+	if x != nil {
+		return x.DesiredSessions
+	}
+	return 0
+}
+
+func (x *GetRenderLoadResponse) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+// The request message for a signed gateway URL.  The page must be cached.
+type CreateSignedURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// How long the URL stays valid (default 1 hour, at most 7 days).
+	TtlSeconds int32 `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+}
+
+func (x *CreateSignedURLRequest) Reset() {
+	*x = CreateSignedURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSignedURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSignedURLRequest) ProtoMessage() {}
+
+func (x *CreateSignedURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSignedURLRequest.ProtoReflect.Descriptor instead.
+func (*CreateSignedURLRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateSignedURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateSignedURLRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// The response message containing a signed gateway URL.
+type CreateSignedURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative to the gateway unless GATEWAY_BASE_URL is set.
+	SignedUrl string                 `protobuf:"bytes,1,opt,name=signed_url,json=signedUrl,proto3" json:"signed_url,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *CreateSignedURLResponse) Reset() {
+	*x = CreateSignedURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSignedURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSignedURLResponse) ProtoMessage() {}
+
+func (x *CreateSignedURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSignedURLResponse.ProtoReflect.Descriptor instead.
+func (*CreateSignedURLResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateSignedURLResponse) GetSignedUrl() string {
+	if x != nil {
+		return x.SignedUrl
+	}
+	return ""
+}
+
+func (x *CreateSignedURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// The request message for switching offline mode.
+type SetOfflineModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offline bool `protobuf:"varint,1,opt,name=offline,proto3" json:"offline,omitempty"`
+}
+
+func (x *SetOfflineModeRequest) Reset() {
+	*x = SetOfflineModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOfflineModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOfflineModeRequest) ProtoMessage() {}
+
+func (x *SetOfflineModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOfflineModeRequest.ProtoReflect.Descriptor instead.
+func (*SetOfflineModeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetOfflineModeRequest) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+// The response message confirming the offline mode.
+type SetOfflineModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offline bool `protobuf:"varint,1,opt,name=offline,proto3" json:"offline,omitempty"`
+	// The mode before this request.
+	WasOffline bool `protobuf:"varint,2,opt,name=was_offline,json=wasOffline,proto3" json:"was_offline,omitempty"`
+}
+
+func (x *SetOfflineModeResponse) Reset() {
+	*x = SetOfflineModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOfflineModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOfflineModeResponse) ProtoMessage() {}
+
+func (x *SetOfflineModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOfflineModeResponse.ProtoReflect.Descriptor instead.
+func (*SetOfflineModeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetOfflineModeResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *SetOfflineModeResponse) GetWasOffline() bool {
+	if x != nil {
+		return x.WasOffline
+	}
+	return false
+}
+
+// The request message for switching maintenance mode.  Call with a deadline
+// to bound how long entering maintenance waits for in-flight work.
+type SetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maintenance bool `protobuf:"varint,1,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// How long rejected clients are told to wait before retrying, in the
+	// RetryInfo detail of their UNAVAILABLE errors (default 60).
+	RetryAfterSeconds int32 `protobuf:"varint,2,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetMaintenanceModeRequest) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetRetryAfterSeconds() int32 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+// The response message confirming the maintenance mode.
+type SetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maintenance bool `protobuf:"varint,1,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	// The mode before this request.
+	WasInMaintenance bool `protobuf:"varint,2,opt,name=was_in_maintenance,json=wasInMaintenance,proto3" json:"was_in_maintenance,omitempty"`
+	// Fetches and writes still running when the request returned; zero once
+	// they have drained.  Nonzero only if the request's deadline expired first.
+	InFlight int32 `protobuf:"varint,3,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+}
+
+func (x *SetMaintenanceModeResponse) Reset() {
+	*x = SetMaintenanceModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetMaintenanceModeResponse) GetMaintenance() bool {
+	if x != nil {
+		return x.Maintenance
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeResponse) GetWasInMaintenance() bool {
+	if x != nil {
+		return x.WasInMaintenance
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeResponse) GetInFlight() int32 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+// The request message for changing logging.  Unset fields are unchanged.
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Messages below this level are dropped.
+	Level LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=downloadcache.LogLevel" json:"level,omitempty"`
+	// Log the informational messages of only 1 in this many requests;
+	// warnings and errors are always logged.  1 logs every request.
+	SampleEvery int32 `protobuf:"varint,2,opt,name=sample_every,json=sampleEvery,proto3" json:"sample_every,omitempty"`
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetLogLevelRequest) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *SetLogLevelRequest) GetSampleEvery() int32 {
+	if x != nil {
+		return x.SampleEvery
+	}
+	return 0
+}
+
+// The response message with the logging settings now in effect.
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level       LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=downloadcache.LogLevel" json:"level,omitempty"`
+	SampleEvery int32    `protobuf:"varint,2,opt,name=sample_every,json=sampleEvery,proto3" json:"sample_every,omitempty"`
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetLogLevelResponse) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *SetLogLevelResponse) GetSampleEvery() int32 {
+	if x != nil {
+		return x.SampleEvery
+	}
+	return 0
+}
+
+// The request message for placing an entry under legal hold or releasing it.
+type SetLegalHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entry's URL, as requested from Get.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The tenant the entry was fetched for, if any.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Hold   bool   `protobuf:"varint,3,opt,name=hold,proto3" json:"hold,omitempty"`
+}
+
+func (x *SetLegalHoldRequest) Reset() {
+	*x = SetLegalHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLegalHoldRequest) ProtoMessage() {}
+
+func (x *SetLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*SetLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetLegalHoldRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetLegalHoldRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SetLegalHoldRequest) GetHold() bool {
+	if x != nil {
+		return x.Hold
+	}
+	return false
+}
+
+// The response message with the entry's hold, before and after.
+type SetLegalHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hold    bool `protobuf:"varint,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	WasHeld bool `protobuf:"varint,2,opt,name=was_held,json=wasHeld,proto3" json:"was_held,omitempty"`
+	// When the held copy was fetched.
+	FetchedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+}
+
+func (x *SetLegalHoldResponse) Reset() {
+	*x = SetLegalHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLegalHoldResponse) ProtoMessage() {}
+
+func (x *SetLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*SetLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetLegalHoldResponse) GetHold() bool {
+	if x != nil {
+		return x.Hold
+	}
+	return false
+}
+
+func (x *SetLegalHoldResponse) GetWasHeld() bool {
+	if x != nil {
+		return x.WasHeld
+	}
+	return false
+}
+
+func (x *SetLegalHoldResponse) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+// The request message for usage.  Callers identify themselves with the
+// x-caller request header.
+type GetUsageReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only report this caller, if set.
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Only report this tenant, if set.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Zero the counters once reported, e.g. at the end of a billing period.
+	ResetCounters bool `protobuf:"varint,3,opt,name=reset_counters,json=resetCounters,proto3" json:"reset_counters,omitempty"`
+}
+
+func (x *GetUsageReportRequest) Reset() {
+	*x = GetUsageReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportRequest) ProtoMessage() {}
+
+func (x *GetUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetUsageReportRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetResetCounters() bool {
+	if x != nil {
+		return x.ResetCounters
+	}
+	return false
+}
+
+// Usage by one caller for one tenant (or shared entries).
+type CallerUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Successful Get requests, and how many of them were cache hits and misses.
+	Requests int64 `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	Hits     int64 `protobuf:"varint,4,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses   int64 `protobuf:"varint,5,opt,name=misses,proto3" json:"misses,omitempty"`
+	// Page bytes returned, after truncation.
+	BytesServed int64 `protobuf:"varint,6,opt,name=bytes_served,json=bytesServed,proto3" json:"bytes_served,omitempty"`
+	// Page bytes fetched on misses, before minification.
+	BytesFetched int64 `protobuf:"varint,7,opt,name=bytes_fetched,json=bytesFetched,proto3" json:"bytes_fetched,omitempty"`
+	// Time spent fetching pages on misses.
+	FetchTime *durationpb.Duration `protobuf:"bytes,8,opt,name=fetch_time,json=fetchTime,proto3" json:"fetch_time,omitempty"`
+}
+
+func (x *CallerUsage) Reset() {
+	*x = CallerUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallerUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallerUsage) ProtoMessage() {}
+
+func (x *CallerUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallerUsage.ProtoReflect.Descriptor instead.
+func (*CallerUsage) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CallerUsage) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *CallerUsage) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CallerUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *CallerUsage) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CallerUsage) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CallerUsage) GetBytesServed() int64 {
+	if x != nil {
+		return x.BytesServed
+	}
+	return 0
+}
+
+func (x *CallerUsage) GetBytesFetched() int64 {
+	if x != nil {
+		return x.BytesFetched
+	}
+	return 0
+}
+
+func (x *CallerUsage) GetFetchTime() *durationpb.Duration {
+	if x != nil {
+		return x.FetchTime
+	}
+	return nil
+}
+
+// The response message with usage, most bytes served first.
+type GetUsageReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage []*CallerUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	// When counting started: the first start, or the last reset.
+	Since *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *GetUsageReportResponse) Reset() {
+	*x = GetUsageReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportResponse) ProtoMessage() {}
+
+func (x *GetUsageReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageReportResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetUsageReportResponse) GetUsage() []*CallerUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetUsageReportResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// The request message for paused domains.
+type ListPausedDomainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPausedDomainsRequest) Reset() {
+	*x = ListPausedDomainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPausedDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPausedDomainsRequest) ProtoMessage() {}
+
+func (x *ListPausedDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPausedDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListPausedDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{52}
+}
+
+// A domain whose fetches are paused.
+type PausedDomain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Fetches that have failed in a row.
+	ConsecutiveFailures int32                  `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	PausedUntil         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=paused_until,json=pausedUntil,proto3" json:"paused_until,omitempty"`
+	// The most recent fetch error.
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *PausedDomain) Reset() {
+	*x = PausedDomain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausedDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausedDomain) ProtoMessage() {}
+
+func (x *PausedDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausedDomain.ProtoReflect.Descriptor instead.
+func (*PausedDomain) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PausedDomain) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *PausedDomain) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *PausedDomain) GetPausedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedUntil
+	}
+	return nil
+}
+
+func (x *PausedDomain) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// The response message listing paused domains, soonest to resume first.
+type ListPausedDomainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domains []*PausedDomain `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+}
+
+func (x *ListPausedDomainsResponse) Reset() {
+	*x = ListPausedDomainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPausedDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPausedDomainsResponse) ProtoMessage() {}
+
+func (x *ListPausedDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPausedDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListPausedDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListPausedDomainsResponse) GetDomains() []*PausedDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// The request message for resuming a paused domain.
+type ResumeDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *ResumeDomainRequest) Reset() {
+	*x = ResumeDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeDomainRequest) ProtoMessage() {}
+
+func (x *ResumeDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeDomainRequest.ProtoReflect.Descriptor instead.
+func (*ResumeDomainRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResumeDomainRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+// The response message for resuming a domain.
+type ResumeDomainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set if the domain was paused.
+	WasPaused bool `protobuf:"varint,1,opt,name=was_paused,json=wasPaused,proto3" json:"was_paused,omitempty"`
+}
+
+func (x *ResumeDomainResponse) Reset() {
+	*x = ResumeDomainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeDomainResponse) ProtoMessage() {}
+
+func (x *ResumeDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeDomainResponse.ProtoReflect.Descriptor instead.
+func (*ResumeDomainResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ResumeDomainResponse) GetWasPaused() bool {
+	if x != nil {
+		return x.WasPaused
+	}
+	return false
+}
+
+// The request message for fetching a page with its assets.  The page and
+// assets are always fetched afresh, as with cache_options.invalidate.
+type FetchWithAssetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Caches the page and assets for a tenant, as in DownloadCacheRequest.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Options for fetching the page, as in DownloadCacheRequest.
+	FetchOptions *FetchOptions `protobuf:"bytes,3,opt,name=fetch_options,json=fetchOptions,proto3" json:"fetch_options,omitempty"`
+	// force replaces held entries; no_store fetches without caching.
+	CacheOptions *CacheOptions `protobuf:"bytes,4,opt,name=cache_options,json=cacheOptions,proto3" json:"cache_options,omitempty"`
+	// Assets larger than this fail the request.  Zero means 10 MiB.
+	MaxAssetBytes int64 `protobuf:"varint,5,opt,name=max_asset_bytes,json=maxAssetBytes,proto3" json:"max_asset_bytes,omitempty"`
+	// At most this many assets are fetched, in the order the page references
+	// them.  Zero means 200.
+	MaxAssets int32 `protobuf:"varint,6,opt,name=max_assets,json=maxAssets,proto3" json:"max_assets,omitempty"`
+	// If set, assets that can't be fetched are reported and left out instead
+	// of failing the request.
+	AllowMissing bool `protobuf:"varint,7,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+}
+
+func (x *FetchWithAssetsRequest) Reset() {
+	*x = FetchWithAssetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchWithAssetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWithAssetsRequest) ProtoMessage() {}
+
+func (x *FetchWithAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWithAssetsRequest.ProtoReflect.Descriptor instead.
+func (*FetchWithAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FetchWithAssetsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FetchWithAssetsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *FetchWithAssetsRequest) GetFetchOptions() *FetchOptions {
+	if x != nil {
+		return x.FetchOptions
+	}
+	return nil
+}
+
+func (x *FetchWithAssetsRequest) GetCacheOptions() *CacheOptions {
+	if x != nil {
+		return x.CacheOptions
+	}
+	return nil
+}
+
+func (x *FetchWithAssetsRequest) GetMaxAssetBytes() int64 {
+	if x != nil {
+		return x.MaxAssetBytes
+	}
+	return 0
+}
+
+func (x *FetchWithAssetsRequest) GetMaxAssets() int32 {
+	if x != nil {
+		return x.MaxAssets
+	}
+	return 0
+}
+
+func (x *FetchWithAssetsRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+// An asset fetched along with a page.
+type FetchedAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes   int64  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Why the asset couldn't be fetched, with allow_missing; it isn't cached.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FetchedAsset) Reset() {
+	*x = FetchedAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchedAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchedAsset) ProtoMessage() {}
+
+func (x *FetchedAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchedAsset.ProtoReflect.Descriptor instead.
+func (*FetchedAsset) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FetchedAsset) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FetchedAsset) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *FetchedAsset) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *FetchedAsset) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// The response message with the page and the assets cached with it.
+type FetchWithAssetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page   *DownloadCacheResponse `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Assets []*FetchedAsset        `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
+}
+
+func (x *FetchWithAssetsResponse) Reset() {
+	*x = FetchWithAssetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchWithAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWithAssetsResponse) ProtoMessage() {}
+
+func (x *FetchWithAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWithAssetsResponse.ProtoReflect.Descriptor instead.
+func (*FetchWithAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FetchWithAssetsResponse) GetPage() *DownloadCacheResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *FetchWithAssetsResponse) GetAssets() []*FetchedAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+// The request message for starting a crawl.
+type StartCrawlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Where the crawl starts.  Only links to the same hostname are followed.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Caches the pages for a tenant, as in DownloadCacheRequest.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Options for fetching each page, as in DownloadCacheRequest.
+	FetchOptions *FetchOptions `protobuf:"bytes,3,opt,name=fetch_options,json=fetchOptions,proto3" json:"fetch_options,omitempty"`
+	// The crawl stops after this many pages.  Zero means 1000.
+	MaxPages int32 `protobuf:"varint,4,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	// How many links away from url to go; zero is unlimited.
+	MaxDepth int32 `protobuf:"varint,5,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// Store pages whose text nearly matches a page crawled before them.  By
+	// default they are flagged and stored as an alias of that page instead.
+	KeepDuplicates bool `protobuf:"varint,6,opt,name=keep_duplicates,json=keepDuplicates,proto3" json:"keep_duplicates,omitempty"`
+}
+
+func (x *StartCrawlRequest) Reset() {
+	*x = StartCrawlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartCrawlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCrawlRequest) ProtoMessage() {}
+
+func (x *StartCrawlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCrawlRequest.ProtoReflect.Descriptor instead.
+func (*StartCrawlRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StartCrawlRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StartCrawlRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *StartCrawlRequest) GetFetchOptions() *FetchOptions {
+	if x != nil {
+		return x.FetchOptions
+	}
+	return nil
+}
+
+func (x *StartCrawlRequest) GetMaxPages() int32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *StartCrawlRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *StartCrawlRequest) GetKeepDuplicates() bool {
+	if x != nil {
+		return x.KeepDuplicates
+	}
+	return false
+}
+
+// The progress of a crawl.
+type CrawlStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CrawlId string     `protobuf:"bytes,1,opt,name=crawl_id,json=crawlId,proto3" json:"crawl_id,omitempty"`
+	Url     string     `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Tenant  string     `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	State   CrawlState `protobuf:"varint,4,opt,name=state,proto3,enum=downloadcache.CrawlState" json:"state,omitempty"`
+	// Pages fetched or served from the cache.
+	PagesDone int64 `protobuf:"varint,5,opt,name=pages_done,json=pagesDone,proto3" json:"pages_done,omitempty"`
+	// Pages found but not fetched yet.
+	PagesQueued int64                  `protobuf:"varint,6,opt,name=pages_queued,json=pagesQueued,proto3" json:"pages_queued,omitempty"`
+	PagesFailed int64                  `protobuf:"varint,7,opt,name=pages_failed,json=pagesFailed,proto3" json:"pages_failed,omitempty"`
+	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The most recent fetch error.
+	LastError string `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Pages done whose text nearly matches a page crawled before them.
+	PagesDuplicate int64 `protobuf:"varint,11,opt,name=pages_duplicate,json=pagesDuplicate,proto3" json:"pages_duplicate,omitempty"`
+}
+
+func (x *CrawlStatus) Reset() {
+	*x = CrawlStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrawlStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlStatus) ProtoMessage() {}
+
+func (x *CrawlStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlStatus.ProtoReflect.Descriptor instead.
+func (*CrawlStatus) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CrawlStatus) GetCrawlId() string {
+	if x != nil {
+		return x.CrawlId
+	}
+	return ""
+}
+
+func (x *CrawlStatus) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CrawlStatus) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CrawlStatus) GetState() CrawlState {
+	if x != nil {
+		return x.State
+	}
+	return CrawlState_CRAWL_STATE_UNSPECIFIED
+}
+
+func (x *CrawlStatus) GetPagesDone() int64 {
+	if x != nil {
+		return x.PagesDone
+	}
+	return 0
+}
+
+func (x *CrawlStatus) GetPagesQueued() int64 {
+	if x != nil {
+		return x.PagesQueued
+	}
+	return 0
+}
+
+func (x *CrawlStatus) GetPagesFailed() int64 {
+	if x != nil {
+		return x.PagesFailed
+	}
+	return 0
+}
+
+func (x *CrawlStatus) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *CrawlStatus) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CrawlStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CrawlStatus) GetPagesDuplicate() int64 {
+	if x != nil {
+		return x.PagesDuplicate
+	}
+	return 0
+}
+
+// The request message for pausing a crawl.
+type PauseCrawlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CrawlId string `protobuf:"bytes,1,opt,name=crawl_id,json=crawlId,proto3" json:"crawl_id,omitempty"`
+}
+
+func (x *PauseCrawlRequest) Reset() {
+	*x = PauseCrawlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseCrawlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCrawlRequest) ProtoMessage() {}
+
+func (x *PauseCrawlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCrawlRequest.ProtoReflect.Descriptor instead.
+func (*PauseCrawlRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PauseCrawlRequest) GetCrawlId() string {
+	if x != nil {
+		return x.CrawlId
+	}
+	return ""
+}
+
+// The request message for resuming a crawl.
+type ResumeCrawlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CrawlId string `protobuf:"bytes,1,opt,name=crawl_id,json=crawlId,proto3" json:"crawl_id,omitempty"`
+}
+
+func (x *ResumeCrawlRequest) Reset() {
+	*x = ResumeCrawlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeCrawlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCrawlRequest) ProtoMessage() {}
+
+func (x *ResumeCrawlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeCrawlRequest.ProtoReflect.Descriptor instead.
+func (*ResumeCrawlRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ResumeCrawlRequest) GetCrawlId() string {
+	if x != nil {
+		return x.CrawlId
+	}
+	return ""
+}
+
+// The request message for crawl progress.
+type ListCrawlsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only this crawl is reported.
+	CrawlId string `protobuf:"bytes,1,opt,name=crawl_id,json=crawlId,proto3" json:"crawl_id,omitempty"`
+}
+
+func (x *ListCrawlsRequest) Reset() {
+	*x = ListCrawlsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCrawlsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrawlsRequest) ProtoMessage() {}
+
+func (x *ListCrawlsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrawlsRequest.ProtoReflect.Descriptor instead.
+func (*ListCrawlsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListCrawlsRequest) GetCrawlId() string {
+	if x != nil {
+		return x.CrawlId
+	}
+	return ""
+}
+
+// The response message with crawls, most recently started first.
+type ListCrawlsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crawls []*CrawlStatus `protobuf:"bytes,1,rep,name=crawls,proto3" json:"crawls,omitempty"`
+}
+
+func (x *ListCrawlsResponse) Reset() {
+	*x = ListCrawlsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCrawlsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrawlsResponse) ProtoMessage() {}
+
+func (x *ListCrawlsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrawlsResponse.ProtoReflect.Descriptor instead.
+func (*ListCrawlsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListCrawlsResponse) GetCrawls() []*CrawlStatus {
+	if x != nil {
+		return x.Crawls
+	}
+	return nil
+}
+
+// The request message for exporting a crawl.
+type ExportCrawlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CrawlId string       `protobuf:"bytes,1,opt,name=crawl_id,json=crawlId,proto3" json:"crawl_id,omitempty"`
+	Format  ExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=downloadcache.ExportFormat" json:"format,omitempty"`
+	// Compresses the export with gzip, one member per record (.jsonl.gz,
+	// .warc.gz).
+	Gzip bool `protobuf:"varint,3,opt,name=gzip,proto3" json:"gzip,omitempty"`
+	// Writes the export to EXPORT_DIR instead of streaming it back.
+	Save bool `protobuf:"varint,4,opt,name=save,proto3" json:"save,omitempty"`
+}
+
+func (x *ExportCrawlRequest) Reset() {
+	*x = ExportCrawlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCrawlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCrawlRequest) ProtoMessage() {}
+
+func (x *ExportCrawlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCrawlRequest.ProtoReflect.Descriptor instead.
+func (*ExportCrawlRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportCrawlRequest) GetCrawlId() string {
+	if x != nil {
+		return x.CrawlId
+	}
+	return ""
+}
+
+func (x *ExportCrawlRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportCrawlRequest) GetGzip() bool {
+	if x != nil {
+		return x.Gzip
+	}
+	return false
+}
+
+func (x *ExportCrawlRequest) GetSave() bool {
+	if x != nil {
+		return x.Save
+	}
+	return false
+}
+
+// A piece of an export.  Concatenated, the data fields make up the export.
+type ExportCrawlChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// With save, the single message names the file written in EXPORT_DIR.
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// With save, the number of pages exported.
+	Pages int64 `protobuf:"varint,3,opt,name=pages,proto3" json:"pages,omitempty"`
+}
+
+func (x *ExportCrawlChunk) Reset() {
+	*x = ExportCrawlChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCrawlChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCrawlChunk) ProtoMessage() {}
+
+func (x *ExportCrawlChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCrawlChunk.ProtoReflect.Descriptor instead.
+func (*ExportCrawlChunk) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExportCrawlChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportCrawlChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportCrawlChunk) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+// The outcome of fetching one URL of a job.
+type ItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The gRPC status code of the fetch; zero (OK) if it succeeded.
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Whether the failure is likely to go away on retrying, such as a timeout
+	// or an unavailable site.
+	Retryable bool `protobuf:"varint,3,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	// The error message of a failed fetch.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// How long the fetch, or the cache read, took.
+	Duration *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *ItemResult) Reset() {
+	*x = ItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemResult) ProtoMessage() {}
+
+func (x *ItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemResult.ProtoReflect.Descriptor instead.
+func (*ItemResult) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ItemResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ItemResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ItemResult) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+func (x *ItemResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ItemResult) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// The request message for the results of a crawl.
+type ListCrawlResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A crawl_id, or the batch_id of a GetBatch or warm_id of a WarmFromFile,
+	// whose results are only the URLs that failed.
+	CrawlId string `protobuf:"bytes,1,opt,name=crawl_id,json=crawlId,proto3" json:"crawl_id,omitempty"`
+	// Only reports the pages that failed.
+	FailedOnly bool `protobuf:"varint,2,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+}
+
+func (x *ListCrawlResultsRequest) Reset() {
+	*x = ListCrawlResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCrawlResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrawlResultsRequest) ProtoMessage() {}
+
+func (x *ListCrawlResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrawlResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListCrawlResultsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListCrawlResultsRequest) GetCrawlId() string {
+	if x != nil {
+		return x.CrawlId
+	}
+	return ""
+}
+
+func (x *ListCrawlResultsRequest) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
+}
+
+// The response message with a crawl's results, in the order the pages were
+// done.
+type ListCrawlResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ListCrawlResultsResponse) Reset() {
+	*x = ListCrawlResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCrawlResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrawlResultsResponse) ProtoMessage() {}
+
+func (x *ListCrawlResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrawlResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListCrawlResultsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListCrawlResultsResponse) GetResults() []*ItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The request message for retrying the failed pages of a crawl.
+type RetryFailedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A crawl_id, or the batch_id of a GetBatch or warm_id of a WarmFromFile,
+	// whose failed URLs are fetched again in the background, as a
+	// JOB_KIND_RETRY job.  Its status reports them as queued, and the URLs not
+	// retried as failed; those that fail again can be retried later.
+	CrawlId string `protobuf:"bytes,1,opt,name=crawl_id,json=crawlId,proto3" json:"crawl_id,omitempty"`
+	// Only retries failures marked retryable.
+	RetryableOnly bool `protobuf:"varint,2,opt,name=retryable_only,json=retryableOnly,proto3" json:"retryable_only,omitempty"`
+}
+
+func (x *RetryFailedRequest) Reset() {
+	*x = RetryFailedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryFailedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedRequest) ProtoMessage() {}
+
+func (x *RetryFailedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedRequest.ProtoReflect.Descriptor instead.
+func (*RetryFailedRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RetryFailedRequest) GetCrawlId() string {
+	if x != nil {
+		return x.CrawlId
+	}
+	return ""
+}
+
+func (x *RetryFailedRequest) GetRetryableOnly() bool {
+	if x != nil {
+		return x.RetryableOnly
+	}
+	return false
+}
+
+// A piece of a warm file.  The first message also carries the settings for
+// the whole file; they are ignored on later ones.
+type WarmFromFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format WarmFormat `protobuf:"varint,1,opt,name=format,proto3,enum=downloadcache.WarmFormat" json:"format,omitempty"`
+	// Caches the pages for a tenant, as in DownloadCacheRequest.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Options for every URL, as in DownloadCacheRequest.
+	FetchOptions *FetchOptions `protobuf:"bytes,3,opt,name=fetch_options,json=fetchOptions,proto3" json:"fetch_options,omitempty"`
+	CacheOptions *CacheOptions `protobuf:"bytes,4,opt,name=cache_options,json=cacheOptions,proto3" json:"cache_options,omitempty"`
+	// How many URLs to fetch at once.  Zero means 4; at most 64.
+	Concurrency int32 `protobuf:"varint,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// How many URLs from the same hostname to fetch at once.  Zero means 2.
+	PerDomainConcurrency int32 `protobuf:"varint,6,opt,name=per_domain_concurrency,json=perDomainConcurrency,proto3" json:"per_domain_concurrency,omitempty"`
+	// The next piece of the file.  Rows may be split across messages.
+	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *WarmFromFileRequest) Reset() {
+	*x = WarmFromFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarmFromFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmFromFileRequest) ProtoMessage() {}
+
+func (x *WarmFromFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmFromFileRequest.ProtoReflect.Descriptor instead.
+func (*WarmFromFileRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *WarmFromFileRequest) GetFormat() WarmFormat {
+	if x != nil {
+		return x.Format
+	}
+	return WarmFormat_WARM_FORMAT_UNSPECIFIED
+}
+
+func (x *WarmFromFileRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *WarmFromFileRequest) GetFetchOptions() *FetchOptions {
+	if x != nil {
+		return x.FetchOptions
+	}
+	return nil
+}
+
+func (x *WarmFromFileRequest) GetCacheOptions() *CacheOptions {
+	if x != nil {
+		return x.CacheOptions
+	}
+	return nil
+}
+
+func (x *WarmFromFileRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *WarmFromFileRequest) GetPerDomainConcurrency() int32 {
+	if x != nil {
+		return x.PerDomainConcurrency
+	}
+	return 0
+}
+
+func (x *WarmFromFileRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The progress of a warm, sent every second and once it finishes.
+type WarmProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URLs read from the file so far.
+	Queued int64 `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// URLs cached, or already in the cache.
+	Done   int64 `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Failed int64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The URLs (and malformed rows) that failed since the previous message.
+	Failures []*ItemResult `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
+	// Set on the last message, once every URL is done.
+	Finished bool `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	// The warm's ID, the same on every message.  The URLs that failed are kept
+	// for a week under it, for ListCrawlResults and RetryFailed.
+	WarmId string `protobuf:"bytes,6,opt,name=warm_id,json=warmId,proto3" json:"warm_id,omitempty"`
+}
+
+func (x *WarmProgress) Reset() {
+	*x = WarmProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarmProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmProgress) ProtoMessage() {}
+
+func (x *WarmProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmProgress.ProtoReflect.Descriptor instead.
+func (*WarmProgress) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *WarmProgress) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *WarmProgress) GetDone() int64 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *WarmProgress) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *WarmProgress) GetFailures() []*ItemResult {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *WarmProgress) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *WarmProgress) GetWarmId() string {
+	if x != nil {
+		return x.WarmId
+	}
+	return ""
+}
+
+// Work in progress on the server.
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Kind  JobKind `protobuf:"varint,2,opt,name=kind,proto3,enum=downloadcache.JobKind" json:"kind,omitempty"`
+	// The URL fetched or crawled, or for warms, the file format.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Tenant      string `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// The x-caller header of the request that started the job, if any.
+	Caller    string                 `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Progress of warms and crawls: URLs still to do, done, and failed.  For
+	// admin jobs, entries done and failed.
+	Queued int64 `protobuf:"varint,7,opt,name=queued,proto3" json:"queued,omitempty"`
+	Done   int64 `protobuf:"varint,8,opt,name=done,proto3" json:"done,omitempty"`
+	Failed int64 `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Job) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Job) GetKind() JobKind {
+	if x != nil {
+		return x.Kind
+	}
+	return JobKind_JOB_KIND_UNSPECIFIED
+}
+
+func (x *Job) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Job) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *Job) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *Job) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Job) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *Job) GetDone() int64 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *Job) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// The request message for listing jobs.
+type ListJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only lists jobs of this kind; unset lists all of them.
+	Kind JobKind `protobuf:"varint,1,opt,name=kind,proto3,enum=downloadcache.JobKind" json:"kind,omitempty"`
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListJobsRequest) GetKind() JobKind {
+	if x != nil {
+		return x.Kind
+	}
+	return JobKind_JOB_KIND_UNSPECIFIED
+}
+
+// The response message with jobs, longest running first.
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// The request message for canceling a job.
+type CancelJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CancelJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// The response message with the job as it was canceled.
+type CancelJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CancelJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// The request message for a self-test.
+type SelfTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The page to render.  Unset renders a built-in page, checking that its
+	// JavaScript ran.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *SelfTestRequest) Reset() {
+	*x = SelfTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfTestRequest) ProtoMessage() {}
+
+func (x *SelfTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfTestRequest.ProtoReflect.Descriptor instead.
+func (*SelfTestRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SelfTestRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// The response message of a passed self-test.
+type SelfTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The steps run, in order.
+	Steps []*SelfTestStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *SelfTestResponse) Reset() {
+	*x = SelfTestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfTestResponse) ProtoMessage() {}
+
+func (x *SelfTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfTestResponse.ProtoReflect.Descriptor instead.
+func (*SelfTestResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SelfTestResponse) GetSteps() []*SelfTestStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// A step of a self-test: "render", fetching and processing the page, or
+// "store", writing it to storage and reading it back.
+type SelfTestStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// What was checked, e.g. the size of the rendered page.
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *SelfTestStep) Reset() {
+	*x = SelfTestStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfTestStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfTestStep) ProtoMessage() {}
+
+func (x *SelfTestStep) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfTestStep.ProtoReflect.Descriptor instead.
+func (*SelfTestStep) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SelfTestStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelfTestStep) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *SelfTestStep) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// The request message for restoring invalidated copies.
+type RestoreInvalidatedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The page to restore, as requested from Get.  Empty restores every page
+	// of the tenant invalidated since invalidated_after.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The tenant the pages were fetched for, if any.
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Only restore copies replaced at or after this time.
+	InvalidatedAfter *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=invalidated_after,json=invalidatedAfter,proto3" json:"invalidated_after,omitempty"`
+}
+
+func (x *RestoreInvalidatedRequest) Reset() {
+	*x = RestoreInvalidatedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreInvalidatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreInvalidatedRequest) ProtoMessage() {}
+
+func (x *RestoreInvalidatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreInvalidatedRequest.ProtoReflect.Descriptor instead.
+func (*RestoreInvalidatedRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RestoreInvalidatedRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RestoreInvalidatedRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *RestoreInvalidatedRequest) GetInvalidatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvalidatedAfter
+	}
+	return nil
+}
+
+// The response message with what was restored.
+type RestoreInvalidatedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URLs whose copies were restored.
+	Urls []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *RestoreInvalidatedResponse) Reset() {
+	*x = RestoreInvalidatedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreInvalidatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreInvalidatedResponse) ProtoMessage() {}
+
+func (x *RestoreInvalidatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreInvalidatedResponse.ProtoReflect.Descriptor instead.
+func (*RestoreInvalidatedResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RestoreInvalidatedResponse) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// The request message for an admin job.
+type AdminJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation AdminOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=downloadcache.AdminOperation" json:"operation,omitempty"`
+	// Only entries whose URL matches this RE2 regular expression.  Required
+	// for purges; other operations default to every entry.
+	UrlPattern string `protobuf:"bytes,2,opt,name=url_pattern,json=urlPattern,proto3" json:"url_pattern,omitempty"`
+	// For reshards: the key scheme and shard depth the cache used before.
+	FromKeyScheme  string `protobuf:"bytes,3,opt,name=from_key_scheme,json=fromKeyScheme,proto3" json:"from_key_scheme,omitempty"`
+	FromShardDepth int32  `protobuf:"varint,4,opt,name=from_shard_depth,json=fromShardDepth,proto3" json:"from_shard_depth,omitempty"`
+	// Count the entries the job would change, without changing them.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *AdminJobRequest) Reset() {
+	*x = AdminJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminJobRequest) ProtoMessage() {}
+
+func (x *AdminJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminJobRequest.ProtoReflect.Descriptor instead.
+func (*AdminJobRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdminJobRequest) GetOperation() AdminOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return AdminOperation_ADMIN_OPERATION_UNSPECIFIED
+}
+
+func (x *AdminJobRequest) GetUrlPattern() string {
+	if x != nil {
+		return x.UrlPattern
+	}
+	return ""
+}
+
+func (x *AdminJobRequest) GetFromKeyScheme() string {
+	if x != nil {
+		return x.FromKeyScheme
+	}
+	return ""
+}
+
+func (x *AdminJobRequest) GetFromShardDepth() int32 {
+	if x != nil {
+		return x.FromShardDepth
+	}
+	return 0
+}
+
+func (x *AdminJobRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// The progress of an admin job, sent every second and once it finishes.
+type AdminJobProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// Entries gone through without error.
+	Done int64 `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// Of those, entries the operation applied to: purged, recompressed,
+	// moved, or checked against a content hash.
+	Affected int64 `protobuf:"varint,3,opt,name=affected,proto3" json:"affected,omitempty"`
+	Failed   int64 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The entries that failed since the previous message.
+	Failures []*ItemResult `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	// Set on the last message, once the job is over.
+	Finished bool `protobuf:"varint,6,opt,name=finished,proto3" json:"finished,omitempty"`
+	// Set on the last message if the job stopped early: it was canceled, or
+	// the server entered maintenance mode.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AdminJobProgress) Reset() {
+	*x = AdminJobProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminJobProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminJobProgress) ProtoMessage() {}
+
+func (x *AdminJobProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminJobProgress.ProtoReflect.Descriptor instead.
+func (*AdminJobProgress) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AdminJobProgress) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AdminJobProgress) GetDone() int64 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *AdminJobProgress) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *AdminJobProgress) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *AdminJobProgress) GetFailures() []*ItemResult {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *AdminJobProgress) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *AdminJobProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// The request message for a cached page's accessibility tree.
+type GetAccessibilityTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetAccessibilityTreeRequest) Reset() {
+	*x = GetAccessibilityTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccessibilityTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessibilityTreeRequest) ProtoMessage() {}
+
+func (x *GetAccessibilityTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessibilityTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessibilityTreeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetAccessibilityTreeRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetAccessibilityTreeRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// The response message with a cached page's accessibility tree.
+type GetAccessibilityTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The result of the DevTools command Accessibility.getFullAXTree, as JSON:
+	// an object whose "nodes" are the tree's nodes, each naming its children
+	// by nodeId.
+	AccessibilityTree string `protobuf:"bytes,1,opt,name=accessibility_tree,json=accessibilityTree,proto3" json:"accessibility_tree,omitempty"`
+	// When the page and its tree were captured.
+	FetchedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+}
+
+func (x *GetAccessibilityTreeResponse) Reset() {
+	*x = GetAccessibilityTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccessibilityTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessibilityTreeResponse) ProtoMessage() {}
+
+func (x *GetAccessibilityTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessibilityTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessibilityTreeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetAccessibilityTreeResponse) GetAccessibilityTree() string {
+	if x != nil {
+		return x.AccessibilityTree
+	}
+	return ""
+}
+
+func (x *GetAccessibilityTreeResponse) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+// The request message for the failure artifact of a URL.
+type GetFailureArtifactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetFailureArtifactRequest) Reset() {
+	*x = GetFailureArtifactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFailureArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailureArtifactRequest) ProtoMessage() {}
+
+func (x *GetFailureArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailureArtifactRequest.ProtoReflect.Descriptor instead.
+func (*GetFailureArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetFailureArtifactRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetFailureArtifactRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// The response message with what the browser showed when the last fetch of
+// a URL failed.
+type GetFailureArtifactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The error the fetch failed with, and its gRPC status code.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Code  int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// When the fetch failed.
+	FailedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	// The URL the browser was on, after any redirects.
+	PageUrl string `protobuf:"bytes,4,opt,name=page_url,json=pageUrl,proto3" json:"page_url,omitempty"`
+	// The page's HTML as it had rendered; empty if it couldn't be read.
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// A PNG screenshot of the browser window; empty if it couldn't be taken.
+	Screenshot []byte `protobuf:"bytes,6,opt,name=screenshot,proto3" json:"screenshot,omitempty"`
+}
+
+func (x *GetFailureArtifactResponse) Reset() {
+	*x = GetFailureArtifactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_downloadcache_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFailureArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailureArtifactResponse) ProtoMessage() {}
+
+func (x *GetFailureArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_downloadcache_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailureArtifactResponse.ProtoReflect.Descriptor instead.
+func (*GetFailureArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_pb_downloadcache_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetFailureArtifactResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetFailureArtifactResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetFailureArtifactResponse) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
+	}
+	return nil
+}
+
+func (x *GetFailureArtifactResponse) GetPageUrl() string {
+	if x != nil {
+		return x.PageUrl
+	}
+	return ""
+}
+
+func (x *GetFailureArtifactResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *GetFailureArtifactResponse) GetScreenshot() []byte {
+	if x != nil {
+		return x.Screenshot
+	}
+	return nil
+}
+
+var File_pb_downloadcache_proto protoreflect.FileDescriptor
+
+var file_pb_downloadcache_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x04, 0x0a, 0x14, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x94, 0x0a, 0x0a, 0x0c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x0c, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x51, 0x0a, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x55, 0x0a, 0x0e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x02, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x57,
+	0x61, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0c, 0x77, 0x61, 0x69,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2b, 0x0a, 0x0f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x6c,
+	0x65, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04,
+	0x52, 0x0d, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f,
+	0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x05, 0x52, 0x14, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x52, 0x6f, 0x6f, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x12, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x3c, 0x0a, 0x1a,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x77, 0x65, 0x62, 0x5f, 0x76, 0x69, 0x74, 0x61, 0x6c, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x57,
+	0x65, 0x62, 0x56, 0x69, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x63, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x3a, 0x0a,
+	0x0b, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x72,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x3f,
+	0x0a, 0x11, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x40, 0x0a, 0x12, 0x43, 0x68, 0x72, 0x6f, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x6d, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x69, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x73, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f,
+	0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x90, 0x03, 0x0a,
+	0x09, 0x57, 0x65, 0x62, 0x56, 0x69, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x74,
+	0x66, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x74, 0x74, 0x66, 0x62, 0x12, 0x4f, 0x0a, 0x16, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x66, 0x75, 0x6c, 0x5f, 0x70, 0x61,
+	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x66, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x66, 0x75, 0x6c, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x18, 0x6c, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x66, 0x75, 0x6c,
+	0x5f, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x66, 0x75, 0x6c, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x12,
+	0x47, 0x0a, 0x12, 0x64, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x64, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x22,
+	0x8e, 0x02, 0x0a, 0x0c, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x6e, 0x6c, 0x79,
+	0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xb5, 0x06, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x41, 0x0a, 0x0e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x48, 0x6f, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e,
+	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x62, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a,
+	0x07, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53,
+	0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x07,
+	0x73, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x72,
+	0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x37,
+	0x0a, 0x0a, 0x77, 0x65, 0x62, 0x5f, 0x76, 0x69, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x56, 0x69, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x09, 0x77, 0x65,
+	0x62, 0x56, 0x69, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x54, 0x4c, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x74, 0x6c,
+	0x73, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x96, 0x03, 0x0a, 0x0c, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x77, 0x73,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65,
+	0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x70, 0x12, 0x35, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d,
+	0x0a, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x54,
+	0x69, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x22, 0x7d, 0x0a, 0x07, 0x54, 0x4c, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0xd0, 0x01, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6e, 0x6f,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x0f, 0x53, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x47, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x53, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x40, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x22, 0x0a, 0x0c, 0x53, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x38, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0xc9, 0x06, 0x0a, 0x06, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x2f, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x36, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61,
+	0x69, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x57, 0x61, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x69, 0x66, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x69, 0x66, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73,
+	0x12, 0x43, 0x0a, 0x10, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x70, 0x75, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x43, 0x70,
+	0x75, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72,
+	0x5f, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x62, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x48, 0x65, 0x61, 0x70, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x22, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x48, 0x6f, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x69, 0x74, 0x65,
+	0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x74,
+	0x65, 0x6d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x61, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61,
+	0x73, 0x74, 0x6d, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x66,
+	0x72, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x66, 0x72, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70,
+	0x22, 0x9a, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x69, 0x74, 0x65, 0x6d, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x6d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x41, 0x0a,
+	0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x22, 0x90, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x15,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x66,
+	0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xfd,
+	0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x3f,
+	0x0a, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x41, 0x0a, 0x0e, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8b,
+	0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x22, 0xa1, 0x01, 0x0a,
+	0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x22, 0x8e, 0x03, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x72, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x72, 0x6c, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x54, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x1a, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x18, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
+	0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xd7, 0x05, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x67,
+	0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c,
+	0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x12, 0x37, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x5f, 0x76, 0x69, 0x74, 0x61, 0x6c,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x56, 0x69, 0x74, 0x61, 0x6c,
+	0x73, 0x52, 0x09, 0x77, 0x65, 0x62, 0x56, 0x69, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x03,
+	0x74, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x54, 0x4c, 0x53, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x0b, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x73, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x64, 0x65,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x61, 0x6b,
+	0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12,
+	0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x53, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x61, 0x73, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x22, 0x6d, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x77, 0x61, 0x73, 0x49, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x66, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x45, 0x76, 0x65, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x72, 0x79, 0x22, 0x53,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c,
+	0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x73, 0x5f, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x73, 0x48, 0x65, 0x6c, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x7c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x1a,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x0c, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x52, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x07, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22,
+	0x35, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x73, 0x5f, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61, 0x73,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0xb2, 0x02, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0c, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a,
+	0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x78, 0x0a, 0x0c, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57,
+	0x69, 0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x22, 0xe2, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x12, 0x40, 0x0a, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f,
+	0x6b, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa6, 0x03, 0x0a, 0x0b, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x73, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x2e,
+	0x0a, 0x11, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x49, 0x64, 0x22, 0x2f,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x49, 0x64, 0x22,
+	0x2e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x49, 0x64, 0x22,
+	0x48, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x67, 0x7a, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x73, 0x61, 0x76, 0x65, 0x22, 0x59, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x4f,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x56, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61,
+	0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xd0, 0x02, 0x0a, 0x13, 0x57, 0x61, 0x72, 0x6d,
+	0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x57, 0x61, 0x72, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0d,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x34, 0x0a, 0x16, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x14, 0x70, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbe, 0x01, 0x0a, 0x0c, 0x57,
+	0x61, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x35, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x03,
+	0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x3a, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f,
+	0x62, 0x73, 0x22, 0x29, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x39, 0x0a,
+	0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x23, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x66,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x45, 0x0a,
+	0x10, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x22, 0x71, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x8e, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x47, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x30, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x72, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x75, 0x72, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x26, 0x0a, 0x0f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x65, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x66, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x64, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xda, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x0a, 0x06,
+	0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x88, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x72, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22,
+	0xd4, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x2a, 0x4d, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x49,
+	0x44, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x45, 0x54,
+	0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x4c, 0x4f,
+	0x57, 0x5f, 0x33, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
+	0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x5f, 0x33,
+	0x47, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x5f, 0x34, 0x47, 0x10, 0x03,
+	0x2a, 0x4e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x10, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x55,
+	0x54, 0x4f, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x53, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x02,
+	0x2a, 0x65, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x15,
+	0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c,
+	0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x2a, 0x70, 0x0a, 0x0a, 0x43, 0x72, 0x61, 0x77, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x52, 0x41, 0x57, 0x4c, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x52, 0x41, 0x57, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43,
+	0x52, 0x41, 0x57, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x52, 0x41, 0x57, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x2a, 0x5e, 0x0a, 0x0c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x57, 0x41, 0x52, 0x43, 0x10, 0x02, 0x2a, 0x55, 0x0a, 0x0a, 0x57, 0x61, 0x72,
+	0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x41, 0x52, 0x4d, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x41, 0x52, 0x4d, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x41, 0x52,
+	0x4d, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02,
+	0x2a, 0x86, 0x01, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x14,
+	0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4a, 0x4f,
+	0x42, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x57, 0x41, 0x52, 0x4d, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x52, 0x41, 0x57, 0x4c, 0x10,
+	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x44,
+	0x4d, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4a, 0x4f, 0x42, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x05, 0x2a, 0xa5, 0x01, 0x0a, 0x0e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b,
+	0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x55, 0x52, 0x47, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x44, 0x4d, 0x49,
+	0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x44, 0x4d, 0x49,
+	0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x48,
+	0x41, 0x52, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x10,
+	0x04, 0x32, 0x91, 0x1a, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x50, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x23, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x53, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53,
+	0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x53, 0x69, 0x74, 0x65, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1c, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x1a,
+	0x1e, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x5d, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72, 0x62,
+	0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72, 0x62, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x21, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c,
+	0x6f, 0x61, 0x64, 0x12, 0x23, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52,
+	0x4c, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5d, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c,
+	0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64,
+	0x12, 0x22, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x27, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x22, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x69, 0x74, 0x68, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x72, 0x61, 0x77,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x43, 0x72, 0x61, 0x77, 0x6c, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x72, 0x61, 0x77, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x72, 0x61,
+	0x77, 0x6c, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x51, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x12,
+	0x20, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72,
+	0x61, 0x77, 0x6c, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x72, 0x61,
+	0x77, 0x6c, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x0c,
+	0x57, 0x61, 0x72, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x57, 0x61, 0x72,
+	0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x57, 0x61, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x1e, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x1f, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x53, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x53, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x53, 0x70, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12,
+	0x4b, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x66, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x6c, 0x66,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x65, 0x6c, 0x66,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x12,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x1e, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65,
+	0x65, 0x12, 0x2a, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72,
+	0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x12, 0x28, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x75, 0x72, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pb_downloadcache_proto_rawDescOnce sync.Once
+	file_pb_downloadcache_proto_rawDescData = file_pb_downloadcache_proto_rawDesc
+)
+
+func file_pb_downloadcache_proto_rawDescGZIP() []byte {
+	file_pb_downloadcache_proto_rawDescOnce.Do(func() {
+		file_pb_downloadcache_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_downloadcache_proto_rawDescData)
+	})
+	return file_pb_downloadcache_proto_rawDescData
+}
+
+var file_pb_downloadcache_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_pb_downloadcache_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_pb_downloadcache_proto_goTypes = []interface{}{
+	(WaitStrategy)(0),                    // 0: downloadcache.WaitStrategy
+	(NetworkProfile)(0),                  // 1: downloadcache.NetworkProfile
+	(RenderMode)(0),                      // 2: downloadcache.RenderMode
+	(LogLevel)(0),                        // 3: downloadcache.LogLevel
+	(CrawlState)(0),                      // 4: downloadcache.CrawlState
+	(ExportFormat)(0),                    // 5: downloadcache.ExportFormat
+	(WarmFormat)(0),                      // 6: downloadcache.WarmFormat
+	(JobKind)(0),                         // 7: downloadcache.JobKind
+	(AdminOperation)(0),                  // 8: downloadcache.AdminOperation
+	(*DownloadCacheRequest)(nil),         // 9: downloadcache.DownloadCacheRequest
+	(*FetchOptions)(nil),                 // 10: downloadcache.FetchOptions
+	(*WebVitals)(nil),                    // 11: downloadcache.WebVitals
+	(*CacheOptions)(nil),                 // 12: downloadcache.CacheOptions
+	(*DownloadCacheResponse)(nil),        // 13: downloadcache.DownloadCacheResponse
+	(*FetchReceipt)(nil),                 // 14: downloadcache.FetchReceipt
+	(*TLSInfo)(nil),                      // 15: downloadcache.TLSInfo
+	(*Certificate)(nil),                  // 16: downloadcache.Certificate
+	(*SpilledContents)(nil),              // 17: downloadcache.SpilledContents
+	(*ReadSpilledRequest)(nil),           // 18: downloadcache.ReadSpilledRequest
+	(*GetBatchRequest)(nil),              // 19: downloadcache.GetBatchRequest
+	(*GetBatchResult)(nil),               // 20: downloadcache.GetBatchResult
+	(*DownloadCacheChunk)(nil),           // 21: downloadcache.DownloadCacheChunk
+	(*SpilledChunk)(nil),                 // 22: downloadcache.SpilledChunk
+	(*GetByHashRequest)(nil),             // 23: downloadcache.GetByHashRequest
+	(*GetByHashResponse)(nil),            // 24: downloadcache.GetByHashResponse
+	(*Timing)(nil),                       // 25: downloadcache.Timing
+	(*RedirectHop)(nil),                  // 26: downloadcache.RedirectHop
+	(*ParseSitemapRequest)(nil),          // 27: downloadcache.ParseSitemapRequest
+	(*SitemapEntry)(nil),                 // 28: downloadcache.SitemapEntry
+	(*ParseSitemapResponse)(nil),         // 29: downloadcache.ParseSitemapResponse
+	(*BackupRequest)(nil),                // 30: downloadcache.BackupRequest
+	(*BackupEntry)(nil),                  // 31: downloadcache.BackupEntry
+	(*RestoreResponse)(nil),              // 32: downloadcache.RestoreResponse
+	(*CollectGarbageRequest)(nil),        // 33: downloadcache.CollectGarbageRequest
+	(*CollectGarbageResponse)(nil),       // 34: downloadcache.CollectGarbageResponse
+	(*GetMetadataRequest)(nil),           // 35: downloadcache.GetMetadataRequest
+	(*GetMetadataResponse)(nil),          // 36: downloadcache.GetMetadataResponse
+	(*SearchRequest)(nil),                // 37: downloadcache.SearchRequest
+	(*SearchResponse)(nil),               // 38: downloadcache.SearchResponse
+	(*SearchResult)(nil),                 // 39: downloadcache.SearchResult
+	(*ListEntriesRequest)(nil),           // 40: downloadcache.ListEntriesRequest
+	(*CacheEntry)(nil),                   // 41: downloadcache.CacheEntry
+	(*ListEntriesResponse)(nil),          // 42: downloadcache.ListEntriesResponse
+	(*GetDomainStatsRequest)(nil),        // 43: downloadcache.GetDomainStatsRequest
+	(*DomainStats)(nil),                  // 44: downloadcache.DomainStats
+	(*GetDomainStatsResponse)(nil),       // 45: downloadcache.GetDomainStatsResponse
+	(*GetRenderLoadRequest)(nil),         // 46: downloadcache.GetRenderLoadRequest
+	(*GetRenderLoadResponse)(nil),        // 47: downloadcache.GetRenderLoadResponse
+	(*CreateSignedURLRequest)(nil),       // 48: downloadcache.CreateSignedURLRequest
+	(*CreateSignedURLResponse)(nil),      // 49: downloadcache.CreateSignedURLResponse
+	(*SetOfflineModeRequest)(nil),        // 50: downloadcache.SetOfflineModeRequest
+	(*SetOfflineModeResponse)(nil),       // 51: downloadcache.SetOfflineModeResponse
+	(*SetMaintenanceModeRequest)(nil),    // 52: downloadcache.SetMaintenanceModeRequest
+	(*SetMaintenanceModeResponse)(nil),   // 53: downloadcache.SetMaintenanceModeResponse
+	(*SetLogLevelRequest)(nil),           // 54: downloadcache.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),          // 55: downloadcache.SetLogLevelResponse
+	(*SetLegalHoldRequest)(nil),          // 56: downloadcache.SetLegalHoldRequest
+	(*SetLegalHoldResponse)(nil),         // 57: downloadcache.SetLegalHoldResponse
+	(*GetUsageReportRequest)(nil),        // 58: downloadcache.GetUsageReportRequest
+	(*CallerUsage)(nil),                  // 59: downloadcache.CallerUsage
+	(*GetUsageReportResponse)(nil),       // 60: downloadcache.GetUsageReportResponse
+	(*ListPausedDomainsRequest)(nil),     // 61: downloadcache.ListPausedDomainsRequest
+	(*PausedDomain)(nil),                 // 62: downloadcache.PausedDomain
+	(*ListPausedDomainsResponse)(nil),    // 63: downloadcache.ListPausedDomainsResponse
+	(*ResumeDomainRequest)(nil),          // 64: downloadcache.ResumeDomainRequest
+	(*ResumeDomainResponse)(nil),         // 65: downloadcache.ResumeDomainResponse
+	(*FetchWithAssetsRequest)(nil),       // 66: downloadcache.FetchWithAssetsRequest
+	(*FetchedAsset)(nil),                 // 67: downloadcache.FetchedAsset
+	(*FetchWithAssetsResponse)(nil),      // 68: downloadcache.FetchWithAssetsResponse
+	(*StartCrawlRequest)(nil),            // 69: downloadcache.StartCrawlRequest
+	(*CrawlStatus)(nil),                  // 70: downloadcache.CrawlStatus
+	(*PauseCrawlRequest)(nil),            // 71: downloadcache.PauseCrawlRequest
+	(*ResumeCrawlRequest)(nil),           // 72: downloadcache.ResumeCrawlRequest
+	(*ListCrawlsRequest)(nil),            // 73: downloadcache.ListCrawlsRequest
+	(*ListCrawlsResponse)(nil),           // 74: downloadcache.ListCrawlsResponse
+	(*ExportCrawlRequest)(nil),           // 75: downloadcache.ExportCrawlRequest
+	(*ExportCrawlChunk)(nil),             // 76: downloadcache.ExportCrawlChunk
+	(*ItemResult)(nil),                   // 77: downloadcache.ItemResult
+	(*ListCrawlResultsRequest)(nil),      // 78: downloadcache.ListCrawlResultsRequest
+	(*ListCrawlResultsResponse)(nil),     // 79: downloadcache.ListCrawlResultsResponse
+	(*RetryFailedRequest)(nil),           // 80: downloadcache.RetryFailedRequest
+	(*WarmFromFileRequest)(nil),          // 81: downloadcache.WarmFromFileRequest
+	(*WarmProgress)(nil),                 // 82: downloadcache.WarmProgress
+	(*Job)(nil),                          // 83: downloadcache.Job
+	(*ListJobsRequest)(nil),              // 84: downloadcache.ListJobsRequest
+	(*ListJobsResponse)(nil),             // 85: downloadcache.ListJobsResponse
+	(*CancelJobRequest)(nil),             // 86: downloadcache.CancelJobRequest
+	(*CancelJobResponse)(nil),            // 87: downloadcache.CancelJobResponse
+	(*SelfTestRequest)(nil),              // 88: downloadcache.SelfTestRequest
+	(*SelfTestResponse)(nil),             // 89: downloadcache.SelfTestResponse
+	(*SelfTestStep)(nil),                 // 90: downloadcache.SelfTestStep
+	(*RestoreInvalidatedRequest)(nil),    // 91: downloadcache.RestoreInvalidatedRequest
+	(*RestoreInvalidatedResponse)(nil),   // 92: downloadcache.RestoreInvalidatedResponse
+	(*AdminJobRequest)(nil),              // 93: downloadcache.AdminJobRequest
+	(*AdminJobProgress)(nil),             // 94: downloadcache.AdminJobProgress
+	(*GetAccessibilityTreeRequest)(nil),  // 95: downloadcache.GetAccessibilityTreeRequest
+	(*GetAccessibilityTreeResponse)(nil), // 96: downloadcache.GetAccessibilityTreeResponse
+	(*GetFailureArtifactRequest)(nil),    // 97: downloadcache.GetFailureArtifactRequest
+	(*GetFailureArtifactResponse)(nil),   // 98: downloadcache.GetFailureArtifactResponse
+	nil,                                  // 99: downloadcache.FetchOptions.CapabilitiesEntry
+	nil,                                  // 100: downloadcache.FetchOptions.ChromeOptionsEntry
+	nil,                                  // 101: downloadcache.CacheOptions.AnnotationsEntry
+	nil,                                  // 102: downloadcache.ListEntriesRequest.AnnotationsEntry
+	nil,                                  // 103: downloadcache.CacheEntry.AnnotationsEntry
+	(*durationpb.Duration)(nil),          // 104: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 105: google.protobuf.Timestamp
+}
+var file_pb_downloadcache_proto_depIdxs = []int32{
+	10,  // 0: downloadcache.DownloadCacheRequest.fetch_options:type_name -> downloadcache.FetchOptions
+	12,  // 1: downloadcache.DownloadCacheRequest.cache_options:type_name -> downloadcache.CacheOptions
+	99,  // 2: downloadcache.FetchOptions.capabilities:type_name -> downloadcache.FetchOptions.CapabilitiesEntry
+	100, // 3: downloadcache.FetchOptions.chrome_options:type_name -> downloadcache.FetchOptions.ChromeOptionsEntry
+	1,   // 4: downloadcache.FetchOptions.network_profile:type_name -> downloadcache.NetworkProfile
+	0,   // 5: downloadcache.FetchOptions.wait_strategy:type_name -> downloadcache.WaitStrategy
+	2,   // 6: downloadcache.FetchOptions.render_mode:type_name -> downloadcache.RenderMode
+	104, // 7: downloadcache.WebVitals.ttfb:type_name -> google.protobuf.Duration
+	104, // 8: downloadcache.WebVitals.first_contentful_paint:type_name -> google.protobuf.Duration
+	104, // 9: downloadcache.WebVitals.largest_contentful_paint:type_name -> google.protobuf.Duration
+	104, // 10: downloadcache.WebVitals.dom_content_loaded:type_name -> google.protobuf.Duration
+	104, // 11: downloadcache.WebVitals.load:type_name -> google.protobuf.Duration
+	101, // 12: downloadcache.CacheOptions.annotations:type_name -> downloadcache.CacheOptions.AnnotationsEntry
+	26,  // 13: downloadcache.DownloadCacheResponse.redirect_chain:type_name -> downloadcache.RedirectHop
+	105, // 14: downloadcache.DownloadCacheResponse.fetched_at:type_name -> google.protobuf.Timestamp
+	25,  // 15: downloadcache.DownloadCacheResponse.timing:type_name -> downloadcache.Timing
+	105, // 16: downloadcache.DownloadCacheResponse.archived_at:type_name -> google.protobuf.Timestamp
+	17,  // 17: downloadcache.DownloadCacheResponse.spilled:type_name -> downloadcache.SpilledContents
+	105, // 18: downloadcache.DownloadCacheResponse.revalidate_after:type_name -> google.protobuf.Timestamp
+	11,  // 19: downloadcache.DownloadCacheResponse.web_vitals:type_name -> downloadcache.WebVitals
+	15,  // 20: downloadcache.DownloadCacheResponse.tls:type_name -> downloadcache.TLSInfo
+	14,  // 21: downloadcache.DownloadCacheResponse.receipt:type_name -> downloadcache.FetchReceipt
+	10,  // 22: downloadcache.FetchReceipt.options:type_name -> downloadcache.FetchOptions
+	105, // 23: downloadcache.FetchReceipt.started_at:type_name -> google.protobuf.Timestamp
+	25,  // 24: downloadcache.FetchReceipt.timing:type_name -> downloadcache.Timing
+	16,  // 25: downloadcache.TLSInfo.certificates:type_name -> downloadcache.Certificate
+	105, // 26: downloadcache.Certificate.not_before:type_name -> google.protobuf.Timestamp
+	105, // 27: downloadcache.Certificate.not_after:type_name -> google.protobuf.Timestamp
+	105, // 28: downloadcache.SpilledContents.expires_at:type_name -> google.protobuf.Timestamp
+	10,  // 29: downloadcache.GetBatchRequest.fetch_options:type_name -> downloadcache.FetchOptions
+	12,  // 30: downloadcache.GetBatchRequest.cache_options:type_name -> downloadcache.CacheOptions
+	77,  // 31: downloadcache.GetBatchResult.result:type_name -> downloadcache.ItemResult
+	13,  // 32: downloadcache.GetBatchResult.response:type_name -> downloadcache.DownloadCacheResponse
+	13,  // 33: downloadcache.DownloadCacheChunk.response:type_name -> downloadcache.DownloadCacheResponse
+	13,  // 34: downloadcache.GetByHashResponse.page:type_name -> downloadcache.DownloadCacheResponse
+	104, // 35: downloadcache.Timing.total:type_name -> google.protobuf.Duration
+	104, // 36: downloadcache.Timing.lock_wait:type_name -> google.protobuf.Duration
+	104, // 37: downloadcache.Timing.queue_wait:type_name -> google.protobuf.Duration
+	104, // 38: downloadcache.Timing.session_create:type_name -> google.protobuf.Duration
+	104, // 39: downloadcache.Timing.navigation:type_name -> google.protobuf.Duration
+	104, // 40: downloadcache.Timing.render_wait:type_name -> google.protobuf.Duration
+	104, // 41: downloadcache.Timing.capture:type_name -> google.protobuf.Duration
+	104, // 42: downloadcache.Timing.minify:type_name -> google.protobuf.Duration
+	104, // 43: downloadcache.Timing.compress:type_name -> google.protobuf.Duration
+	104, // 44: downloadcache.Timing.store:type_name -> google.protobuf.Duration
+	104, // 45: downloadcache.Timing.cache_read:type_name -> google.protobuf.Duration
+	104, // 46: downloadcache.Timing.processors:type_name -> google.protobuf.Duration
+	104, // 47: downloadcache.Timing.browser_cpu_time:type_name -> google.protobuf.Duration
+	28,  // 48: downloadcache.ParseSitemapResponse.entries:type_name -> downloadcache.SitemapEntry
+	105, // 49: downloadcache.BackupRequest.since:type_name -> google.protobuf.Timestamp
+	105, // 50: downloadcache.BackupEntry.fetched_at:type_name -> google.protobuf.Timestamp
+	41,  // 51: downloadcache.GetMetadataResponse.entry:type_name -> downloadcache.CacheEntry
+	105, // 52: downloadcache.SearchRequest.fetched_after:type_name -> google.protobuf.Timestamp
+	105, // 53: downloadcache.SearchRequest.fetched_before:type_name -> google.protobuf.Timestamp
+	39,  // 54: downloadcache.SearchResponse.results:type_name -> downloadcache.SearchResult
+	105, // 55: downloadcache.SearchResult.fetched_at:type_name -> google.protobuf.Timestamp
+	102, // 56: downloadcache.ListEntriesRequest.annotations:type_name -> downloadcache.ListEntriesRequest.AnnotationsEntry
+	105, // 57: downloadcache.ListEntriesRequest.certificate_expires_before:type_name -> google.protobuf.Timestamp
+	105, // 58: downloadcache.CacheEntry.fetched_at:type_name -> google.protobuf.Timestamp
+	105, // 59: downloadcache.CacheEntry.last_accessed_at:type_name -> google.protobuf.Timestamp
+	103, // 60: downloadcache.CacheEntry.annotations:type_name -> downloadcache.CacheEntry.AnnotationsEntry
+	104, // 61: downloadcache.CacheEntry.ttl:type_name -> google.protobuf.Duration
+	11,  // 62: downloadcache.CacheEntry.web_vitals:type_name -> downloadcache.WebVitals
+	15,  // 63: downloadcache.CacheEntry.tls:type_name -> downloadcache.TLSInfo
+	14,  // 64: downloadcache.CacheEntry.receipt:type_name -> downloadcache.FetchReceipt
+	41,  // 65: downloadcache.ListEntriesResponse.entries:type_name -> downloadcache.CacheEntry
+	44,  // 66: downloadcache.GetDomainStatsResponse.domains:type_name -> downloadcache.DomainStats
+	105, // 67: downloadcache.CreateSignedURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	3,   // 68: downloadcache.SetLogLevelRequest.level:type_name -> downloadcache.LogLevel
+	3,   // 69: downloadcache.SetLogLevelResponse.level:type_name -> downloadcache.LogLevel
+	105, // 70: downloadcache.SetLegalHoldResponse.fetched_at:type_name -> google.protobuf.Timestamp
+	104, // 71: downloadcache.CallerUsage.fetch_time:type_name -> google.protobuf.Duration
+	59,  // 72: downloadcache.GetUsageReportResponse.usage:type_name -> downloadcache.CallerUsage
+	105, // 73: downloadcache.GetUsageReportResponse.since:type_name -> google.protobuf.Timestamp
+	105, // 74: downloadcache.PausedDomain.paused_until:type_name -> google.protobuf.Timestamp
+	62,  // 75: downloadcache.ListPausedDomainsResponse.domains:type_name -> downloadcache.PausedDomain
+	10,  // 76: downloadcache.FetchWithAssetsRequest.fetch_options:type_name -> downloadcache.FetchOptions
+	12,  // 77: downloadcache.FetchWithAssetsRequest.cache_options:type_name -> downloadcache.CacheOptions
+	13,  // 78: downloadcache.FetchWithAssetsResponse.page:type_name -> downloadcache.DownloadCacheResponse
+	67,  // 79: downloadcache.FetchWithAssetsResponse.assets:type_name -> downloadcache.FetchedAsset
+	10,  // 80: downloadcache.StartCrawlRequest.fetch_options:type_name -> downloadcache.FetchOptions
+	4,   // 81: downloadcache.CrawlStatus.state:type_name -> downloadcache.CrawlState
+	105, // 82: downloadcache.CrawlStatus.started_at:type_name -> google.protobuf.Timestamp
+	105, // 83: downloadcache.CrawlStatus.updated_at:type_name -> google.protobuf.Timestamp
+	70,  // 84: downloadcache.ListCrawlsResponse.crawls:type_name -> downloadcache.CrawlStatus
+	5,   // 85: downloadcache.ExportCrawlRequest.format:type_name -> downloadcache.ExportFormat
+	104, // 86: downloadcache.ItemResult.duration:type_name -> google.protobuf.Duration
+	77,  // 87: downloadcache.ListCrawlResultsResponse.results:type_name -> downloadcache.ItemResult
+	6,   // 88: downloadcache.WarmFromFileRequest.format:type_name -> downloadcache.WarmFormat
+	10,  // 89: downloadcache.WarmFromFileRequest.fetch_options:type_name -> downloadcache.FetchOptions
+	12,  // 90: downloadcache.WarmFromFileRequest.cache_options:type_name -> downloadcache.CacheOptions
+	77,  // 91: downloadcache.WarmProgress.failures:type_name -> downloadcache.ItemResult
+	7,   // 92: downloadcache.Job.kind:type_name -> downloadcache.JobKind
+	105, // 93: downloadcache.Job.started_at:type_name -> google.protobuf.Timestamp
+	7,   // 94: downloadcache.ListJobsRequest.kind:type_name -> downloadcache.JobKind
+	83,  // 95: downloadcache.ListJobsResponse.jobs:type_name -> downloadcache.Job
+	83,  // 96: downloadcache.CancelJobResponse.job:type_name -> downloadcache.Job
+	90,  // 97: downloadcache.SelfTestResponse.steps:type_name -> downloadcache.SelfTestStep
+	104, // 98: downloadcache.SelfTestStep.duration:type_name -> google.protobuf.Duration
+	105, // 99: downloadcache.RestoreInvalidatedRequest.invalidated_after:type_name -> google.protobuf.Timestamp
+	8,   // 100: downloadcache.AdminJobRequest.operation:type_name -> downloadcache.AdminOperation
+	77,  // 101: downloadcache.AdminJobProgress.failures:type_name -> downloadcache.ItemResult
+	105, // 102: downloadcache.GetAccessibilityTreeResponse.fetched_at:type_name -> google.protobuf.Timestamp
+	105, // 103: downloadcache.GetFailureArtifactResponse.failed_at:type_name -> google.protobuf.Timestamp
+	9,   // 104: downloadcache.DownloadCache.Get:input_type -> downloadcache.DownloadCacheRequest
+	9,   // 105: downloadcache.DownloadCache.GetStream:input_type -> downloadcache.DownloadCacheRequest
+	19,  // 106: downloadcache.DownloadCache.GetBatch:input_type -> downloadcache.GetBatchRequest
+	23,  // 107: downloadcache.DownloadCache.GetByHash:input_type -> downloadcache.GetByHashRequest
+	27,  // 108: downloadcache.DownloadCache.ParseSitemap:input_type -> downloadcache.ParseSitemapRequest
+	30,  // 109: downloadcache.DownloadCache.Backup:input_type -> downloadcache.BackupRequest
+	31,  // 110: downloadcache.DownloadCache.Restore:input_type -> downloadcache.BackupEntry
+	33,  // 111: downloadcache.DownloadCache.CollectGarbage:input_type -> downloadcache.CollectGarbageRequest
+	40,  // 112: downloadcache.DownloadCache.ListEntries:input_type -> downloadcache.ListEntriesRequest
+	35,  // 113: downloadcache.DownloadCache.GetMetadata:input_type -> downloadcache.GetMetadataRequest
+	37,  // 114: downloadcache.DownloadCache.Search:input_type -> downloadcache.SearchRequest
+	43,  // 115: downloadcache.DownloadCache.GetDomainStats:input_type -> downloadcache.GetDomainStatsRequest
+	46,  // 116: downloadcache.DownloadCache.GetRenderLoad:input_type -> downloadcache.GetRenderLoadRequest
+	48,  // 117: downloadcache.DownloadCache.CreateSignedURL:input_type -> downloadcache.CreateSignedURLRequest
+	50,  // 118: downloadcache.DownloadCache.SetOfflineMode:input_type -> downloadcache.SetOfflineModeRequest
+	52,  // 119: downloadcache.DownloadCache.SetMaintenanceMode:input_type -> downloadcache.SetMaintenanceModeRequest
+	54,  // 120: downloadcache.DownloadCache.SetLogLevel:input_type -> downloadcache.SetLogLevelRequest
+	56,  // 121: downloadcache.DownloadCache.SetLegalHold:input_type -> downloadcache.SetLegalHoldRequest
+	58,  // 122: downloadcache.DownloadCache.GetUsageReport:input_type -> downloadcache.GetUsageReportRequest
+	61,  // 123: downloadcache.DownloadCache.ListPausedDomains:input_type -> downloadcache.ListPausedDomainsRequest
+	64,  // 124: downloadcache.DownloadCache.ResumeDomain:input_type -> downloadcache.ResumeDomainRequest
+	66,  // 125: downloadcache.DownloadCache.FetchWithAssets:input_type -> downloadcache.FetchWithAssetsRequest
+	69,  // 126: downloadcache.DownloadCache.StartCrawl:input_type -> downloadcache.StartCrawlRequest
+	71,  // 127: downloadcache.DownloadCache.PauseCrawl:input_type -> downloadcache.PauseCrawlRequest
+	72,  // 128: downloadcache.DownloadCache.ResumeCrawl:input_type -> downloadcache.ResumeCrawlRequest
+	73,  // 129: downloadcache.DownloadCache.ListCrawls:input_type -> downloadcache.ListCrawlsRequest
+	75,  // 130: downloadcache.DownloadCache.ExportCrawl:input_type -> downloadcache.ExportCrawlRequest
+	78,  // 131: downloadcache.DownloadCache.ListCrawlResults:input_type -> downloadcache.ListCrawlResultsRequest
+	80,  // 132: downloadcache.DownloadCache.RetryFailed:input_type -> downloadcache.RetryFailedRequest
+	81,  // 133: downloadcache.DownloadCache.WarmFromFile:input_type -> downloadcache.WarmFromFileRequest
+	84,  // 134: downloadcache.DownloadCache.ListJobs:input_type -> downloadcache.ListJobsRequest
+	86,  // 135: downloadcache.DownloadCache.CancelJob:input_type -> downloadcache.CancelJobRequest
+	18,  // 136: downloadcache.DownloadCache.ReadSpilled:input_type -> downloadcache.ReadSpilledRequest
+	88,  // 137: downloadcache.DownloadCache.SelfTest:input_type -> downloadcache.SelfTestRequest
+	91,  // 138: downloadcache.DownloadCache.RestoreInvalidated:input_type -> downloadcache.RestoreInvalidatedRequest
+	93,  // 139: downloadcache.DownloadCache.RunAdminJob:input_type -> downloadcache.AdminJobRequest
+	95,  // 140: downloadcache.DownloadCache.GetAccessibilityTree:input_type -> downloadcache.GetAccessibilityTreeRequest
+	97,  // 141: downloadcache.DownloadCache.GetFailureArtifact:input_type -> downloadcache.GetFailureArtifactRequest
+	13,  // 142: downloadcache.DownloadCache.Get:output_type -> downloadcache.DownloadCacheResponse
+	21,  // 143: downloadcache.DownloadCache.GetStream:output_type -> downloadcache.DownloadCacheChunk
+	20,  // 144: downloadcache.DownloadCache.GetBatch:output_type -> downloadcache.GetBatchResult
+	24,  // 145: downloadcache.DownloadCache.GetByHash:output_type -> downloadcache.GetByHashResponse
+	29,  // 146: downloadcache.DownloadCache.ParseSitemap:output_type -> downloadcache.ParseSitemapResponse
+	31,  // 147: downloadcache.DownloadCache.Backup:output_type -> downloadcache.BackupEntry
+	32,  // 148: downloadcache.DownloadCache.Restore:output_type -> downloadcache.RestoreResponse
+	34,  // 149: downloadcache.DownloadCache.CollectGarbage:output_type -> downloadcache.CollectGarbageResponse
+	42,  // 150: downloadcache.DownloadCache.ListEntries:output_type -> downloadcache.ListEntriesResponse
+	36,  // 151: downloadcache.DownloadCache.GetMetadata:output_type -> downloadcache.GetMetadataResponse
+	38,  // 152: downloadcache.DownloadCache.Search:output_type -> downloadcache.SearchResponse
+	45,  // 153: downloadcache.DownloadCache.GetDomainStats:output_type -> downloadcache.GetDomainStatsResponse
+	47,  // 154: downloadcache.DownloadCache.GetRenderLoad:output_type -> downloadcache.GetRenderLoadResponse
+	49,  // 155: downloadcache.DownloadCache.CreateSignedURL:output_type -> downloadcache.CreateSignedURLResponse
+	51,  // 156: downloadcache.DownloadCache.SetOfflineMode:output_type -> downloadcache.SetOfflineModeResponse
+	53,  // 157: downloadcache.DownloadCache.SetMaintenanceMode:output_type -> downloadcache.SetMaintenanceModeResponse
+	55,  // 158: downloadcache.DownloadCache.SetLogLevel:output_type -> downloadcache.SetLogLevelResponse
+	57,  // 159: downloadcache.DownloadCache.SetLegalHold:output_type -> downloadcache.SetLegalHoldResponse
+	60,  // 160: downloadcache.DownloadCache.GetUsageReport:output_type -> downloadcache.GetUsageReportResponse
+	63,  // 161: downloadcache.DownloadCache.ListPausedDomains:output_type -> downloadcache.ListPausedDomainsResponse
+	65,  // 162: downloadcache.DownloadCache.ResumeDomain:output_type -> downloadcache.ResumeDomainResponse
+	68,  // 163: downloadcache.DownloadCache.FetchWithAssets:output_type -> downloadcache.FetchWithAssetsResponse
+	70,  // 164: downloadcache.DownloadCache.StartCrawl:output_type -> downloadcache.CrawlStatus
+	70,  // 165: downloadcache.DownloadCache.PauseCrawl:output_type -> downloadcache.CrawlStatus
+	70,  // 166: downloadcache.DownloadCache.ResumeCrawl:output_type -> downloadcache.CrawlStatus
+	74,  // 167: downloadcache.DownloadCache.ListCrawls:output_type -> downloadcache.ListCrawlsResponse
+	76,  // 168: downloadcache.DownloadCache.ExportCrawl:output_type -> downloadcache.ExportCrawlChunk
+	79,  // 169: downloadcache.DownloadCache.ListCrawlResults:output_type -> downloadcache.ListCrawlResultsResponse
+	70,  // 170: downloadcache.DownloadCache.RetryFailed:output_type -> downloadcache.CrawlStatus
+	82,  // 171: downloadcache.DownloadCache.WarmFromFile:output_type -> downloadcache.WarmProgress
+	85,  // 172: downloadcache.DownloadCache.ListJobs:output_type -> downloadcache.ListJobsResponse
+	87,  // 173: downloadcache.DownloadCache.CancelJob:output_type -> downloadcache.CancelJobResponse
+	22,  // 174: downloadcache.DownloadCache.ReadSpilled:output_type -> downloadcache.SpilledChunk
+	89,  // 175: downloadcache.DownloadCache.SelfTest:output_type -> downloadcache.SelfTestResponse
+	92,  // 176: downloadcache.DownloadCache.RestoreInvalidated:output_type -> downloadcache.RestoreInvalidatedResponse
+	94,  // 177: downloadcache.DownloadCache.RunAdminJob:output_type -> downloadcache.AdminJobProgress
+	96,  // 178: downloadcache.DownloadCache.GetAccessibilityTree:output_type -> downloadcache.GetAccessibilityTreeResponse
+	98,  // 179: downloadcache.DownloadCache.GetFailureArtifact:output_type -> downloadcache.GetFailureArtifactResponse
+	142, // [142:180] is the sub-list for method output_type
+	104, // [104:142] is the sub-list for method input_type
+	104, // [104:104] is the sub-list for extension type_name
+	104, // [104:104] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
+}
+
+func init() { file_pb_downloadcache_proto_init() }
+func file_pb_downloadcache_proto_init() {
+	if File_pb_downloadcache_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pb_downloadcache_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebVitals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Certificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpilledContents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSpilledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadCacheChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpilledChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetByHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectHop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseSitemapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitemapEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseSitemapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectGarbageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectGarbageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDomainStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DomainStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDomainStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRenderLoadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRenderLoadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSignedURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSignedURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOfflineModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOfflineModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLegalHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLegalHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallerUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPausedDomainsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PausedDomain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPausedDomainsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeDomainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchWithAssetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchedAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchWithAssetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCrawlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrawlStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseCrawlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeCrawlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCrawlsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCrawlsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCrawlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCrawlChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCrawlResultsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCrawlResultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryFailedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarmFromFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarmProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfTestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfTestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfTestStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreInvalidatedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreInvalidatedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccessibilityTreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccessibilityTreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFailureArtifactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_downloadcache_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFailureArtifactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -208,18 +9937,20 @@ func file_pb_downloadcache_proto_init() {
 			}
 		}
 	}
+	file_pb_downloadcache_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_downloadcache_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      9,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pb_downloadcache_proto_goTypes,
 		DependencyIndexes: file_pb_downloadcache_proto_depIdxs,
+		EnumInfos:         file_pb_downloadcache_proto_enumTypes,
 		MessageInfos:      file_pb_downloadcache_proto_msgTypes,
 	}.Build()
 	File_pb_downloadcache_proto = out.File
@@ -19,7 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DownloadCache_Get_FullMethodName = "/downloadcache.DownloadCache/Get"
+	DownloadCache_Get_FullMethodName                  = "/downloadcache.DownloadCache/Get"
+	DownloadCache_GetStream_FullMethodName            = "/downloadcache.DownloadCache/GetStream"
+	DownloadCache_GetBatch_FullMethodName             = "/downloadcache.DownloadCache/GetBatch"
+	DownloadCache_GetByHash_FullMethodName            = "/downloadcache.DownloadCache/GetByHash"
+	DownloadCache_ParseSitemap_FullMethodName         = "/downloadcache.DownloadCache/ParseSitemap"
+	DownloadCache_Backup_FullMethodName               = "/downloadcache.DownloadCache/Backup"
+	DownloadCache_Restore_FullMethodName              = "/downloadcache.DownloadCache/Restore"
+	DownloadCache_CollectGarbage_FullMethodName       = "/downloadcache.DownloadCache/CollectGarbage"
+	DownloadCache_ListEntries_FullMethodName          = "/downloadcache.DownloadCache/ListEntries"
+	DownloadCache_GetMetadata_FullMethodName          = "/downloadcache.DownloadCache/GetMetadata"
+	DownloadCache_Search_FullMethodName               = "/downloadcache.DownloadCache/Search"
+	DownloadCache_GetDomainStats_FullMethodName       = "/downloadcache.DownloadCache/GetDomainStats"
+	DownloadCache_GetRenderLoad_FullMethodName        = "/downloadcache.DownloadCache/GetRenderLoad"
+	DownloadCache_CreateSignedURL_FullMethodName      = "/downloadcache.DownloadCache/CreateSignedURL"
+	DownloadCache_SetOfflineMode_FullMethodName       = "/downloadcache.DownloadCache/SetOfflineMode"
+	DownloadCache_SetMaintenanceMode_FullMethodName   = "/downloadcache.DownloadCache/SetMaintenanceMode"
+	DownloadCache_SetLogLevel_FullMethodName          = "/downloadcache.DownloadCache/SetLogLevel"
+	DownloadCache_SetLegalHold_FullMethodName         = "/downloadcache.DownloadCache/SetLegalHold"
+	DownloadCache_GetUsageReport_FullMethodName       = "/downloadcache.DownloadCache/GetUsageReport"
+	DownloadCache_ListPausedDomains_FullMethodName    = "/downloadcache.DownloadCache/ListPausedDomains"
+	DownloadCache_ResumeDomain_FullMethodName         = "/downloadcache.DownloadCache/ResumeDomain"
+	DownloadCache_FetchWithAssets_FullMethodName      = "/downloadcache.DownloadCache/FetchWithAssets"
+	DownloadCache_StartCrawl_FullMethodName           = "/downloadcache.DownloadCache/StartCrawl"
+	DownloadCache_PauseCrawl_FullMethodName           = "/downloadcache.DownloadCache/PauseCrawl"
+	DownloadCache_ResumeCrawl_FullMethodName          = "/downloadcache.DownloadCache/ResumeCrawl"
+	DownloadCache_ListCrawls_FullMethodName           = "/downloadcache.DownloadCache/ListCrawls"
+	DownloadCache_ExportCrawl_FullMethodName          = "/downloadcache.DownloadCache/ExportCrawl"
+	DownloadCache_ListCrawlResults_FullMethodName     = "/downloadcache.DownloadCache/ListCrawlResults"
+	DownloadCache_RetryFailed_FullMethodName          = "/downloadcache.DownloadCache/RetryFailed"
+	DownloadCache_WarmFromFile_FullMethodName         = "/downloadcache.DownloadCache/WarmFromFile"
+	DownloadCache_ListJobs_FullMethodName             = "/downloadcache.DownloadCache/ListJobs"
+	DownloadCache_CancelJob_FullMethodName            = "/downloadcache.DownloadCache/CancelJob"
+	DownloadCache_ReadSpilled_FullMethodName          = "/downloadcache.DownloadCache/ReadSpilled"
+	DownloadCache_SelfTest_FullMethodName             = "/downloadcache.DownloadCache/SelfTest"
+	DownloadCache_RestoreInvalidated_FullMethodName   = "/downloadcache.DownloadCache/RestoreInvalidated"
+	DownloadCache_RunAdminJob_FullMethodName          = "/downloadcache.DownloadCache/RunAdminJob"
+	DownloadCache_GetAccessibilityTree_FullMethodName = "/downloadcache.DownloadCache/GetAccessibilityTree"
+	DownloadCache_GetFailureArtifact_FullMethodName   = "/downloadcache.DownloadCache/GetFailureArtifact"
 )
 
 // DownloadCacheClient is the client API for DownloadCache service.
@@ -28,6 +65,116 @@ const (
 type DownloadCacheClient interface {
 	// Fetches a URL, using a cache if available.
 	Get(ctx context.Context, in *DownloadCacheRequest, opts ...grpc.CallOption) (*DownloadCacheResponse, error)
+	// Like Get, but streams the page's contents in chunks after the response,
+	// so pages of any size can be read without raising the client's message
+	// size limit.
+	GetStream(ctx context.Context, in *DownloadCacheRequest, opts ...grpc.CallOption) (DownloadCache_GetStreamClient, error)
+	// Gets many URLs at once, fetching misses concurrently, and streams back
+	// each URL's result as soon as it is done.
+	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (DownloadCache_GetBatchClient, error)
+	// Returns a cached page by the SHA-256 of its content (content_hash in
+	// Get responses), for systems that keep only hashes.
+	GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*GetByHashResponse, error)
+	// Fetches a sitemap, using a cache if available, and returns the URLs it lists.
+	ParseSitemap(ctx context.Context, in *ParseSitemapRequest, opts ...grpc.CallOption) (*ParseSitemapResponse, error)
+	// Streams every cache entry fetched after a point in time, for incremental
+	// backups.  Safe to call while the server is serving traffic.
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (DownloadCache_BackupClient, error)
+	// Writes entries produced by Backup back into the cache.
+	Restore(ctx context.Context, opts ...grpc.CallOption) (DownloadCache_RestoreClient, error)
+	// Removes files that no cache entry refers to, e.g. left behind by a crash
+	// part way through a write.
+	CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error)
+	// Lists cache entries with their original URLs, whatever the key scheme.
+	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
+	// Describes the cache entry for a URL, with its annotations.
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	// Finds cached pages by the words in their text (see SEARCH_INDEX).
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Reports cache usage and traffic per hostname, largest first.
+	GetDomainStats(ctx context.Context, in *GetDomainStatsRequest, opts ...grpc.CallOption) (*GetDomainStatsResponse, error)
+	// Reports demand for browser sessions, for autoscaling the Selenium grid.
+	GetRenderLoad(ctx context.Context, in *GetRenderLoadRequest, opts ...grpc.CallOption) (*GetRenderLoadResponse, error)
+	// Mints a time-limited HTTP gateway URL for a cached page, for sharing with
+	// systems that can't call this API.  Requires GATEWAY_SIGNING_KEY.
+	CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error)
+	// Switches the server into or out of offline mode, in which cached pages
+	// are still served but nothing is fetched.  For origin maintenance windows
+	// and Selenium grid upgrades.
+	SetOfflineMode(ctx context.Context, in *SetOfflineModeRequest, opts ...grpc.CallOption) (*SetOfflineModeResponse, error)
+	// Switches the server into or out of maintenance mode, in which cached
+	// pages are still served but nothing is written to the cache.  Entering
+	// maintenance waits for in-flight fetches and writes to finish, so storage
+	// can be worked on safely once it returns with in_flight = 0.
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error)
+	// Changes the log level and request sampling without a restart.  An empty
+	// request changes nothing and reports the current settings.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// Places a cached entry under legal hold, or releases it.  A held entry is
+	// never deleted or expired, and is only replaced by a request that sets
+	// both invalidate and force.
+	SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*SetLegalHoldResponse, error)
+	// Reports bytes served and fetched, and time spent fetching, per caller
+	// and tenant, for charging heavy users for the rendering farm.
+	GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error)
+	// Lists the domains whose fetches are paused after repeated failures (see
+	// PAUSE_AFTER_FAILURES).
+	ListPausedDomains(ctx context.Context, in *ListPausedDomainsRequest, opts ...grpc.CallOption) (*ListPausedDomainsResponse, error)
+	// Resumes fetches from a paused domain before its cooldown ends.
+	ResumeDomain(ctx context.Context, in *ResumeDomainRequest, opts ...grpc.CallOption) (*ResumeDomainResponse, error)
+	// Fetches a page together with the same-origin stylesheets, scripts and
+	// images it references, and caches them all, so the complete page can be
+	// reassembled later.  Nothing is cached unless every part was fetched.
+	FetchWithAssets(ctx context.Context, in *FetchWithAssetsRequest, opts ...grpc.CallOption) (*FetchWithAssetsResponse, error)
+	// Starts crawling a site: caching a page, then the pages it links to on
+	// the same hostname, and so on.  Crawls are saved as they go, so they
+	// carry on where they left off after a restart.
+	StartCrawl(ctx context.Context, in *StartCrawlRequest, opts ...grpc.CallOption) (*CrawlStatus, error)
+	// Pauses a running crawl.
+	PauseCrawl(ctx context.Context, in *PauseCrawlRequest, opts ...grpc.CallOption) (*CrawlStatus, error)
+	// Resumes a paused crawl where it left off.
+	ResumeCrawl(ctx context.Context, in *ResumeCrawlRequest, opts ...grpc.CallOption) (*CrawlStatus, error)
+	// Reports the progress of crawls.
+	ListCrawls(ctx context.Context, in *ListCrawlsRequest, opts ...grpc.CallOption) (*ListCrawlsResponse, error)
+	// Exports the pages of a crawl as JSON lines or a WARC archive, streamed
+	// back or written to EXPORT_DIR.
+	ExportCrawl(ctx context.Context, in *ExportCrawlRequest, opts ...grpc.CallOption) (DownloadCache_ExportCrawlClient, error)
+	// Reports the outcome of each page of a crawl.
+	ListCrawlResults(ctx context.Context, in *ListCrawlResultsRequest, opts ...grpc.CallOption) (*ListCrawlResultsResponse, error)
+	// Queues the pages a crawl failed to fetch again, and runs the crawl if it
+	// was done.
+	RetryFailed(ctx context.Context, in *RetryFailedRequest, opts ...grpc.CallOption) (*CrawlStatus, error)
+	// Caches the URLs listed in a CSV or JSON lines file, streamed in as
+	// chunks, fetching several at once, and streams back progress until every
+	// URL is done.
+	WarmFromFile(ctx context.Context, opts ...grpc.CallOption) (DownloadCache_WarmFromFileClient, error)
+	// Lists the work in progress: fetches, warms and running crawls.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// Stops a job listed by ListJobs.  A canceled crawl is paused, so it can
+	// be resumed with ResumeCrawl.
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	// Streams the contents of a Get response too large to send in one
+	// message (see DownloadCacheResponse.spilled).
+	ReadSpilled(ctx context.Context, in *ReadSpilledRequest, opts ...grpc.CallOption) (DownloadCache_ReadSpilledClient, error)
+	// Renders a page end to end, through the renderer, content processing
+	// and storage, without caching it, for deployment smoke tests.  Fails
+	// with UNAVAILABLE naming the step that failed.
+	SelfTest(ctx context.Context, in *SelfTestRequest, opts ...grpc.CallOption) (*SelfTestResponse, error)
+	// Puts back cached copies replaced by invalidating requests, or purged by
+	// RunAdminJob, while they are in the trash (see TRASH_RETENTION), e.g.
+	// after an accidental mass invalidation.
+	RestoreInvalidated(ctx context.Context, in *RestoreInvalidatedRequest, opts ...grpc.CallOption) (*RestoreInvalidatedResponse, error)
+	// Runs a bulk operation over the cache's entries (purge, recompress,
+	// reshard or verify) as a background job, streaming its progress every
+	// second.  Closing the stream leaves the job running; it is listed by
+	// ListJobs and stopped with CancelJob.
+	RunAdminJob(ctx context.Context, in *AdminJobRequest, opts ...grpc.CallOption) (DownloadCache_RunAdminJobClient, error)
+	// Returns the accessibility tree captured with a cached page (see
+	// FetchOptions.capture_accessibility_tree).
+	GetAccessibilityTree(ctx context.Context, in *GetAccessibilityTreeRequest, opts ...grpc.CallOption) (*GetAccessibilityTreeResponse, error)
+	// Returns what the browser showed when the last fetch of a URL failed
+	// (see FetchOptions.capture_failure_artifact).
+	GetFailureArtifact(ctx context.Context, in *GetFailureArtifactRequest, opts ...grpc.CallOption) (*GetFailureArtifactResponse, error)
 }
 
 type downloadCacheClient struct {
@@ -47,49 +194,1488 @@ func (c *downloadCacheClient) Get(ctx context.Context, in *DownloadCacheRequest,
 	return out, nil
 }
 
+func (c *downloadCacheClient) GetStream(ctx context.Context, in *DownloadCacheRequest, opts ...grpc.CallOption) (DownloadCache_GetStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[0], DownloadCache_GetStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheGetStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DownloadCache_GetStreamClient interface {
+	Recv() (*DownloadCacheChunk, error)
+	grpc.ClientStream
+}
+
+type downloadCacheGetStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheGetStreamClient) Recv() (*DownloadCacheChunk, error) {
+	m := new(DownloadCacheChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (DownloadCache_GetBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[1], DownloadCache_GetBatch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheGetBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DownloadCache_GetBatchClient interface {
+	Recv() (*GetBatchResult, error)
+	grpc.ClientStream
+}
+
+type downloadCacheGetBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheGetBatchClient) Recv() (*GetBatchResult, error) {
+	m := new(GetBatchResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*GetByHashResponse, error) {
+	out := new(GetByHashResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_GetByHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ParseSitemap(ctx context.Context, in *ParseSitemapRequest, opts ...grpc.CallOption) (*ParseSitemapResponse, error) {
+	out := new(ParseSitemapResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_ParseSitemap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (DownloadCache_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[2], DownloadCache_Backup_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DownloadCache_BackupClient interface {
+	Recv() (*BackupEntry, error)
+	grpc.ClientStream
+}
+
+type downloadCacheBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheBackupClient) Recv() (*BackupEntry, error) {
+	m := new(BackupEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) Restore(ctx context.Context, opts ...grpc.CallOption) (DownloadCache_RestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[3], DownloadCache_Restore_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheRestoreClient{stream}
+	return x, nil
+}
+
+type DownloadCache_RestoreClient interface {
+	Send(*BackupEntry) error
+	CloseAndRecv() (*RestoreResponse, error)
+	grpc.ClientStream
+}
+
+type downloadCacheRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheRestoreClient) Send(m *BackupEntry) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *downloadCacheRestoreClient) CloseAndRecv() (*RestoreResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error) {
+	out := new(CollectGarbageResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_CollectGarbage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_ListEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_GetMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) GetDomainStats(ctx context.Context, in *GetDomainStatsRequest, opts ...grpc.CallOption) (*GetDomainStatsResponse, error) {
+	out := new(GetDomainStatsResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_GetDomainStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) GetRenderLoad(ctx context.Context, in *GetRenderLoadRequest, opts ...grpc.CallOption) (*GetRenderLoadResponse, error) {
+	out := new(GetRenderLoadResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_GetRenderLoad_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) CreateSignedURL(ctx context.Context, in *CreateSignedURLRequest, opts ...grpc.CallOption) (*CreateSignedURLResponse, error) {
+	out := new(CreateSignedURLResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_CreateSignedURL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) SetOfflineMode(ctx context.Context, in *SetOfflineModeRequest, opts ...grpc.CallOption) (*SetOfflineModeResponse, error) {
+	out := new(SetOfflineModeResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_SetOfflineMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error) {
+	out := new(SetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_SetMaintenanceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*SetLegalHoldResponse, error) {
+	out := new(SetLegalHoldResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_SetLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error) {
+	out := new(GetUsageReportResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_GetUsageReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ListPausedDomains(ctx context.Context, in *ListPausedDomainsRequest, opts ...grpc.CallOption) (*ListPausedDomainsResponse, error) {
+	out := new(ListPausedDomainsResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_ListPausedDomains_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ResumeDomain(ctx context.Context, in *ResumeDomainRequest, opts ...grpc.CallOption) (*ResumeDomainResponse, error) {
+	out := new(ResumeDomainResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_ResumeDomain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) FetchWithAssets(ctx context.Context, in *FetchWithAssetsRequest, opts ...grpc.CallOption) (*FetchWithAssetsResponse, error) {
+	out := new(FetchWithAssetsResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_FetchWithAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) StartCrawl(ctx context.Context, in *StartCrawlRequest, opts ...grpc.CallOption) (*CrawlStatus, error) {
+	out := new(CrawlStatus)
+	err := c.cc.Invoke(ctx, DownloadCache_StartCrawl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) PauseCrawl(ctx context.Context, in *PauseCrawlRequest, opts ...grpc.CallOption) (*CrawlStatus, error) {
+	out := new(CrawlStatus)
+	err := c.cc.Invoke(ctx, DownloadCache_PauseCrawl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ResumeCrawl(ctx context.Context, in *ResumeCrawlRequest, opts ...grpc.CallOption) (*CrawlStatus, error) {
+	out := new(CrawlStatus)
+	err := c.cc.Invoke(ctx, DownloadCache_ResumeCrawl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ListCrawls(ctx context.Context, in *ListCrawlsRequest, opts ...grpc.CallOption) (*ListCrawlsResponse, error) {
+	out := new(ListCrawlsResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_ListCrawls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ExportCrawl(ctx context.Context, in *ExportCrawlRequest, opts ...grpc.CallOption) (DownloadCache_ExportCrawlClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[4], DownloadCache_ExportCrawl_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheExportCrawlClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DownloadCache_ExportCrawlClient interface {
+	Recv() (*ExportCrawlChunk, error)
+	grpc.ClientStream
+}
+
+type downloadCacheExportCrawlClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheExportCrawlClient) Recv() (*ExportCrawlChunk, error) {
+	m := new(ExportCrawlChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) ListCrawlResults(ctx context.Context, in *ListCrawlResultsRequest, opts ...grpc.CallOption) (*ListCrawlResultsResponse, error) {
+	out := new(ListCrawlResultsResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_ListCrawlResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) RetryFailed(ctx context.Context, in *RetryFailedRequest, opts ...grpc.CallOption) (*CrawlStatus, error) {
+	out := new(CrawlStatus)
+	err := c.cc.Invoke(ctx, DownloadCache_RetryFailed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) WarmFromFile(ctx context.Context, opts ...grpc.CallOption) (DownloadCache_WarmFromFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[5], DownloadCache_WarmFromFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheWarmFromFileClient{stream}
+	return x, nil
+}
+
+type DownloadCache_WarmFromFileClient interface {
+	Send(*WarmFromFileRequest) error
+	Recv() (*WarmProgress, error)
+	grpc.ClientStream
+}
+
+type downloadCacheWarmFromFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheWarmFromFileClient) Send(m *WarmFromFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *downloadCacheWarmFromFileClient) Recv() (*WarmProgress, error) {
+	m := new(WarmProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_ListJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_CancelJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) ReadSpilled(ctx context.Context, in *ReadSpilledRequest, opts ...grpc.CallOption) (DownloadCache_ReadSpilledClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[6], DownloadCache_ReadSpilled_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheReadSpilledClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DownloadCache_ReadSpilledClient interface {
+	Recv() (*SpilledChunk, error)
+	grpc.ClientStream
+}
+
+type downloadCacheReadSpilledClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheReadSpilledClient) Recv() (*SpilledChunk, error) {
+	m := new(SpilledChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) SelfTest(ctx context.Context, in *SelfTestRequest, opts ...grpc.CallOption) (*SelfTestResponse, error) {
+	out := new(SelfTestResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_SelfTest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) RestoreInvalidated(ctx context.Context, in *RestoreInvalidatedRequest, opts ...grpc.CallOption) (*RestoreInvalidatedResponse, error) {
+	out := new(RestoreInvalidatedResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_RestoreInvalidated_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) RunAdminJob(ctx context.Context, in *AdminJobRequest, opts ...grpc.CallOption) (DownloadCache_RunAdminJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DownloadCache_ServiceDesc.Streams[7], DownloadCache_RunAdminJob_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &downloadCacheRunAdminJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DownloadCache_RunAdminJobClient interface {
+	Recv() (*AdminJobProgress, error)
+	grpc.ClientStream
+}
+
+type downloadCacheRunAdminJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *downloadCacheRunAdminJobClient) Recv() (*AdminJobProgress, error) {
+	m := new(AdminJobProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *downloadCacheClient) GetAccessibilityTree(ctx context.Context, in *GetAccessibilityTreeRequest, opts ...grpc.CallOption) (*GetAccessibilityTreeResponse, error) {
+	out := new(GetAccessibilityTreeResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_GetAccessibilityTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloadCacheClient) GetFailureArtifact(ctx context.Context, in *GetFailureArtifactRequest, opts ...grpc.CallOption) (*GetFailureArtifactResponse, error) {
+	out := new(GetFailureArtifactResponse)
+	err := c.cc.Invoke(ctx, DownloadCache_GetFailureArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DownloadCacheServer is the server API for DownloadCache service.
 // All implementations must embed UnimplementedDownloadCacheServer
 // for forward compatibility
 type DownloadCacheServer interface {
 	// Fetches a URL, using a cache if available.
 	Get(context.Context, *DownloadCacheRequest) (*DownloadCacheResponse, error)
+	// Like Get, but streams the page's contents in chunks after the response,
+	// so pages of any size can be read without raising the client's message
+	// size limit.
+	GetStream(*DownloadCacheRequest, DownloadCache_GetStreamServer) error
+	// Gets many URLs at once, fetching misses concurrently, and streams back
+	// each URL's result as soon as it is done.
+	GetBatch(*GetBatchRequest, DownloadCache_GetBatchServer) error
+	// Returns a cached page by the SHA-256 of its content (content_hash in
+	// Get responses), for systems that keep only hashes.
+	GetByHash(context.Context, *GetByHashRequest) (*GetByHashResponse, error)
+	// Fetches a sitemap, using a cache if available, and returns the URLs it lists.
+	ParseSitemap(context.Context, *ParseSitemapRequest) (*ParseSitemapResponse, error)
+	// Streams every cache entry fetched after a point in time, for incremental
+	// backups.  Safe to call while the server is serving traffic.
+	Backup(*BackupRequest, DownloadCache_BackupServer) error
+	// Writes entries produced by Backup back into the cache.
+	Restore(DownloadCache_RestoreServer) error
+	// Removes files that no cache entry refers to, e.g. left behind by a crash
+	// part way through a write.
+	CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error)
+	// Lists cache entries with their original URLs, whatever the key scheme.
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	// Describes the cache entry for a URL, with its annotations.
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	// Finds cached pages by the words in their text (see SEARCH_INDEX).
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Reports cache usage and traffic per hostname, largest first.
+	GetDomainStats(context.Context, *GetDomainStatsRequest) (*GetDomainStatsResponse, error)
+	// Reports demand for browser sessions, for autoscaling the Selenium grid.
+	GetRenderLoad(context.Context, *GetRenderLoadRequest) (*GetRenderLoadResponse, error)
+	// Mints a time-limited HTTP gateway URL for a cached page, for sharing with
+	// systems that can't call this API.  Requires GATEWAY_SIGNING_KEY.
+	CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error)
+	// Switches the server into or out of offline mode, in which cached pages
+	// are still served but nothing is fetched.  For origin maintenance windows
+	// and Selenium grid upgrades.
+	SetOfflineMode(context.Context, *SetOfflineModeRequest) (*SetOfflineModeResponse, error)
+	// Switches the server into or out of maintenance mode, in which cached
+	// pages are still served but nothing is written to the cache.  Entering
+	// maintenance waits for in-flight fetches and writes to finish, so storage
+	// can be worked on safely once it returns with in_flight = 0.
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error)
+	// Changes the log level and request sampling without a restart.  An empty
+	// request changes nothing and reports the current settings.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// Places a cached entry under legal hold, or releases it.  A held entry is
+	// never deleted or expired, and is only replaced by a request that sets
+	// both invalidate and force.
+	SetLegalHold(context.Context, *SetLegalHoldRequest) (*SetLegalHoldResponse, error)
+	// Reports bytes served and fetched, and time spent fetching, per caller
+	// and tenant, for charging heavy users for the rendering farm.
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error)
+	// Lists the domains whose fetches are paused after repeated failures (see
+	// PAUSE_AFTER_FAILURES).
+	ListPausedDomains(context.Context, *ListPausedDomainsRequest) (*ListPausedDomainsResponse, error)
+	// Resumes fetches from a paused domain before its cooldown ends.
+	ResumeDomain(context.Context, *ResumeDomainRequest) (*ResumeDomainResponse, error)
+	// Fetches a page together with the same-origin stylesheets, scripts and
+	// images it references, and caches them all, so the complete page can be
+	// reassembled later.  Nothing is cached unless every part was fetched.
+	FetchWithAssets(context.Context, *FetchWithAssetsRequest) (*FetchWithAssetsResponse, error)
+	// Starts crawling a site: caching a page, then the pages it links to on
+	// the same hostname, and so on.  Crawls are saved as they go, so they
+	// carry on where they left off after a restart.
+	StartCrawl(context.Context, *StartCrawlRequest) (*CrawlStatus, error)
+	// Pauses a running crawl.
+	PauseCrawl(context.Context, *PauseCrawlRequest) (*CrawlStatus, error)
+	// Resumes a paused crawl where it left off.
+	ResumeCrawl(context.Context, *ResumeCrawlRequest) (*CrawlStatus, error)
+	// Reports the progress of crawls.
+	ListCrawls(context.Context, *ListCrawlsRequest) (*ListCrawlsResponse, error)
+	// Exports the pages of a crawl as JSON lines or a WARC archive, streamed
+	// back or written to EXPORT_DIR.
+	ExportCrawl(*ExportCrawlRequest, DownloadCache_ExportCrawlServer) error
+	// Reports the outcome of each page of a crawl.
+	ListCrawlResults(context.Context, *ListCrawlResultsRequest) (*ListCrawlResultsResponse, error)
+	// Queues the pages a crawl failed to fetch again, and runs the crawl if it
+	// was done.
+	RetryFailed(context.Context, *RetryFailedRequest) (*CrawlStatus, error)
+	// Caches the URLs listed in a CSV or JSON lines file, streamed in as
+	// chunks, fetching several at once, and streams back progress until every
+	// URL is done.
+	WarmFromFile(DownloadCache_WarmFromFileServer) error
+	// Lists the work in progress: fetches, warms and running crawls.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// Stops a job listed by ListJobs.  A canceled crawl is paused, so it can
+	// be resumed with ResumeCrawl.
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	// Streams the contents of a Get response too large to send in one
+	// message (see DownloadCacheResponse.spilled).
+	ReadSpilled(*ReadSpilledRequest, DownloadCache_ReadSpilledServer) error
+	// Renders a page end to end, through the renderer, content processing
+	// and storage, without caching it, for deployment smoke tests.  Fails
+	// with UNAVAILABLE naming the step that failed.
+	SelfTest(context.Context, *SelfTestRequest) (*SelfTestResponse, error)
+	// Puts back cached copies replaced by invalidating requests, or purged by
+	// RunAdminJob, while they are in the trash (see TRASH_RETENTION), e.g.
+	// after an accidental mass invalidation.
+	RestoreInvalidated(context.Context, *RestoreInvalidatedRequest) (*RestoreInvalidatedResponse, error)
+	// Runs a bulk operation over the cache's entries (purge, recompress,
+	// reshard or verify) as a background job, streaming its progress every
+	// second.  Closing the stream leaves the job running; it is listed by
+	// ListJobs and stopped with CancelJob.
+	RunAdminJob(*AdminJobRequest, DownloadCache_RunAdminJobServer) error
+	// Returns the accessibility tree captured with a cached page (see
+	// FetchOptions.capture_accessibility_tree).
+	GetAccessibilityTree(context.Context, *GetAccessibilityTreeRequest) (*GetAccessibilityTreeResponse, error)
+	// Returns what the browser showed when the last fetch of a URL failed
+	// (see FetchOptions.capture_failure_artifact).
+	GetFailureArtifact(context.Context, *GetFailureArtifactRequest) (*GetFailureArtifactResponse, error)
 	mustEmbedUnimplementedDownloadCacheServer()
 }
 
-// UnimplementedDownloadCacheServer must be embedded to have forward compatible implementations.
-type UnimplementedDownloadCacheServer struct {
+// UnimplementedDownloadCacheServer must be embedded to have forward compatible implementations.
+type UnimplementedDownloadCacheServer struct {
+}
+
+func (UnimplementedDownloadCacheServer) Get(context.Context, *DownloadCacheRequest) (*DownloadCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetStream(*DownloadCacheRequest, DownloadCache_GetStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetStream not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetBatch(*GetBatchRequest, DownloadCache_GetBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetByHash(context.Context, *GetByHashRequest) (*GetByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHash not implemented")
+}
+func (UnimplementedDownloadCacheServer) ParseSitemap(context.Context, *ParseSitemapRequest) (*ParseSitemapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseSitemap not implemented")
+}
+func (UnimplementedDownloadCacheServer) Backup(*BackupRequest, DownloadCache_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedDownloadCacheServer) Restore(DownloadCache_RestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedDownloadCacheServer) CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectGarbage not implemented")
+}
+func (UnimplementedDownloadCacheServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (UnimplementedDownloadCacheServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetDomainStats(context.Context, *GetDomainStatsRequest) (*GetDomainStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomainStats not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetRenderLoad(context.Context, *GetRenderLoadRequest) (*GetRenderLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRenderLoad not implemented")
+}
+func (UnimplementedDownloadCacheServer) CreateSignedURL(context.Context, *CreateSignedURLRequest) (*CreateSignedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSignedURL not implemented")
+}
+func (UnimplementedDownloadCacheServer) SetOfflineMode(context.Context, *SetOfflineModeRequest) (*SetOfflineModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOfflineMode not implemented")
+}
+func (UnimplementedDownloadCacheServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedDownloadCacheServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedDownloadCacheServer) SetLegalHold(context.Context, *SetLegalHoldRequest) (*SetLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLegalHold not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageReport not implemented")
+}
+func (UnimplementedDownloadCacheServer) ListPausedDomains(context.Context, *ListPausedDomainsRequest) (*ListPausedDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPausedDomains not implemented")
+}
+func (UnimplementedDownloadCacheServer) ResumeDomain(context.Context, *ResumeDomainRequest) (*ResumeDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeDomain not implemented")
+}
+func (UnimplementedDownloadCacheServer) FetchWithAssets(context.Context, *FetchWithAssetsRequest) (*FetchWithAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchWithAssets not implemented")
+}
+func (UnimplementedDownloadCacheServer) StartCrawl(context.Context, *StartCrawlRequest) (*CrawlStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCrawl not implemented")
+}
+func (UnimplementedDownloadCacheServer) PauseCrawl(context.Context, *PauseCrawlRequest) (*CrawlStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCrawl not implemented")
+}
+func (UnimplementedDownloadCacheServer) ResumeCrawl(context.Context, *ResumeCrawlRequest) (*CrawlStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCrawl not implemented")
+}
+func (UnimplementedDownloadCacheServer) ListCrawls(context.Context, *ListCrawlsRequest) (*ListCrawlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCrawls not implemented")
+}
+func (UnimplementedDownloadCacheServer) ExportCrawl(*ExportCrawlRequest, DownloadCache_ExportCrawlServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCrawl not implemented")
+}
+func (UnimplementedDownloadCacheServer) ListCrawlResults(context.Context, *ListCrawlResultsRequest) (*ListCrawlResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCrawlResults not implemented")
+}
+func (UnimplementedDownloadCacheServer) RetryFailed(context.Context, *RetryFailedRequest) (*CrawlStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailed not implemented")
+}
+func (UnimplementedDownloadCacheServer) WarmFromFile(DownloadCache_WarmFromFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method WarmFromFile not implemented")
+}
+func (UnimplementedDownloadCacheServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedDownloadCacheServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedDownloadCacheServer) ReadSpilled(*ReadSpilledRequest, DownloadCache_ReadSpilledServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadSpilled not implemented")
+}
+func (UnimplementedDownloadCacheServer) SelfTest(context.Context, *SelfTestRequest) (*SelfTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfTest not implemented")
+}
+func (UnimplementedDownloadCacheServer) RestoreInvalidated(context.Context, *RestoreInvalidatedRequest) (*RestoreInvalidatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreInvalidated not implemented")
+}
+func (UnimplementedDownloadCacheServer) RunAdminJob(*AdminJobRequest, DownloadCache_RunAdminJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method RunAdminJob not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetAccessibilityTree(context.Context, *GetAccessibilityTreeRequest) (*GetAccessibilityTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessibilityTree not implemented")
+}
+func (UnimplementedDownloadCacheServer) GetFailureArtifact(context.Context, *GetFailureArtifactRequest) (*GetFailureArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailureArtifact not implemented")
+}
+func (UnimplementedDownloadCacheServer) mustEmbedUnimplementedDownloadCacheServer() {}
+
+// UnsafeDownloadCacheServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DownloadCacheServer will
+// result in compilation errors.
+type UnsafeDownloadCacheServer interface {
+	mustEmbedUnimplementedDownloadCacheServer()
+}
+
+func RegisterDownloadCacheServer(s grpc.ServiceRegistrar, srv DownloadCacheServer) {
+	s.RegisterService(&DownloadCache_ServiceDesc, srv)
+}
+
+func _DownloadCache_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).Get(ctx, req.(*DownloadCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_GetStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadCacheRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DownloadCacheServer).GetStream(m, &downloadCacheGetStreamServer{stream})
+}
+
+type DownloadCache_GetStreamServer interface {
+	Send(*DownloadCacheChunk) error
+	grpc.ServerStream
+}
+
+type downloadCacheGetStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheGetStreamServer) Send(m *DownloadCacheChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DownloadCache_GetBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DownloadCacheServer).GetBatch(m, &downloadCacheGetBatchServer{stream})
+}
+
+type DownloadCache_GetBatchServer interface {
+	Send(*GetBatchResult) error
+	grpc.ServerStream
+}
+
+type downloadCacheGetBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheGetBatchServer) Send(m *GetBatchResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DownloadCache_GetByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).GetByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_GetByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).GetByHash(ctx, req.(*GetByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_ParseSitemap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseSitemapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).ParseSitemap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_ParseSitemap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).ParseSitemap(ctx, req.(*ParseSitemapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DownloadCacheServer).Backup(m, &downloadCacheBackupServer{stream})
+}
+
+type DownloadCache_BackupServer interface {
+	Send(*BackupEntry) error
+	grpc.ServerStream
+}
+
+type downloadCacheBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheBackupServer) Send(m *BackupEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DownloadCache_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DownloadCacheServer).Restore(&downloadCacheRestoreServer{stream})
+}
+
+type DownloadCache_RestoreServer interface {
+	SendAndClose(*RestoreResponse) error
+	Recv() (*BackupEntry, error)
+	grpc.ServerStream
+}
+
+type downloadCacheRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheRestoreServer) SendAndClose(m *RestoreResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *downloadCacheRestoreServer) Recv() (*BackupEntry, error) {
+	m := new(BackupEntry)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DownloadCache_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectGarbageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).CollectGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_CollectGarbage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).CollectGarbage(ctx, req.(*CollectGarbageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_ListEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).ListEntries(ctx, req.(*ListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_GetMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_GetDomainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDomainStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).GetDomainStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_GetDomainStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).GetDomainStats(ctx, req.(*GetDomainStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_GetRenderLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRenderLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).GetRenderLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_GetRenderLoad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).GetRenderLoad(ctx, req.(*GetRenderLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_CreateSignedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSignedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).CreateSignedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_CreateSignedURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).CreateSignedURL(ctx, req.(*CreateSignedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_SetOfflineMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOfflineModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).SetOfflineMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_SetOfflineMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).SetOfflineMode(ctx, req.(*SetOfflineModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_SetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).SetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_SetLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).SetLegalHold(ctx, req.(*SetLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_GetUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).GetUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_GetUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).GetUsageReport(ctx, req.(*GetUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_ListPausedDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPausedDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).ListPausedDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_ListPausedDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).ListPausedDomains(ctx, req.(*ListPausedDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_ResumeDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).ResumeDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_ResumeDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).ResumeDomain(ctx, req.(*ResumeDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_FetchWithAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchWithAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).FetchWithAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_FetchWithAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).FetchWithAssets(ctx, req.(*FetchWithAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_StartCrawl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCrawlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).StartCrawl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_StartCrawl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).StartCrawl(ctx, req.(*StartCrawlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_PauseCrawl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCrawlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).PauseCrawl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_PauseCrawl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).PauseCrawl(ctx, req.(*PauseCrawlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedDownloadCacheServer) Get(context.Context, *DownloadCacheRequest) (*DownloadCacheResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func _DownloadCache_ResumeCrawl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCrawlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).ResumeCrawl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_ResumeCrawl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).ResumeCrawl(ctx, req.(*ResumeCrawlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDownloadCacheServer) mustEmbedUnimplementedDownloadCacheServer() {}
 
-// UnsafeDownloadCacheServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DownloadCacheServer will
-// result in compilation errors.
-type UnsafeDownloadCacheServer interface {
-	mustEmbedUnimplementedDownloadCacheServer()
+func _DownloadCache_ListCrawls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCrawlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).ListCrawls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_ListCrawls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).ListCrawls(ctx, req.(*ListCrawlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDownloadCacheServer(s grpc.ServiceRegistrar, srv DownloadCacheServer) {
-	s.RegisterService(&DownloadCache_ServiceDesc, srv)
+func _DownloadCache_ExportCrawl_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCrawlRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DownloadCacheServer).ExportCrawl(m, &downloadCacheExportCrawlServer{stream})
 }
 
-func _DownloadCache_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownloadCacheRequest)
+type DownloadCache_ExportCrawlServer interface {
+	Send(*ExportCrawlChunk) error
+	grpc.ServerStream
+}
+
+type downloadCacheExportCrawlServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheExportCrawlServer) Send(m *ExportCrawlChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DownloadCache_ListCrawlResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCrawlResultsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DownloadCacheServer).Get(ctx, in)
+		return srv.(DownloadCacheServer).ListCrawlResults(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DownloadCache_Get_FullMethodName,
+		FullMethod: DownloadCache_ListCrawlResults_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DownloadCacheServer).Get(ctx, req.(*DownloadCacheRequest))
+		return srv.(DownloadCacheServer).ListCrawlResults(ctx, req.(*ListCrawlResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_RetryFailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryFailedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).RetryFailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_RetryFailed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).RetryFailed(ctx, req.(*RetryFailedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_WarmFromFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DownloadCacheServer).WarmFromFile(&downloadCacheWarmFromFileServer{stream})
+}
+
+type DownloadCache_WarmFromFileServer interface {
+	Send(*WarmProgress) error
+	Recv() (*WarmFromFileRequest, error)
+	grpc.ServerStream
+}
+
+type downloadCacheWarmFromFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheWarmFromFileServer) Send(m *WarmProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *downloadCacheWarmFromFileServer) Recv() (*WarmFromFileRequest, error) {
+	m := new(WarmFromFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DownloadCache_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_ReadSpilled_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadSpilledRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DownloadCacheServer).ReadSpilled(m, &downloadCacheReadSpilledServer{stream})
+}
+
+type DownloadCache_ReadSpilledServer interface {
+	Send(*SpilledChunk) error
+	grpc.ServerStream
+}
+
+type downloadCacheReadSpilledServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheReadSpilledServer) Send(m *SpilledChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DownloadCache_SelfTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).SelfTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_SelfTest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).SelfTest(ctx, req.(*SelfTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_RestoreInvalidated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreInvalidatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).RestoreInvalidated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_RestoreInvalidated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).RestoreInvalidated(ctx, req.(*RestoreInvalidatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_RunAdminJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AdminJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DownloadCacheServer).RunAdminJob(m, &downloadCacheRunAdminJobServer{stream})
+}
+
+type DownloadCache_RunAdminJobServer interface {
+	Send(*AdminJobProgress) error
+	grpc.ServerStream
+}
+
+type downloadCacheRunAdminJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *downloadCacheRunAdminJobServer) Send(m *AdminJobProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DownloadCache_GetAccessibilityTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessibilityTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).GetAccessibilityTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_GetAccessibilityTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).GetAccessibilityTree(ctx, req.(*GetAccessibilityTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloadCache_GetFailureArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFailureArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloadCacheServer).GetFailureArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloadCache_GetFailureArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloadCacheServer).GetFailureArtifact(ctx, req.(*GetFailureArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -105,7 +1691,165 @@ var DownloadCache_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _DownloadCache_Get_Handler,
 		},
+		{
+			MethodName: "GetByHash",
+			Handler:    _DownloadCache_GetByHash_Handler,
+		},
+		{
+			MethodName: "ParseSitemap",
+			Handler:    _DownloadCache_ParseSitemap_Handler,
+		},
+		{
+			MethodName: "CollectGarbage",
+			Handler:    _DownloadCache_CollectGarbage_Handler,
+		},
+		{
+			MethodName: "ListEntries",
+			Handler:    _DownloadCache_ListEntries_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _DownloadCache_GetMetadata_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _DownloadCache_Search_Handler,
+		},
+		{
+			MethodName: "GetDomainStats",
+			Handler:    _DownloadCache_GetDomainStats_Handler,
+		},
+		{
+			MethodName: "GetRenderLoad",
+			Handler:    _DownloadCache_GetRenderLoad_Handler,
+		},
+		{
+			MethodName: "CreateSignedURL",
+			Handler:    _DownloadCache_CreateSignedURL_Handler,
+		},
+		{
+			MethodName: "SetOfflineMode",
+			Handler:    _DownloadCache_SetOfflineMode_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _DownloadCache_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _DownloadCache_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _DownloadCache_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "GetUsageReport",
+			Handler:    _DownloadCache_GetUsageReport_Handler,
+		},
+		{
+			MethodName: "ListPausedDomains",
+			Handler:    _DownloadCache_ListPausedDomains_Handler,
+		},
+		{
+			MethodName: "ResumeDomain",
+			Handler:    _DownloadCache_ResumeDomain_Handler,
+		},
+		{
+			MethodName: "FetchWithAssets",
+			Handler:    _DownloadCache_FetchWithAssets_Handler,
+		},
+		{
+			MethodName: "StartCrawl",
+			Handler:    _DownloadCache_StartCrawl_Handler,
+		},
+		{
+			MethodName: "PauseCrawl",
+			Handler:    _DownloadCache_PauseCrawl_Handler,
+		},
+		{
+			MethodName: "ResumeCrawl",
+			Handler:    _DownloadCache_ResumeCrawl_Handler,
+		},
+		{
+			MethodName: "ListCrawls",
+			Handler:    _DownloadCache_ListCrawls_Handler,
+		},
+		{
+			MethodName: "ListCrawlResults",
+			Handler:    _DownloadCache_ListCrawlResults_Handler,
+		},
+		{
+			MethodName: "RetryFailed",
+			Handler:    _DownloadCache_RetryFailed_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _DownloadCache_ListJobs_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _DownloadCache_CancelJob_Handler,
+		},
+		{
+			MethodName: "SelfTest",
+			Handler:    _DownloadCache_SelfTest_Handler,
+		},
+		{
+			MethodName: "RestoreInvalidated",
+			Handler:    _DownloadCache_RestoreInvalidated_Handler,
+		},
+		{
+			MethodName: "GetAccessibilityTree",
+			Handler:    _DownloadCache_GetAccessibilityTree_Handler,
+		},
+		{
+			MethodName: "GetFailureArtifact",
+			Handler:    _DownloadCache_GetFailureArtifact_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetStream",
+			Handler:       _DownloadCache_GetStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetBatch",
+			Handler:       _DownloadCache_GetBatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _DownloadCache_Backup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Restore",
+			Handler:       _DownloadCache_Restore_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCrawl",
+			Handler:       _DownloadCache_ExportCrawl_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WarmFromFile",
+			Handler:       _DownloadCache_WarmFromFile_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadSpilled",
+			Handler:       _DownloadCache_ReadSpilled_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RunAdminJob",
+			Handler:       _DownloadCache_RunAdminJob_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/downloadcache.proto",
 }
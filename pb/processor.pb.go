@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.21.12
+// source: pb/processor.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Points in the request pipeline where processors can run.
+type ProcessingStage int32
+
+const (
+	ProcessingStage_PROCESSING_STAGE_UNSPECIFIED ProcessingStage = 0
+	// On the page as fetched, before it is minified.
+	ProcessingStage_PROCESSING_STAGE_AFTER_FETCH ProcessingStage = 1
+	// On the minified page, just before it is written to the cache.
+	ProcessingStage_PROCESSING_STAGE_BEFORE_STORE ProcessingStage = 2
+	// On every response, including cache hits.  The cached copy is unchanged.
+	ProcessingStage_PROCESSING_STAGE_BEFORE_RESPOND ProcessingStage = 3
+)
+
+// Enum value maps for ProcessingStage.
+var (
+	ProcessingStage_name = map[int32]string{
+		0: "PROCESSING_STAGE_UNSPECIFIED",
+		1: "PROCESSING_STAGE_AFTER_FETCH",
+		2: "PROCESSING_STAGE_BEFORE_STORE",
+		3: "PROCESSING_STAGE_BEFORE_RESPOND",
+	}
+	ProcessingStage_value = map[string]int32{
+		"PROCESSING_STAGE_UNSPECIFIED":    0,
+		"PROCESSING_STAGE_AFTER_FETCH":    1,
+		"PROCESSING_STAGE_BEFORE_STORE":   2,
+		"PROCESSING_STAGE_BEFORE_RESPOND": 3,
+	}
+)
+
+func (x ProcessingStage) Enum() *ProcessingStage {
+	p := new(ProcessingStage)
+	*p = x
+	return p
+}
+
+func (x ProcessingStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProcessingStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_processor_proto_enumTypes[0].Descriptor()
+}
+
+func (ProcessingStage) Type() protoreflect.EnumType {
+	return &file_pb_processor_proto_enumTypes[0]
+}
+
+func (x ProcessingStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProcessingStage.Descriptor instead.
+func (ProcessingStage) EnumDescriptor() ([]byte, []int) {
+	return file_pb_processor_proto_rawDescGZIP(), []int{0}
+}
+
+type ProcessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL the client requested.
+	Url          string          `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Stage        ProcessingStage `protobuf:"varint,2,opt,name=stage,proto3,enum=downloadcache.ProcessingStage" json:"stage,omitempty"`
+	PageContents string          `protobuf:"bytes,3,opt,name=page_contents,json=pageContents,proto3" json:"page_contents,omitempty"`
+}
+
+func (x *ProcessRequest) Reset() {
+	*x = ProcessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_processor_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessRequest) ProtoMessage() {}
+
+func (x *ProcessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_processor_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessRequest.ProtoReflect.Descriptor instead.
+func (*ProcessRequest) Descriptor() ([]byte, []int) {
+	return file_pb_processor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProcessRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProcessRequest) GetStage() ProcessingStage {
+	if x != nil {
+		return x.Stage
+	}
+	return ProcessingStage_PROCESSING_STAGE_UNSPECIFIED
+}
+
+func (x *ProcessRequest) GetPageContents() string {
+	if x != nil {
+		return x.PageContents
+	}
+	return ""
+}
+
+type ProcessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageContents string `protobuf:"bytes,1,opt,name=page_contents,json=pageContents,proto3" json:"page_contents,omitempty"`
+}
+
+func (x *ProcessResponse) Reset() {
+	*x = ProcessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_processor_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResponse) ProtoMessage() {}
+
+func (x *ProcessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_processor_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResponse.ProtoReflect.Descriptor instead.
+func (*ProcessResponse) Descriptor() ([]byte, []int) {
+	return file_pb_processor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProcessResponse) GetPageContents() string {
+	if x != nil {
+		return x.PageContents
+	}
+	return ""
+}
+
+var File_pb_processor_proto protoreflect.FileDescriptor
+
+var file_pb_processor_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x22, 0x7d, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x36, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x9d, 0x01, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x20,
+	0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48,
+	0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x45, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45,
+	0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x10, 0x03, 0x32, 0x55, 0x0a, 0x09, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1d, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x79, 0x6f, 0x75, 0x72, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pb_processor_proto_rawDescOnce sync.Once
+	file_pb_processor_proto_rawDescData = file_pb_processor_proto_rawDesc
+)
+
+func file_pb_processor_proto_rawDescGZIP() []byte {
+	file_pb_processor_proto_rawDescOnce.Do(func() {
+		file_pb_processor_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_processor_proto_rawDescData)
+	})
+	return file_pb_processor_proto_rawDescData
+}
+
+var file_pb_processor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pb_processor_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pb_processor_proto_goTypes = []interface{}{
+	(ProcessingStage)(0),    // 0: downloadcache.ProcessingStage
+	(*ProcessRequest)(nil),  // 1: downloadcache.ProcessRequest
+	(*ProcessResponse)(nil), // 2: downloadcache.ProcessResponse
+}
+var file_pb_processor_proto_depIdxs = []int32{
+	0, // 0: downloadcache.ProcessRequest.stage:type_name -> downloadcache.ProcessingStage
+	1, // 1: downloadcache.Processor.Process:input_type -> downloadcache.ProcessRequest
+	2, // 2: downloadcache.Processor.Process:output_type -> downloadcache.ProcessResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pb_processor_proto_init() }
+func file_pb_processor_proto_init() {
+	if File_pb_processor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pb_processor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_processor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pb_processor_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pb_processor_proto_goTypes,
+		DependencyIndexes: file_pb_processor_proto_depIdxs,
+		EnumInfos:         file_pb_processor_proto_enumTypes,
+		MessageInfos:      file_pb_processor_proto_msgTypes,
+	}.Build()
+	File_pb_processor_proto = out.File
+	file_pb_processor_proto_rawDesc = nil
+	file_pb_processor_proto_goTypes = nil
+	file_pb_processor_proto_depIdxs = nil
+}
@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: pb/processor.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Processor_Process_FullMethodName = "/downloadcache.Processor/Process"
+)
+
+// ProcessorClient is the client API for Processor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProcessorClient interface {
+	// Transforms a page.  An error fails the request, so a page is never
+	// stored or returned without passing through every configured processor.
+	Process(ctx context.Context, in *ProcessRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
+}
+
+type processorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProcessorClient(cc grpc.ClientConnInterface) ProcessorClient {
+	return &processorClient{cc}
+}
+
+func (c *processorClient) Process(ctx context.Context, in *ProcessRequest, opts ...grpc.CallOption) (*ProcessResponse, error) {
+	out := new(ProcessResponse)
+	err := c.cc.Invoke(ctx, Processor_Process_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProcessorServer is the server API for Processor service.
+// All implementations must embed UnimplementedProcessorServer
+// for forward compatibility
+type ProcessorServer interface {
+	// Transforms a page.  An error fails the request, so a page is never
+	// stored or returned without passing through every configured processor.
+	Process(context.Context, *ProcessRequest) (*ProcessResponse, error)
+	mustEmbedUnimplementedProcessorServer()
+}
+
+// UnimplementedProcessorServer must be embedded to have forward compatible implementations.
+type UnimplementedProcessorServer struct {
+}
+
+func (UnimplementedProcessorServer) Process(context.Context, *ProcessRequest) (*ProcessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Process not implemented")
+}
+func (UnimplementedProcessorServer) mustEmbedUnimplementedProcessorServer() {}
+
+// UnsafeProcessorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProcessorServer will
+// result in compilation errors.
+type UnsafeProcessorServer interface {
+	mustEmbedUnimplementedProcessorServer()
+}
+
+func RegisterProcessorServer(s grpc.ServiceRegistrar, srv ProcessorServer) {
+	s.RegisterService(&Processor_ServiceDesc, srv)
+}
+
+func _Processor_Process_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessorServer).Process(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Processor_Process_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessorServer).Process(ctx, req.(*ProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Processor_ServiceDesc is the grpc.ServiceDesc for Processor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Processor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "downloadcache.Processor",
+	HandlerType: (*ProcessorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Process",
+			Handler:    _Processor_Process_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pb/processor.proto",
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// adaptiveTTL learns how long each page stays fresh from whether its
+// content changed between fetches: a page refetched unchanged has its TTL
+// doubled, and a page that changed has it halved, within [min, max].  Pages
+// fetched for the first time start from the average TTL learned for their
+// domain, so static sites settle quickly.
+type adaptiveTTL struct {
+	min, max time.Duration
+
+	mu      sync.Mutex
+	domains map[string]time.Duration // Running average of the TTLs learned for each domain's pages
+}
+
+func newAdaptiveTTL(minTTL, maxTTL time.Duration) *adaptiveTTL {
+	return &adaptiveTTL{min: minTTL, max: maxTTL, domains: make(map[string]time.Duration)}
+}
+
+// learn returns the TTL for a page just fetched, given the metadata of the
+// copy it replaces, if any, and fallback, the TTL for pages nothing is
+// known about.
+func (a *adaptiveTTL) learn(prev, md *entryMetadata, fallback time.Duration) time.Duration {
+	domain := hostOf(md.contentURL())
+	if prev == nil || prev.TTL <= 0 || prev.ContentHash == "" || md.ContentHash == "" {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		if ttl, ok := a.domains[domain]; ok {
+			return ttl
+		}
+		if fallback <= 0 {
+			return a.max
+		}
+		return a.clamp(fallback)
+	}
+
+	ttl := prev.TTL / 2
+	if md.ContentHash == prev.ContentHash {
+		ttl = prev.TTL * 2
+	}
+	ttl = a.clamp(ttl)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if avg, ok := a.domains[domain]; ok {
+		a.domains[domain] = a.clamp((3*avg + ttl) / 4)
+	} else {
+		a.domains[domain] = ttl
+	}
+	return ttl
+}
+
+func (a *adaptiveTTL) clamp(ttl time.Duration) time.Duration {
+	return min(max(ttl, a.min), a.max)
+}
+
+// entryTTL returns how long an entry stays fresh: its learned TTL with
+// adaptive TTLs, else the server's.
+func (s *Server) entryTTL(md *entryMetadata) time.Duration {
+	if s.adaptiveTTL != nil && md.TTL > 0 {
+		return md.TTL
+	}
+	return s.ttl
+}
+
+// maxAgeKey holds the oldest copy a request accepts in its context.
+type maxAgeKey struct{}
+
+// withMaxAge returns ctx for a request that accepts cached copies up to
+// maxAge old, whatever their TTL.  Zero leaves the TTL.
+func withMaxAge(ctx context.Context, maxAge time.Duration) context.Context {
+	if maxAge <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, maxAgeKey{}, maxAge)
+}
+
+// freshFor returns how long an entry stays fresh for a request: the
+// request's max age if it has one, else the entry's TTL.
+func (s *Server) freshFor(ctx context.Context, md *entryMetadata) time.Duration {
+	if maxAge, ok := ctx.Value(maxAgeKey{}).(time.Duration); ok {
+		return maxAge
+	}
+	return s.entryTTL(md)
+}
+
+// response builds a response from an entry, with the time it expires.
+// Held entries never expire.
+func (s *Server) response(md *entryMetadata, content []byte) *pb.DownloadCacheResponse {
+	resp := md.response(content)
+	if ttl := s.entryTTL(md); ttl > 0 && !md.FetchedAt.IsZero() && !md.LegalHold {
+		resp.RevalidateAfter = timestamppb.New(md.FetchedAt.Add(ttl))
+	}
+	return resp
+}
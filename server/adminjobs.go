@@ -0,0 +1,332 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const adminProgressInterval = time.Second
+
+// errNotAffected reports an entry an admin job leaves as it is.
+var errNotAffected = errors.New("entry not affected")
+
+// adminProgress counts the entries an admin job has gone through.
+type adminProgress struct {
+	mu                     sync.Mutex
+	done, affected, failed int64
+	failures               []*pb.ItemResult // Since the last message
+}
+
+// counts reports the entries done and failed, for ListJobs.
+func (p *adminProgress) counts() (queued, done, failed int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return 0, p.done, p.failed
+}
+
+// record counts an entry the job has gone through, with the error it got,
+// if any.
+func (p *adminProgress) record(rawURL string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case err == nil:
+		p.done++
+		p.affected++
+	case errors.Is(err, errNotAffected):
+		p.done++
+	default:
+		p.failed++
+		p.failures = append(p.failures, &pb.ItemResult{
+			Url:       rawURL,
+			Code:      int32(status.Code(err)),
+			Retryable: retryableCode(status.Code(err)),
+			Message:   status.Convert(err).Message(),
+		})
+	}
+}
+
+// message reports the progress since the last message.
+func (p *adminProgress) message(jobID string) *pb.AdminJobProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	msg := &pb.AdminJobProgress{JobId: jobID, Done: p.done, Affected: p.affected, Failed: p.failed, Failures: p.failures}
+	p.failures = nil
+	return msg
+}
+
+// adminJob is a bulk operation over the cache's entries.
+type adminJob struct {
+	req     *pb.AdminJobRequest
+	pattern *regexp.Regexp // nil matches every URL
+	from    cacheLayout    // For reshards
+}
+
+// matches reports whether the job applies to an entry.
+func (j *adminJob) matches(md *entryMetadata) bool {
+	return j.pattern == nil || j.pattern.MatchString(md.URL)
+}
+
+// RunAdminJob handles the gRPC request.
+func (s *Server) RunAdminJob(req *pb.AdminJobRequest, stream pb.DownloadCache_RunAdminJobServer) error {
+	j := &adminJob{req: req}
+	op := req.GetOperation()
+	switch op {
+	case pb.AdminOperation_ADMIN_OPERATION_PURGE:
+		if req.GetUrlPattern() == "" {
+			return status.Errorf(codes.InvalidArgument, "purges need a url_pattern")
+		}
+	case pb.AdminOperation_ADMIN_OPERATION_RECOMPRESS, pb.AdminOperation_ADMIN_OPERATION_VERIFY:
+	case pb.AdminOperation_ADMIN_OPERATION_RESHARD:
+		var err error
+		if j.from, err = parseLayout(req.GetFromKeyScheme(), int(req.GetFromShardDepth())); err != nil {
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if j.from == s.layout {
+			return status.Errorf(codes.InvalidArgument, "the cache already uses key scheme %s and shard depth %d", j.from.keyScheme, j.from.shardDepth)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown operation %v", op)
+	}
+	if req.GetUrlPattern() != "" {
+		var err error
+		if j.pattern, err = regexp.Compile(req.GetUrlPattern()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid url_pattern: %v", err)
+		}
+	}
+
+	description := strings.ToLower(strings.TrimPrefix(op.String(), "ADMIN_OPERATION_"))
+	if req.GetUrlPattern() != "" {
+		description += " " + req.GetUrlPattern()
+	}
+	if req.GetDryRun() {
+		description += " (dry run)"
+	}
+	progress := &adminProgress{}
+	// The job outlives the stream, so it is only canceled by CancelJob.
+	ctx, finish := s.startJob(context.WithoutCancel(stream.Context()), pb.JobKind_JOB_KIND_ADMIN, description, "", progress.counts)
+	jobID := jobIDOf(ctx)
+	s.logger.Printf("Started admin job %s: %s", jobID, description)
+
+	over := make(chan error, 1)
+	go func() {
+		defer finish()
+		err := s.runAdminJob(ctx, j, progress)
+		if err != nil {
+			s.logger.Printf("Admin job %s stopped: %v", jobID, err)
+		} else {
+			s.logger.Printf("Admin job %s finished", jobID)
+		}
+		over <- err
+	}()
+
+	ticker := time.NewTicker(adminProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-over:
+			msg := progress.message(jobID)
+			msg.Finished = true
+			if err != nil {
+				msg.Error = err.Error()
+			}
+			return stream.Send(msg)
+		case <-ticker.C:
+			if err := stream.Send(progress.message(jobID)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+// runAdminJob goes through the entries a job applies to.  It stops if ctx
+// is canceled or the server enters maintenance mode.
+func (s *Server) runAdminJob(ctx context.Context, j *adminJob, progress *adminProgress) error {
+	apply := func(md *entryMetadata, fn func() error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !j.matches(md) {
+			return nil
+		}
+		done, err := s.maintenance.enter()
+		if err != nil {
+			return err
+		}
+		defer done()
+		progress.record(md.URL, fn())
+		return nil
+	}
+
+	var entry func(cacheKey string) error
+	op := j.req.GetOperation()
+	switch op {
+	case pb.AdminOperation_ADMIN_OPERATION_PURGE:
+		entry = func(cacheKey string) error { return s.purgeEntry(cacheKey, j) }
+	case pb.AdminOperation_ADMIN_OPERATION_RECOMPRESS:
+		entry = func(cacheKey string) error { return s.recompressEntry(ctx, cacheKey, j.req.GetDryRun()) }
+	case pb.AdminOperation_ADMIN_OPERATION_VERIFY:
+		entry = func(cacheKey string) error { return s.verifyEntry(ctx, cacheKey) }
+	case pb.AdminOperation_ADMIN_OPERATION_RESHARD:
+		return s.reshard(j, apply)
+	}
+
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil {
+			return nil
+		}
+		return apply(md, func() error { return entry(cacheKey) })
+	})
+	if err != nil || op != pb.AdminOperation_ADMIN_OPERATION_PURGE {
+		return err
+	}
+	// Aliases and cold entries have no content file.
+	return walkDetachedMetadata(s.storage, func(name string, md *entryMetadata) error {
+		return apply(md, func() error { return entry(md.key(s.layout)) })
+	})
+}
+
+// purgeEntry deletes an entry, first moving it to the trash if trashed
+// copies are kept.  Held entries fail.
+func (s *Server) purgeEntry(cacheKey string, j *adminJob) error {
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if errors.Is(err, fs.ErrNotExist) || err == nil && !j.matches(md) {
+		return errNotAffected // Removed or replaced since.
+	} else if err != nil {
+		return err
+	}
+	if md.LegalHold {
+		return status.Errorf(codes.FailedPrecondition, "the cached copy is under legal hold")
+	}
+	if j.req.GetDryRun() {
+		return nil
+	}
+	if s.trashRetention > 0 {
+		if err := s.trashEntry(cacheKey); err != nil {
+			return fmt.Errorf("failed to move the cached copy to the trash: %w", err)
+		}
+	}
+	return s.removeEntry(cacheKey, md)
+}
+
+// recompressEntry rewrites an entry with the server's codec, and its
+// domain's dictionary if there is one, unless it already uses them.
+func (s *Server) recompressEntry(ctx context.Context, cacheKey string, dryRun bool) error {
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return errNotAffected
+	} else if err != nil {
+		return err
+	}
+	dictionary := s.codec == codecZstd && md.Dict == 0 && s.dicts.has(hostOf(md.contentURL()))
+	if !md.hasLocalContent() || md.codec() == s.codec && !dictionary {
+		return errNotAffected
+	}
+	if dryRun {
+		return nil
+	}
+	name := s.contentName(cacheKey)
+	content, err := s.readContent(ctx, name, md)
+	if err != nil {
+		return err
+	}
+	recompressed := *md
+	recompressed.Codec = s.codec
+	if err := s.writeContent(ctx, name, &recompressed, content); err != nil {
+		return err
+	}
+	if err := s.writeMetadata(cacheKey, &recompressed); err != nil {
+		return err
+	}
+	s.trackEntry(cacheKey, &recompressed)
+	return nil
+}
+
+// verifyEntry reads an entry back and checks it against its content hash.
+// Entries cached before hashes were recorded are only read.
+func (s *Server) verifyEntry(ctx context.Context, cacheKey string) error {
+	md, content, err := s.readEntry(ctx, cacheKey)
+	if errors.Is(err, errExpired) || errors.Is(err, fs.ErrNotExist) {
+		// Expired entries are refetched before they are served again.
+		return errNotAffected
+	} else if err != nil {
+		return status.Errorf(codes.DataLoss, "failed to read the cached copy: %v", err)
+	}
+	if md.ContentHash == "" {
+		return errNotAffected
+	}
+	if contentHash(content) != md.ContentHash {
+		return status.Errorf(codes.DataLoss, "the cached copy doesn't match its content hash %s", md.ContentHash)
+	}
+	return nil
+}
+
+// reshard moves the entries of an earlier layout to the server's.
+func (s *Server) reshard(j *adminJob, apply func(*entryMetadata, func() error) error) error {
+	m := &cacheMigrator{storage: s.storage, from: j.from, to: s.layout, lockEntry: s.lockEntry}
+	migrate := func(md *entryMetadata, fn func() error) error {
+		return apply(md, func() error {
+			if j.req.GetDryRun() {
+				return nil
+			}
+			if err := fn(); errors.Is(err, errAlreadyMigrated) {
+				return errNotAffected
+			} else if err != nil {
+				return err
+			}
+			s.untrackEntry(md.key(j.from))
+			s.trackEntry(md.key(s.layout), md)
+			return nil
+		})
+	}
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		key := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != j.from.entryName(key) {
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, j.from, key)
+		if err != nil {
+			return nil
+		}
+		return migrate(md, func() error { return m.migrateEntry(name) })
+	})
+	if err != nil {
+		return err
+	}
+	err = walkDetachedMetadata(s.storage, func(name string, md *entryMetadata) error {
+		if name != j.from.metadataName(md.key(j.from)) {
+			return nil
+		}
+		return migrate(md, func() error { return m.migrateDetached(name, md) })
+	})
+	if err != nil {
+		return err
+	}
+	if d, ok := s.storage.(dirStorage); ok && !j.req.GetDryRun() && j.from.shardDepth != s.layout.shardDepth {
+		removeEmptyDirs(d.root)
+	}
+	return nil
+}
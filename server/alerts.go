@@ -0,0 +1,153 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minAlertRequests is the fewest requests in an interval for ratio alerts to
+// be evaluated; a handful of requests says little about the hit ratio.
+const minAlertRequests = 20
+
+// AlertConfig holds the alert thresholds.  Zero disables a threshold.
+type AlertConfig struct {
+	WebhookURL          string        // Receives a JSON POST when an alert fires or resolves
+	MinHitRatio         float64       // Alert when hits / requests over an interval falls below this
+	MaxErrorRate        float64       // Alert when errors / requests over an interval rises above this
+	MaxSeleniumFailures int           // Alert after this many consecutive Selenium failures
+	Interval            time.Duration // How often ratio alerts are evaluated
+}
+
+func (c AlertConfig) enabled() bool {
+	return c.MinHitRatio > 0 || c.MaxErrorRate > 0 || c.MaxSeleniumFailures > 0
+}
+
+// alerter logs alerts and posts them to a webhook.  Alerts only fire when
+// their state changes, so a sustained problem produces one "firing" and one
+// "resolved" notification rather than one per interval.
+type alerter struct {
+	cfg    AlertConfig
+	client *http.Client
+	logger *levelLogger
+
+	mu     sync.Mutex
+	firing map[string]bool
+}
+
+func newAlerter(cfg AlertConfig, logger *levelLogger) *alerter {
+	return &alerter{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: logger,
+		firing: make(map[string]bool),
+	}
+}
+
+// alertPayload is the webhook body.  The text field makes it usable with
+// Slack-style incoming webhooks as is.
+type alertPayload struct {
+	Alert   string    `json:"alert"`
+	Status  string    `json:"status"`
+	Message string    `json:"message"`
+	Text    string    `json:"text"`
+	Time    time.Time `json:"time"`
+}
+
+// set records whether an alert condition holds, notifying on changes.
+func (a *alerter) set(name string, firing bool, message string) {
+	a.mu.Lock()
+	changed := a.firing[name] != firing
+	a.firing[name] = firing
+	a.mu.Unlock()
+	if !changed {
+		return
+	}
+
+	state := "resolved"
+	if firing {
+		state = "firing"
+		a.logger.Printf("Error: ALERT %s firing: %s", name, message)
+	} else {
+		a.logger.Printf("ALERT %s resolved: %s", name, message)
+	}
+	if a.cfg.WebhookURL != "" {
+		go a.post(alertPayload{
+			Alert:   name,
+			Status:  state,
+			Message: message,
+			Text:    fmt.Sprintf("[downloadcache] %s %s: %s", name, state, message),
+			Time:    time.Now(),
+		})
+	}
+}
+
+func (a *alerter) post(payload alertPayload) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		a.logger.Printf("Error: failed to encode alert: %v", err)
+		return
+	}
+	resp, err := a.client.Post(a.cfg.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		a.logger.Printf("Error: failed to send alert webhook: %v", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		a.logger.Printf("Error: alert webhook returned HTTP %d", resp.StatusCode)
+	}
+}
+
+// runAlerts evaluates the ratio alerts over each interval until ctx is done.
+func (s *Server) runAlerts(ctx context.Context) {
+	ticker := time.NewTicker(s.alerter.cfg.Interval)
+	defer ticker.Stop()
+	last := s.stats.snapshot()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := s.stats.snapshot()
+			s.evaluateRatioAlerts(now.sub(last))
+			last = now
+		}
+	}
+}
+
+func (s *Server) evaluateRatioAlerts(window statsSnapshot) {
+	cfg := s.alerter.cfg
+	requests := window.requests()
+	if requests < minAlertRequests {
+		return
+	}
+	if cfg.MinHitRatio > 0 {
+		ratio := float64(window.hits) / float64(requests)
+		s.alerter.set("low_hit_ratio", ratio < cfg.MinHitRatio,
+			fmt.Sprintf("hit ratio %.1f%% over the last %v (threshold %.1f%%)", 100*ratio, cfg.Interval, 100*cfg.MinHitRatio))
+	}
+	if cfg.MaxErrorRate > 0 {
+		rate := float64(window.errors) / float64(requests)
+		s.alerter.set("high_error_rate", rate > cfg.MaxErrorRate,
+			fmt.Sprintf("error rate %.1f%% over the last %v (threshold %.1f%%)", 100*rate, cfg.Interval, 100*cfg.MaxErrorRate))
+	}
+}
+
+// recordSeleniumResult tracks consecutive Selenium failures.
+func (s *Server) recordSeleniumResult(err error) {
+	if err == nil {
+		if s.stats.seleniumFailuresInARow.Swap(0) > 0 && s.alerter != nil && s.alerter.cfg.MaxSeleniumFailures > 0 {
+			s.alerter.set("selenium_failures", false, "Selenium session succeeded")
+		}
+		return
+	}
+	n := s.stats.seleniumFailuresInARow.Add(1)
+	if s.alerter != nil && s.alerter.cfg.MaxSeleniumFailures > 0 && n >= int64(s.alerter.cfg.MaxSeleniumFailures) {
+		s.alerter.set("selenium_failures", true, fmt.Sprintf("%d Selenium failures in a row, latest: %v", n, err))
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	archiveMaxBytes = 10 << 20
+	// latestSnapshot is a timestamp after every capture; Wayback-style
+	// archives redirect it to the most recent one.
+	latestSnapshot = "99991231235959"
+	// snapshotLayout is the format of timestamps in archive URLs.
+	snapshotLayout = "20060102150405"
+)
+
+// archiveFetcher fetches the most recent copies of pages from a web archive
+// with the Wayback Machine's URL scheme, such as https://web.archive.org/web
+// or a pywb collection.  Copies are fetched with the id_ flag, as they were
+// captured, without the archive's link rewriting and banner.
+type archiveFetcher struct {
+	base string // e.g. "https://web.archive.org/web"
+	http *httpFetcher
+}
+
+// archivedPage is the archive's copy of a page.
+type archivedPage struct {
+	result     *FetchResult
+	url        string    // The copy's URL in the archive
+	archivedAt time.Time // When the archive captured it, if known
+}
+
+func (a *archiveFetcher) fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*archivedPage, error) {
+	snapshot := strings.TrimSuffix(a.base, "/") + "/" + latestSnapshot + "id_/" + rawURL
+	// The archive redirects to its copy, which doesn't count against the
+	// page's max_redirects.
+	opts = proto.Clone(opts).(*pb.FetchOptions)
+	opts.MaxRedirects = nil
+	result, err := a.http.Fetch(ctx, snapshot, opts)
+	if err != nil {
+		return nil, err
+	}
+	page := &archivedPage{result: result, url: snapshot}
+	if chain := result.RedirectChain; len(chain) > 0 {
+		page.url = chain[len(chain)-1].URL
+	}
+	page.archivedAt = snapshotTime(page.url)
+	// The redirects and connection were the archive's; the copy stands in
+	// for the page.
+	result.RedirectChain, result.TLS = nil, nil
+	result.Receipt.Fetcher = fetcherArchive
+	page.url = strings.Replace(page.url, "id_/", "/", 1)
+	return page, nil
+}
+
+// snapshotTime returns the capture time in an archive URL such as
+// https://web.archive.org/web/20240101123456id_/https://example.com/, or the
+// zero time if it has none.
+func snapshotTime(snapshotURL string) time.Time {
+	for _, part := range strings.Split(snapshotURL, "/") {
+		if ts, ok := strings.CutSuffix(part, "id_"); ok && ts != latestSnapshot {
+			t, _ := time.Parse(snapshotLayout, ts)
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// originFailed reports whether a fetch shows that a page's site can't serve
+// it: the fetch failed for a reason other than the request itself, or the
+// page came back with an error status.
+func originFailed(result *FetchResult, err error) bool {
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.Canceled, codes.FailedPrecondition, codes.ResourceExhausted:
+			return false
+		}
+		return true
+	}
+	if chain := result.RedirectChain; len(chain) > 0 {
+		return chain[len(chain)-1].StatusCode >= 400
+	}
+	return false
+}
+
+// fetchArchived fetches a page's copy from the archive after its site
+// failed.  If it fails too and the site's fetch failed with originErr, the
+// error returned mentions both.
+func (s *Server) fetchArchived(ctx context.Context, rawURL string, fetchOpts *pb.FetchOptions, originErr error) (*archivedPage, error) {
+	page, err := s.archive.fetch(ctx, rawURL, fetchOpts)
+	if err != nil {
+		s.logger.Requestf(ctx, "The archive has no copy of %s: %v", rawURL, err)
+		if originErr == nil {
+			return nil, err
+		}
+		return nil, status.Errorf(status.Code(originErr), "%s (the archive has no copy: %v)", status.Convert(originErr).Message(), err)
+	}
+	s.logger.Requestf(ctx, "Fetched %s from the archive at %s", rawURL, page.url)
+	return page, nil
+}
@@ -0,0 +1,216 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxAssetBytes = 10 << 20
+	defaultMaxAssets     = 200
+)
+
+// assetLinkRels are the <link rel> values that load something the page
+// needs to display.
+var assetLinkRels = map[string]bool{
+	"stylesheet":       true,
+	"icon":             true,
+	"apple-touch-icon": true,
+	"preload":          true,
+	"modulepreload":    true,
+}
+
+// pageAssets returns the absolute URLs of the stylesheets, scripts and
+// images a page references from its own origin, in document order and
+// without duplicates.  URLs resolve against pageURL or the page's <base href>.
+func pageAssets(content []byte, pageURL string) []string {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return nil
+	}
+	origin := *base
+
+	var assets []string
+	seen := make(map[string]bool)
+	add := func(ref string) {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			return
+		}
+		u, err := base.Parse(ref)
+		if err != nil || u.Scheme != origin.Scheme || u.Host != origin.Host {
+			return
+		}
+		u.Fragment = ""
+		if s := u.String(); !seen[s] {
+			seen[s] = true
+			assets = append(assets, s)
+		}
+	}
+
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			return assets
+		}
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			continue
+		}
+		t := z.Token()
+		attrs := make(map[string]string, len(t.Attr))
+		for _, a := range t.Attr {
+			attrs[a.Key] = a.Val
+		}
+		switch t.DataAtom {
+		case atom.Base:
+			if ref, err := base.Parse(strings.TrimSpace(attrs["href"])); err == nil {
+				base = ref
+			}
+		case atom.Link:
+			for _, rel := range strings.Fields(strings.ToLower(attrs["rel"])) {
+				if assetLinkRels[rel] {
+					add(attrs["href"])
+					break
+				}
+			}
+		case atom.Script, atom.Img, atom.Source, atom.Input:
+			if src, ok := attrs["src"]; ok {
+				add(src)
+			}
+			if srcset, ok := attrs["srcset"]; ok {
+				for _, c := range strings.Split(srcset, ",") {
+					if fields := strings.Fields(c); len(fields) > 0 {
+						add(fields[0])
+					}
+				}
+			}
+		case atom.Video:
+			if poster, ok := attrs["poster"]; ok {
+				add(poster)
+			}
+		}
+	}
+}
+
+// FetchWithAssets handles the gRPC request.
+func (s *Server) FetchWithAssets(ctx context.Context, req *pb.FetchWithAssetsRequest) (*pb.FetchWithAssetsResponse, error) {
+	ctx = s.logger.sampleRequest(ctx)
+	pageReq := &pb.DownloadCacheRequest{
+		Url:          req.GetUrl(),
+		Tenant:       req.GetTenant(),
+		FetchOptions: req.GetFetchOptions(),
+		CacheOptions: req.GetCacheOptions(),
+	}
+	fetchOpts, cacheOpts := s.mergeOptions(pageReq)
+	s.logger.Requestf(ctx, "Received request for URL with assets: %s", req.GetUrl())
+
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if req.GetMaxAssetBytes() < 0 || req.GetMaxAssets() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_asset_bytes and max_assets must not be negative")
+	}
+	if err := s.validateFetchOptions(fetchOpts); err != nil {
+		return nil, err
+	}
+	if err := validateCacheOptions(cacheOpts); err != nil {
+		return nil, err
+	}
+	if err := s.browser.validateBrowserOptions(req.GetFetchOptions()); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return nil, err
+	}
+	cacheKey := s.layout.tenantKey(req.GetTenant(), req.GetUrl())
+	if !cacheOpts.GetForce() && s.held(s.resolveAlias(cacheKey)) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is under legal hold; set cache_options.force to replace it", req.GetUrl())
+	}
+	if err := s.checkOnline(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := s.checkPaused(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	// Fetch everything before storing anything, so a failure leaves the
+	// cache as it was.
+	fetchStart := time.Now()
+	page, err := s.fetchPage(ctx, s.fetcher, req.GetUrl(), req.GetTenant(), fetchOpts)
+	if err != nil {
+		s.stats.recordError(req.GetUrl())
+		return nil, err
+	}
+	s.stats.recordMiss(req.GetUrl(), time.Since(fetchStart))
+	fetchedBytes := page.fetchedBytes
+
+	var assetURLs []string
+	if isHTML(page.md.ContentType) {
+		assetURLs = pageAssets(page.content, page.md.finalURL())
+	}
+	maxAssets := int(req.GetMaxAssets())
+	if maxAssets == 0 {
+		maxAssets = defaultMaxAssets
+	}
+	if len(assetURLs) > maxAssets {
+		s.logger.Requestf(ctx, "Fetching %d of the %d assets of %s", maxAssets, len(assetURLs), req.GetUrl())
+		assetURLs = assetURLs[:maxAssets]
+	}
+	maxBytes := req.GetMaxAssetBytes()
+	if maxBytes == 0 {
+		maxBytes = defaultMaxAssetBytes
+	}
+	assetFetcher := s.newHTTPFetcher(maxBytes)
+
+	resp := &pb.FetchWithAssetsResponse{}
+	var assets []*fetchedPage
+	for _, assetURL := range assetURLs {
+		start := time.Now()
+		asset, err := s.fetchPage(ctx, assetFetcher, assetURL, req.GetTenant(), fetchOpts)
+		if err != nil {
+			s.stats.recordError(assetURL)
+			if !req.GetAllowMissing() || ctx.Err() != nil {
+				return nil, status.Errorf(status.Code(err), "failed to fetch asset %s of %s: %v", assetURL, req.GetUrl(), status.Convert(err).Message())
+			}
+			resp.Assets = append(resp.Assets, &pb.FetchedAsset{Url: assetURL, Error: status.Convert(err).Message()})
+			continue
+		}
+		s.stats.recordMiss(assetURL, time.Since(start))
+		fetchedBytes += asset.fetchedBytes
+		assets = append(assets, asset)
+		resp.Assets = append(resp.Assets, &pb.FetchedAsset{Url: assetURL, ContentType: asset.md.ContentType, SizeBytes: int64(len(asset.content))})
+	}
+	fetchTime := time.Since(fetchStart)
+	s.usage.record(ctx, req.GetTenant(), func(c *usageCounters) {
+		c.Misses += int64(1 + len(assets))
+		c.BytesFetched += int64(fetchedBytes)
+		c.FetchTime += fetchTime
+	})
+
+	s.storePage(ctx, page, cacheKey, cacheOpts)
+	for _, asset := range assets {
+		s.storePage(ctx, asset, s.layout.tenantKey(req.GetTenant(), asset.md.URL), cacheOpts)
+	}
+	s.logger.Requestf(ctx, "Fetched %s with %d assets in %v", req.GetUrl(), len(assets), fetchTime)
+
+	if resp.Page, err = s.respond(ctx, pageReq, s.response(page.md, page.content)); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
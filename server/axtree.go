@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// axTreeSubdir holds the accessibility trees captured with cached pages,
+// one file per cache key, gzipped, and encrypted like the page.  They keep
+// their codec when the page is recompressed.
+const axTreeSubdir = ".axtree"
+
+// axTreeName returns the Storage name of the accessibility tree for a key.
+func (l cacheLayout) axTreeName(cacheKey string) string {
+	return path.Join(axTreeSubdir, l.relPath(cacheKey))
+}
+
+// accessibilityTree returns the full accessibility tree of a WebDriver
+// session's page, as DevTools returns it.
+func accessibilityTree(ctx context.Context, driverURL, sessionID string) ([]byte, error) {
+	var tree json.RawMessage
+	if err := devtoolsCommand(ctx, driverURL, sessionID, "Accessibility.getFullAXTree", struct{}{}, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// writeAXTree stores the accessibility tree captured with an entry.  The
+// caller holds the entry lock, and records the tree in the entry's metadata
+// once it is written.
+func (s *Server) writeAXTree(ctx context.Context, cacheKey string, md *entryMetadata, tree []byte) error {
+	data, err := codecGzip.encode(tree)
+	if err != nil {
+		return err
+	}
+	if md.encrypted() {
+		c, err := s.tenantCipher(ctx, md.Tenant)
+		if err != nil {
+			return err
+		}
+		if data, err = sealContent(c, md.Tenant, data); err != nil {
+			return err
+		}
+	}
+	return s.storage.Write(s.layout.axTreeName(cacheKey), data)
+}
+
+// readAXTree reads the accessibility tree captured with an entry.
+func (s *Server) readAXTree(ctx context.Context, cacheKey string, md *entryMetadata) ([]byte, error) {
+	data, err := s.storage.Read(s.layout.axTreeName(cacheKey))
+	if err != nil {
+		return nil, err
+	}
+	if md.encrypted() {
+		c, err := s.tenantCipher(ctx, md.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = openContent(c, md.Tenant, data); err != nil {
+			return nil, err
+		}
+	}
+	return codecGzip.decode(data)
+}
+
+// GetAccessibilityTree handles the gRPC request.
+func (s *Server) GetAccessibilityTree(ctx context.Context, req *pb.GetAccessibilityTreeRequest) (*pb.GetAccessibilityTreeResponse, error) {
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return nil, err
+	}
+	cacheKey := s.resolveAlias(s.layout.tenantKey(req.GetTenant(), req.GetUrl()))
+	unlock := s.readLockEntry(cacheKey)
+	defer unlock()
+	md, err := s.readMetadata(cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "%s is not cached", req.GetUrl())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read metadata for %s: %v", req.GetUrl(), err)
+	}
+	if !md.AXTree {
+		return nil, status.Errorf(codes.NotFound, "the cached copy of %s was fetched without capture_accessibility_tree", req.GetUrl())
+	}
+	tree, err := s.readAXTree(ctx, cacheKey, md)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read the accessibility tree of %s: %v", req.GetUrl(), err)
+	}
+	return &pb.GetAccessibilityTreeResponse{AccessibilityTree: string(tree), FetchedAt: timestamppb.New(md.FetchedAt)}, nil
+}
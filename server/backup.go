@@ -0,0 +1,179 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Backup handles the gRPC request.
+func (s *Server) Backup(req *pb.BackupRequest, stream pb.DownloadCache_BackupServer) error {
+	var since time.Time
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
+	s.logger.Printf("Received backup request for entries fetched after %v", since)
+
+	sent := 0
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil {
+			s.logger.Printf("Warning: skipping %s in backup: %v", name, err)
+			return nil
+		}
+		if !md.FetchedAt.After(since) {
+			return nil
+		}
+		content, err := s.storage.Read(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil // Removed since the walk listed it.
+		} else if err != nil {
+			return err
+		}
+		md.Codec = md.codec()
+
+		if err := sendBackupEntry(stream, md, content); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "backup failed after %d entries: %v", sent, err)
+	}
+
+	err = walkDetachedMetadata(s.storage, func(name string, md *entryMetadata) error {
+		if !md.FetchedAt.After(since) {
+			return nil
+		}
+		var content []byte
+		if md.Cold {
+			var err error
+			if content, err = s.coldStore.Get(coldObjectName(md)); err != nil {
+				s.logger.Printf("Warning: skipping cold entry %s in backup: %v", md.URL, err)
+				return nil
+			}
+		}
+		if err := sendBackupEntry(stream, md, content); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "backup failed after %d entries: %v", sent, err)
+	}
+
+	s.logger.Printf("Backup finished: sent %d entries", sent)
+	return nil
+}
+
+func sendBackupEntry(stream pb.DownloadCache_BackupServer, md *entryMetadata, content []byte) error {
+	metadata, err := json.Marshal(md)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.BackupEntry{
+		Url:       md.URL,
+		Metadata:  metadata,
+		Content:   content,
+		FetchedAt: timestamppb.New(md.FetchedAt),
+	})
+}
+
+// Restore handles the gRPC request.
+func (s *Server) Restore(stream pb.DownloadCache_RestoreServer) error {
+	s.logger.Printf("Received restore request")
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	resp := &pb.RestoreResponse{}
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			s.logger.Printf("Restore finished: %d entries restored, %d skipped", resp.Restored, resp.Skipped)
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+
+		restored, err := s.restoreEntry(entry)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to restore %s: %v", entry.GetUrl(), err)
+		}
+		if restored {
+			resp.Restored++
+		} else {
+			resp.Skipped++
+		}
+	}
+}
+
+// restoreEntry writes a backed up entry into the cache, unless the cache
+// already holds a copy fetched more recently or under legal hold.
+func (s *Server) restoreEntry(entry *pb.BackupEntry) (bool, error) {
+	var md entryMetadata
+	if err := json.Unmarshal(entry.GetMetadata(), &md); err != nil {
+		return false, fmt.Errorf("invalid metadata: %w", err)
+	}
+	if md.URL == "" {
+		return false, errors.New("metadata has no URL")
+	}
+
+	cacheKey := md.key(s.layout)
+	if md.AliasOf == "" {
+		if _, err := parseCodec(string(md.codec())); err != nil {
+			return false, err
+		}
+		md.Cold = false      // Restored content is always local.
+		md.AXTree = false    // Backups don't include accessibility trees,
+		md.Thumbnail = false // or thumbnails.
+	}
+
+	// Hold the URL lock so we don't interleave with a download of the same page.
+	unlock := s.urlLocks.lock(md.URL)
+	defer unlock()
+	unlockEntry := s.lockEntry(cacheKey)
+	defer unlockEntry()
+
+	if existing, err := s.readMetadata(cacheKey); err == nil && (existing.LegalHold || existing.FetchedAt.After(md.FetchedAt)) {
+		return false, nil
+	}
+
+	if md.AliasOf != "" {
+		if err := s.storage.Remove(s.contentName(cacheKey)); err != nil {
+			return false, err
+		}
+	} else if err := s.storage.Write(s.contentName(cacheKey), entry.GetContent()); err != nil {
+		return false, err
+	}
+	if err := s.writeMetadata(cacheKey, &md); err != nil {
+		return false, err
+	}
+	s.indexContentHash(cacheKey, &md)
+	s.trackEntry(cacheKey, &md)
+	return true, nil
+}
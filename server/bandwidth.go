@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// bandwidthLimiter caps how fast fetches download, in total and from each
+// domain, so bulk fetching doesn't saturate the uplink.  Downloads over
+// HTTP are held to the limits byte by byte; browser sessions can only be
+// throttled as a whole, so each gets an even share of the limits when it
+// starts.
+type bandwidthLimiter struct {
+	global    *tokenBucket // nil if unlimited
+	perDomain int64        // Bytes per second from each domain; zero is unlimited
+
+	mu      sync.Mutex
+	domains map[string]*tokenBucket
+	active  map[string]int // Browser sessions in progress, by domain
+	total   int
+}
+
+func newBandwidthLimiter(global, perDomain int64) *bandwidthLimiter {
+	if global <= 0 && perDomain <= 0 {
+		return nil
+	}
+	l := &bandwidthLimiter{perDomain: perDomain, domains: make(map[string]*tokenBucket), active: make(map[string]int)}
+	if global > 0 {
+		l.global = newTokenBucket(global)
+	}
+	return l
+}
+
+// domain returns the bucket for a hostname, or nil if domains are unlimited.
+func (l *bandwidthLimiter) domain(host string) *tokenBucket {
+	if l.perDomain <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.domains[host]
+	if !ok {
+		b = newTokenBucket(l.perDomain)
+		l.domains[host] = b
+	}
+	return b
+}
+
+// reader limits a download from host read through r.  A nil limiter
+// doesn't limit anything.
+func (l *bandwidthLimiter) reader(ctx context.Context, host string, r io.Reader) io.Reader {
+	if l == nil {
+		return r
+	}
+	return &limitedReader{ctx: ctx, r: r, buckets: []*tokenBucket{l.global, l.domain(host)}}
+}
+
+// browserShare registers a browser session fetching from host, returning
+// the throughput in bytes per second to throttle it to and a function to
+// call once the session is done.
+func (l *bandwidthLimiter) browserShare(host string) (int64, func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active[host]++
+	l.total++
+	var share int64
+	if l.global != nil {
+		share = l.global.rate / int64(l.total)
+	}
+	if l.perDomain > 0 {
+		if s := l.perDomain / int64(l.active[host]); share == 0 || s < share {
+			share = s
+		}
+	}
+	return max(share, 1), func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.total--
+		if l.active[host]--; l.active[host] == 0 {
+			delete(l.active, host)
+		}
+	}
+}
+
+// tokenBucket allows rate bytes per second, with bursts of up to a
+// second's worth.
+type tokenBucket struct {
+	rate int64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int64) *tokenBucket {
+	return &tokenBucket{rate: rate, tokens: float64(rate), last: time.Now()}
+}
+
+// take removes n bytes' worth of tokens, going into debt if there aren't
+// enough, and returns how long to wait for the debt to be paid off.
+func (b *tokenBucket) take(n int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	b.tokens = min(b.tokens+now.Sub(b.last).Seconds()*float64(b.rate), float64(b.rate))
+	b.last = now
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / float64(b.rate) * float64(time.Second))
+}
+
+// limitedReader waits after each read until every bucket allows it.
+type limitedReader struct {
+	ctx     context.Context
+	r       io.Reader
+	buckets []*tokenBucket
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	var wait time.Duration
+	for _, b := range r.buckets {
+		if b != nil {
+			wait = max(wait, b.take(n))
+		}
+	}
+	if wait > 0 {
+		sleepContext(r.ctx, wait)
+		if r.ctx.Err() != nil {
+			return n, r.ctx.Err()
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	defaultBatchConcurrency = 8
+	maxBatchURLs            = 1000
+)
+
+// GetBatch handles the gRPC request.  Each URL is got as by Get, at most
+// concurrency at a time, and its result is sent as soon as it is done, so a
+// slow fetch doesn't hold back the rest of the batch.
+func (s *Server) GetBatch(req *pb.GetBatchRequest, stream pb.DownloadCache_GetBatchServer) error {
+	ctx := stream.Context()
+	if len(req.GetUrls()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "urls cannot be empty")
+	}
+	if len(req.GetUrls()) > maxBatchURLs {
+		return status.Errorf(codes.InvalidArgument, "at most %d URLs can be got in a batch", maxBatchURLs)
+	}
+	if c := int(req.GetConcurrency()); c < 0 || c > s.batchConcurrency {
+		return status.Errorf(codes.InvalidArgument, "concurrency must be between 0 and %d", s.batchConcurrency)
+	}
+	if req.GetMaxMessageBytes() < 0 {
+		return status.Errorf(codes.InvalidArgument, "max_message_bytes must not be negative")
+	}
+	// The options are the same for every URL, so they are checked once
+	// rather than failing every URL alike.
+	defaults := &pb.DownloadCacheRequest{Tenant: req.GetTenant(), FetchOptions: req.GetFetchOptions(), CacheOptions: req.GetCacheOptions()}
+	fetchOpts, cacheOpts := s.mergeOptions(defaults)
+	if err := s.validateFetchOptions(fetchOpts); err != nil {
+		return err
+	}
+	if err := validateCacheOptions(cacheOpts); err != nil {
+		return err
+	}
+	if err := s.browser.validateBrowserOptions(req.GetFetchOptions()); err != nil {
+		return err
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return err
+	}
+	concurrency := int(req.GetConcurrency())
+	if concurrency == 0 {
+		concurrency = s.batchConcurrency
+	}
+	concurrency = min(concurrency, len(req.GetUrls()))
+	run, err := newFailedRun(runBatch, req.GetTenant())
+	if err != nil {
+		return err
+	}
+	// The URLs that failed are kept, even if the batch is cut short, so
+	// RetryFailed can get them again by the batch's ID.
+	defer s.saveFailedRun(run)
+	s.logger.Printf("Received batch request %s: %d URLs, %d at a time", run.ID, len(req.GetUrls()), concurrency)
+	// Responses are compressed alike, since the compressor can't change
+	// once the first one is sent.
+	if s.compressMinBytes > 0 {
+		s.setResponseCompressor(ctx)
+	}
+
+	indexes := make(chan int)
+	go func() {
+		defer close(indexes)
+		for i := range req.GetUrls() {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	results := make(chan *pb.GetBatchResult, concurrency)
+	var workers sync.WaitGroup
+	for range concurrency {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for i := range indexes {
+				rawURL := req.GetUrls()[i]
+				start := time.Now()
+				itemReq := &pb.DownloadCacheRequest{
+					Url:             rawURL,
+					Tenant:          req.GetTenant(),
+					FetchOptions:    req.GetFetchOptions(),
+					CacheOptions:    req.GetCacheOptions(),
+					MaxMessageBytes: req.GetMaxMessageBytes(),
+				}
+				resp, err := s.Get(ctx, itemReq)
+				result := &pb.GetBatchResult{
+					Index:    int32(i),
+					Result:   &pb.ItemResult{Url: rawURL, Duration: durationpb.New(time.Since(start))},
+					Response: resp,
+					BatchId:  run.ID,
+				}
+				if err != nil {
+					if ctx.Err() == nil {
+						run.fail(itemReq, err, time.Since(start))
+					}
+					result.Result.Code = int32(status.Code(err))
+					result.Result.Retryable = retryableCode(status.Code(err))
+					result.Result.Message = status.Convert(err).Message()
+				}
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	done, failed := 0, 0
+	for result := range results {
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+		if result.GetResult().GetCode() == 0 {
+			done++
+		} else {
+			failed++
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	s.logger.Printf("Batch %s finished: %d URLs done, %d failed", run.ID, done, failed)
+	return nil
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// reservedCapabilities are set by the server itself and can't be passed
+// through.
+var reservedCapabilities = []string{"browserName", "goog:chromeOptions", "goog:loggingPrefs"}
+
+// BrowserConfig is the BROWSER_CONFIG file: the Chrome switches and WebDriver
+// capabilities requests may pass through to the browser, and extra ones to
+// use for some domains.
+type BrowserConfig struct {
+	AllowedArgs          []string              `json:"allowed_args"`           // Switch names, e.g. "--lang"
+	AllowedCapabilities  []string              `json:"allowed_capabilities"`   // e.g. "pageLoadStrategy"
+	AllowedChromeOptions []string              `json:"allowed_chrome_options"` // goog:chromeOptions keys, e.g. "prefs"
+	Domains              []BrowserDomainConfig `json:"domains"`
+}
+
+// BrowserDomainConfig adds browser settings to every fetch from some
+// domains.  They are set by the operator, so they needn't be allowed for
+// requests.  Request settings are applied after them.
+type BrowserDomainConfig struct {
+	Domains       []string                   `json:"domains"` // Hostnames, including their subdomains
+	ChromeArgs    []string                   `json:"chrome_args"`
+	Capabilities  map[string]json.RawMessage `json:"capabilities"`
+	ChromeOptions map[string]json.RawMessage `json:"chrome_options"`
+	// Close cookie consent banners before capturing pages, unless the
+	// request says otherwise.
+	DismissConsent bool `json:"dismiss_consent"`
+	// Inline same-origin iframes into captured pages, unless the request
+	// says otherwise.
+	InlineIframes bool `json:"inline_iframes"`
+	// Save open shadow roots in captured pages, unless the request says
+	// otherwise.
+	SerializeShadowRoots bool `json:"serialize_shadow_roots"`
+	// Steps tried in turn to fetch pages, escalating to the next while the
+	// page looks like a failure: "http", "browser" and "stealth".  Empty
+	// uses the browser.  The first matching entry with a ladder applies.
+	Escalation []string `json:"escalation"`
+}
+
+// loadBrowserConfig reads the browser settings from a JSON file.
+func loadBrowserConfig(path string) (*BrowserConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c BrowserConfig
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid browser config %s: %w", path, err)
+	}
+	for _, name := range c.AllowedCapabilities {
+		if slices.Contains(reservedCapabilities, name) {
+			return nil, fmt.Errorf("invalid browser config %s: capability %q is set by the server", path, name)
+		}
+	}
+	for _, d := range c.Domains {
+		if len(d.Domains) == 0 {
+			return nil, fmt.Errorf("invalid browser config %s: domain entry has no domains", path)
+		}
+		for name := range d.Capabilities {
+			if slices.Contains(reservedCapabilities, name) {
+				return nil, fmt.Errorf("invalid browser config %s: capability %q is set by the server", path, name)
+			}
+		}
+		for _, step := range d.Escalation {
+			if !slices.Contains(escalationSteps, step) {
+				return nil, fmt.Errorf("invalid browser config %s: unknown escalation step %q (want %s)", path, step, strings.Join(escalationSteps, ", "))
+			}
+		}
+	}
+	return &c, nil
+}
+
+// validateBrowserOptions checks the browser settings a request passes
+// through against the allowlists.  A nil config allows none.
+func (c *BrowserConfig) validateBrowserOptions(fetch *pb.FetchOptions) error {
+	var allowedArgs, allowedCaps, allowedOpts []string
+	if c != nil {
+		allowedArgs, allowedCaps, allowedOpts = c.AllowedArgs, c.AllowedCapabilities, c.AllowedChromeOptions
+	}
+	for _, arg := range fetch.GetChromeArgs() {
+		name, _, _ := strings.Cut(arg, "=")
+		if !slices.Contains(allowedArgs, name) {
+			return status.Errorf(codes.InvalidArgument, "chrome arg %q is not allowed", name)
+		}
+	}
+	for name, value := range fetch.GetCapabilities() {
+		if !slices.Contains(allowedCaps, name) {
+			return status.Errorf(codes.InvalidArgument, "capability %q is not allowed", name)
+		}
+		if !json.Valid([]byte(value)) {
+			return status.Errorf(codes.InvalidArgument, "capability %q must be a JSON value", name)
+		}
+	}
+	for name, value := range fetch.GetChromeOptions() {
+		if !slices.Contains(allowedOpts, name) || name == "args" {
+			return status.Errorf(codes.InvalidArgument, "chrome option %q is not allowed", name)
+		}
+		if !json.Valid([]byte(value)) {
+			return status.Errorf(codes.InvalidArgument, "chrome option %q must be a JSON value", name)
+		}
+	}
+	return nil
+}
+
+// applyDomainDefaults adds the settings configured for rawURL's domain to
+// fetch options, under any the request set itself.
+func (c *BrowserConfig) applyDomainDefaults(rawURL string, fetch *pb.FetchOptions) {
+	if c == nil {
+		return
+	}
+	host := hostOf(rawURL)
+	var args []string
+	for _, d := range c.Domains {
+		if !matchesDomain(host, d.Domains) {
+			continue
+		}
+		args = append(args, d.ChromeArgs...)
+		fetch.Capabilities = mergeJSONOptions(d.Capabilities, fetch.Capabilities)
+		fetch.ChromeOptions = mergeJSONOptions(d.ChromeOptions, fetch.ChromeOptions)
+		if d.DismissConsent && fetch.DismissConsent == nil {
+			fetch.DismissConsent = proto.Bool(true)
+		}
+		if d.InlineIframes && fetch.InlineIframes == nil {
+			fetch.InlineIframes = proto.Bool(true)
+		}
+		if d.SerializeShadowRoots && fetch.SerializeShadowRoots == nil {
+			fetch.SerializeShadowRoots = proto.Bool(true)
+		}
+	}
+	// Chrome takes the last of repeated switches, so the request's win.
+	fetch.ChromeArgs = append(args, fetch.ChromeArgs...)
+}
+
+// escalationFor returns the escalation ladder configured for rawURL's
+// domain, or nil if it has none.
+func (c *BrowserConfig) escalationFor(rawURL string) []string {
+	if c == nil {
+		return nil
+	}
+	host := hostOf(rawURL)
+	for _, d := range c.Domains {
+		if len(d.Escalation) > 0 && matchesDomain(host, d.Domains) {
+			return d.Escalation
+		}
+	}
+	return nil
+}
+
+// hasEscalation reports whether any domain has an escalation ladder.
+func (c *BrowserConfig) hasEscalation() bool {
+	return c != nil && slices.ContainsFunc(c.Domains, func(d BrowserDomainConfig) bool { return len(d.Escalation) > 0 })
+}
+
+// mergeJSONOptions adds defaults to options without overriding them.
+func mergeJSONOptions(defaults map[string]json.RawMessage, options map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return options
+	}
+	if options == nil {
+		options = make(map[string]string)
+	}
+	for name, value := range defaults {
+		if _, ok := options[name]; !ok {
+			options[name] = string(value)
+		}
+	}
+	return options
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// canonicalURL returns the absolute rel=canonical URL declared in a page's
+// <head>, resolved against pageURL, or "" if there is none.
+func canonicalURL(pageURL string, content []byte) string {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return ""
+	}
+
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return ""
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			switch t.DataAtom {
+			case atom.Body:
+				return ""
+			case atom.Link:
+				if href := canonicalHref(t); href != "" {
+					ref, err := base.Parse(href)
+					if err != nil {
+						return ""
+					}
+					ref.Fragment = ""
+					return ref.String()
+				}
+			}
+		case html.EndTagToken:
+			if z.Token().DataAtom == atom.Head {
+				return ""
+			}
+		}
+	}
+}
+
+// canonicalHref returns the href of a <link rel="canonical"> tag.
+func canonicalHref(t html.Token) string {
+	var rel, href string
+	for _, a := range t.Attr {
+		switch a.Key {
+		case "rel":
+			rel = a.Val
+		case "href":
+			href = strings.TrimSpace(a.Val)
+		}
+	}
+	for _, r := range strings.Fields(rel) {
+		if strings.EqualFold(r, "canonical") {
+			return href
+		}
+	}
+	return ""
+}
+
+// sameOrigin reports whether two URLs have the same scheme and host.  A
+// page can only name a canonical URL on its own origin, so it can't take
+// over the cache entry of a page on another host, even one of the same
+// site.
+func sameOrigin(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return ua.Host != "" && strings.EqualFold(ua.Scheme, ub.Scheme) && strings.EqualFold(ua.Host, ub.Host)
+}
+
+// canonicalTaken reports whether the entry under a canonical URL's key
+// holds another page than md's, one fetched for a different URL.  A page
+// naming that URL as canonical is then cached under its own URL instead,
+// rather than replacing it.  The caller holds a lock on storeKey.
+func (s *Server) canonicalTaken(storeKey string, md *entryMetadata) bool {
+	prev, err := s.readMetadata(storeKey)
+	return err == nil && prev.hasLocalContent() && prev.URL != md.URL
+}
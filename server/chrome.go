@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+const (
+	chromedriverStartTimeout = 30 * time.Second
+	maxChromedriverBackoff   = 30 * time.Second
+	// chromedriverStableRun is how long a worker must stay up for its restart
+	// backoff to reset.
+	chromedriverStableRun = time.Minute
+)
+
+// chromeWorker is one supervised chromedriver process.  Each worker serves
+// one session at a time.
+type chromeWorker struct {
+	id  int
+	url string
+
+	// Guarded by chromeSupervisor.mu.
+	healthy   bool
+	busy      bool
+	process   *os.Process // The running chromedriver, while healthy
+	started   time.Time
+	pages     int
+	recycling string // Why the worker is being recycled, if it is
+}
+
+// RecyclePolicy says when to restart a browser, since headless Chrome leaks
+// memory over time.  Zero fields don't apply.
+type RecyclePolicy struct {
+	MaxPages    int           // Pages rendered
+	MaxAge      time.Duration // Time since it started
+	MaxMemoryMB int           // Memory used by it and the processes it started
+}
+
+// due returns why a worker that started at started and has rendered pages
+// should be recycled, or "" if it shouldn't.  Memory is checked separately.
+func (p RecyclePolicy) due(started time.Time, pages int) string {
+	if p.MaxPages > 0 && pages >= p.MaxPages {
+		return fmt.Sprintf("%d pages", pages)
+	}
+	if age := time.Since(started); p.MaxAge > 0 && age >= p.MaxAge {
+		return fmt.Sprintf("running for %v", age.Round(time.Second))
+	}
+	return ""
+}
+
+// chromeSupervisor runs a fixed number of local chromedriver processes,
+// restarting any that exit, so the server can render pages without an
+// external Selenium grid.  chromedriver launches headless Chrome itself.
+type chromeSupervisor struct {
+	path    string
+	workers []*chromeWorker
+	policy  RecyclePolicy
+	logger  *levelLogger
+
+	mu   sync.Mutex
+	wake chan struct{} // Closed and replaced whenever a worker changes state
+}
+
+// startChromeSupervisor launches n chromedriver workers listening on
+// consecutive ports starting at basePort.  It returns immediately; workers
+// become available as they finish starting.
+func startChromeSupervisor(ctx context.Context, path string, n, basePort int, policy RecyclePolicy, logger *levelLogger) *chromeSupervisor {
+	cs := &chromeSupervisor{path: path, policy: policy, logger: logger, wake: make(chan struct{})}
+	for i := 0; i < n; i++ {
+		w := &chromeWorker{id: i, url: fmt.Sprintf("http://127.0.0.1:%d", basePort+i)}
+		cs.workers = append(cs.workers, w)
+		go cs.supervise(ctx, w, basePort+i)
+	}
+	cs.logger.Printf("Started %d local chromedriver workers using %s", n, path)
+	return cs
+}
+
+// supervise keeps one worker's chromedriver running until ctx is done.
+func (cs *chromeSupervisor) supervise(ctx context.Context, w *chromeWorker, port int) {
+	backoff := time.Second
+	for ctx.Err() == nil {
+		started := time.Now()
+		err := cs.run(ctx, w, port)
+		if ctx.Err() != nil {
+			return
+		}
+		cs.mu.Lock()
+		reason := w.recycling
+		w.recycling = ""
+		cs.mu.Unlock()
+		if reason != "" {
+			cs.logger.Printf("Recycled chromedriver worker %d after %s", w.id, reason)
+			backoff = time.Second
+			continue
+		}
+		cs.logger.Printf("Error: chromedriver worker %d exited: %v; restarting in %v", w.id, err, backoff)
+
+		if time.Since(started) > chromedriverStableRun {
+			backoff = time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxChromedriverBackoff)
+	}
+}
+
+// run starts chromedriver, marks the worker healthy once it answers, and
+// waits for the process to exit.
+func (cs *chromeSupervisor) run(ctx context.Context, w *chromeWorker, port int) error {
+	cmd := exec.CommandContext(ctx, cs.path, fmt.Sprintf("--port=%d", port))
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	if err := waitForChromedriver(ctx, w.url, exited); err != nil {
+		cmd.Process.Kill()
+		return err
+	}
+	cs.mu.Lock()
+	w.process, w.started, w.pages = cmd.Process, time.Now(), 0
+	cs.mu.Unlock()
+	cs.setHealthy(w, true)
+	defer cs.setHealthy(w, false)
+	return <-exited
+}
+
+// waitForChromedriver polls chromedriver's status endpoint until it is ready.
+func waitForChromedriver(ctx context.Context, url string, exited <-chan error) error {
+	deadline := time.After(chromedriverStartTimeout)
+	for {
+		if resp, err := http.Get(url + "/status"); err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+		select {
+		case err := <-exited:
+			return fmt.Errorf("exited during startup: %v", err)
+		case <-deadline:
+			return fmt.Errorf("not ready after %v", chromedriverStartTimeout)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (cs *chromeSupervisor) setHealthy(w *chromeWorker, healthy bool) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	w.healthy = healthy
+	cs.broadcast()
+}
+
+// broadcast wakes every goroutine waiting in acquire.  Callers hold cs.mu.
+func (cs *chromeSupervisor) broadcast() {
+	close(cs.wake)
+	cs.wake = make(chan struct{})
+}
+
+// acquire waits for a healthy, idle worker and reserves it.  Idle workers
+// due for recycling by age are recycled instead.
+func (cs *chromeSupervisor) acquire(ctx context.Context) (*chromeWorker, error) {
+	for {
+		cs.mu.Lock()
+		for _, w := range cs.workers {
+			if !w.healthy || w.busy {
+				continue
+			}
+			if reason := cs.policy.due(w.started, w.pages); reason != "" {
+				cs.recycleLocked(w, reason)
+				continue
+			}
+			w.busy = true
+			cs.mu.Unlock()
+			return w, nil
+		}
+		wake := cs.wake
+		cs.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// release returns a worker reserved by acquire after it rendered pages,
+// recycling it if the policy says it is due.
+func (cs *chromeSupervisor) release(w *chromeWorker, pages int) {
+	cs.mu.Lock()
+	w.pages += pages
+	reason := cs.policy.due(w.started, w.pages)
+	process := w.process
+	cs.mu.Unlock()
+
+	if reason == "" && cs.policy.MaxMemoryMB > 0 && process != nil {
+		if _, rss, err := processTree(process.Pid); err != nil {
+			cs.logger.Printf("Warning: failed to measure memory of chromedriver worker %d: %v", w.id, err)
+		} else if mb := rss >> 20; mb >= int64(cs.policy.MaxMemoryMB) {
+			reason = fmt.Sprintf("using %d MB", mb)
+		}
+	}
+
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	w.busy = false
+	if reason != "" {
+		cs.recycleLocked(w, reason)
+	}
+	cs.broadcast()
+}
+
+// kill recycles the worker with a WebDriver URL straight away, even while it
+// is rendering a page.
+func (cs *chromeSupervisor) kill(url, reason string) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	for _, w := range cs.workers {
+		if w.url == url {
+			cs.recycleLocked(w, reason)
+		}
+	}
+}
+
+// recycleLocked takes a healthy worker out of service and kills its
+// chromedriver, along with any browsers it left behind; supervise then
+// starts it again.  The caller holds cs.mu.
+func (cs *chromeSupervisor) recycleLocked(w *chromeWorker, reason string) {
+	if !w.healthy || w.process == nil {
+		return
+	}
+	w.healthy, w.recycling = false, reason
+	pids, _, err := processTree(w.process.Pid)
+	if err != nil {
+		pids = []int{w.process.Pid}
+	}
+	// Kill the browsers first, so they aren't left running without their
+	// parent.
+	for i := len(pids) - 1; i >= 0; i-- {
+		if p, err := os.FindProcess(pids[i]); err == nil {
+			p.Kill()
+		}
+	}
+	w.process = nil
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// codec names the compression a cache entry is stored with.  The name is
+// recorded in the entry's metadata so entries written with different codecs
+// can coexist, e.g. while migrating a cache from gzip to zstd.
+type codec string
+
+const (
+	codecGzip codec = "gzip"
+	codecZstd codec = "zstd"
+	codecNone codec = "none"
+)
+
+// parseCodec validates a codec name.
+func parseCodec(name string) (codec, error) {
+	switch c := codec(name); c {
+	case codecGzip, codecZstd, codecNone:
+		return c, nil
+	}
+	return "", fmt.Errorf("unknown codec %q (want gzip, zstd or none)", name)
+}
+
+// newWriter wraps w so that writes are compressed.  The caller must Close the
+// returned writer to flush it; closing does not close w.
+func (c codec) newWriter(w io.Writer) (io.WriteCloser, error) {
+	switch c {
+	case codecGzip:
+		return gzip.NewWriter(w), nil
+	case codecZstd:
+		return zstd.NewWriter(w)
+	case codecNone:
+		return nopWriteCloser{w}, nil
+	}
+	return nil, fmt.Errorf("unknown codec %q", c)
+}
+
+// newReader wraps r so that reads are decompressed.
+func (c codec) newReader(r io.Reader) (io.ReadCloser, error) {
+	switch c {
+	case codecGzip:
+		return gzip.NewReader(r)
+	case codecZstd:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	case codecNone:
+		return io.NopCloser(r), nil
+	}
+	return nil, fmt.Errorf("unknown codec %q", c)
+}
+
+// sniffCodec guesses the codec of stored content from its leading bytes.
+func sniffCodec(header []byte) codec {
+	switch {
+	case bytes.HasPrefix(header, []byte{0x1f, 0x8b}):
+		return codecGzip
+	case bytes.HasPrefix(header, []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		return codecZstd
+	}
+	return codecNone
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// encode compresses content.
+func (c codec) encode(content []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := c.newWriter(&buf)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(content); err != nil {
+		w.Close()
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decode decompresses data written by encode.
+func (c codec) decode(data []byte) ([]byte, error) {
+	r, err := c.newReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,616 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPort     = "50051"
+	defaultCacheDir = "/cache" // This path will be used inside the Docker container
+
+	defaultMaxRedirects = 10
+
+	defaultColdAfter       = 30 * 24 * time.Hour
+	defaultTieringInterval = time.Hour
+
+	defaultAlertInterval = 5 * time.Minute
+
+	defaultAutoscaleHeadroom = 1.25
+
+	defaultLocalChromeWorkers   = 2
+	defaultChromedriverBasePort = 9515
+
+	defaultSessionMaxUses     = 20
+	defaultSessionIdleTimeout = 2 * time.Minute
+)
+
+// Config holds the server settings.  Start from DefaultConfig, or read the
+// settings from the environment with LoadConfig.
+type Config struct {
+	Port        string   // gRPC port, for the main binary
+	Listen      []string // gRPC listener addresses, used instead of Port if set; see Listen
+	HTTPPort    string   // HTTP gateway port, for the main binary; empty disables the gateway
+	MetricsPort string   // Prometheus metrics port, for the main binary; empty disables metrics
+
+	UnixSocketMode  os.FileMode // Permissions of unix:// listener sockets
+	UnixSocketGroup string      // Group owning unix:// listener sockets; empty keeps the server's
+
+	Storage        string // Where entries are kept: dir (CacheDir) or memory
+	MemoryMaxBytes int    // Size cap for memory storage; zero is unlimited
+	MaxCacheBytes  int    // Least recently used entries are evicted past this size; zero never evicts
+	CacheDir       string
+	SeleniumURL    string // Remote WebDriver URL
+	MaxRedirects   int    // Navigations that follow more redirects than this are rejected
+	AliasCanonical bool   // Store pages under their rel=canonical URL and alias the requested URL to it
+	SearchIndex    bool   // Keep a full-text index of cached pages for Search
+	Codec          string // Compression for new entries: gzip, zstd or none
+	KeyScheme      string // How URLs map to file names: escaped or sha256
+	ShardDepth     int    // Directory levels entries are spread over, 0-4
+	GCInterval     time.Duration
+
+	ColdStorageDir  string // Where idle entries are moved; empty disables tiering
+	ColdAfter       time.Duration
+	TieringInterval time.Duration
+
+	DictTrainInterval time.Duration // How often zstd dictionaries are trained for domains with templated pages; zero never trains them
+
+	CacheTTL time.Duration // Entries fetched longer ago are refetched; zero keeps them forever
+
+	// AdaptiveTTLMin and AdaptiveTTLMax bound the TTLs learned for pages
+	// from how often their content changes.  Zero AdaptiveTTLMax disables
+	// learning.
+	AdaptiveTTLMin time.Duration
+	AdaptiveTTLMax time.Duration
+
+	PrefetchInterval time.Duration // Least time between prefetches of linked pages
+
+	FetchHardTimeout time.Duration // Fetches running longer are canceled and abandoned; zero never
+
+	// WriteWorkers store fetched pages in the background, from a queue of
+	// WriteQueueSize, so requests don't wait for them; zero stores them
+	// before answering.
+	WriteWorkers   int
+	WriteQueueSize int
+	Fsync          bool // Flush each write to disk before it completes
+
+	PreloadEntries int // Most recently used entries read at startup, to warm the OS page cache
+
+	TrashRetention time.Duration // How long copies replaced by invalidating requests can be restored; zero keeps none
+
+	Retention         *RetentionConfig // Rules for deleting old entries; nil keeps them
+	RetentionInterval time.Duration
+
+	ExportDir string // Where ExportCrawl saves exports; empty only streams them
+
+	Alerts AlertConfig
+
+	Faults FaultConfig // Faults to inject, for testing; none by default
+
+	PauseAfterFailures int           // Fetch failures in a row that pause a domain; zero never pauses
+	PauseCooldown      time.Duration // How long a domain stays paused
+
+	AutoscaleHeadroom float64 // Multiplier applied to peak demand when reporting desired sessions
+	LoadCapacity      int     // Browser sessions the server can run at once, for load reports; zero is LocalChromeWorkers with ChromedriverPath, else unknown
+
+	ChromedriverPath     string // If set, run local chromedriver workers instead of using SeleniumURL
+	LocalChromeWorkers   int
+	ChromedriverBasePort int
+	ChromeRecycle        RecyclePolicy // When to restart local chromedriver workers
+
+	SessionPoolSize    int           // WebDriver sessions kept open between fetches; zero opens one per fetch
+	SessionMaxUses     int           // Pages a pooled session renders before it is closed; zero is unlimited
+	SessionIdleTimeout time.Duration // How long a pooled session is kept unused; zero is forever
+
+	RawDownloadMaxBytes int // Binary documents up to this size are downloaded over HTTP instead of in the browser; zero always uses the browser
+
+	ThumbnailWidth int // Width in pixels of the thumbnails stored with rendered pages; zero takes none
+
+	BandwidthLimit       int // Bytes per second all fetches may download together; zero is unlimited
+	DomainBandwidthLimit int // Bytes per second fetches may download from each domain; zero is unlimited
+
+	ArchiveURL string // Web archive for FetchOptions.archive_fallback, e.g. "https://web.archive.org/web"; empty disables it
+
+	FetcherPluginAddr string // gRPC address of a Fetcher plugin used instead of Selenium
+
+	Offline   bool     // Start in offline mode; see Server.SetOfflineMode
+	LogLevel  string   // info, warning or error; see Server.SetLogLevel
+	LogRedact []string // Built-in redaction patterns applied to log messages, e.g. "email"
+
+	ReplayMode   string // "record" saves fetches to ReplayBundle; "replay" serves fetches only from it
+	ReplayBundle string // Replay bundle directory
+
+	Processors []ProcessorConfig
+
+	Browser *BrowserConfig // Chrome switches and capabilities requests may set, and per-domain ones; nil allows none
+	Egress  *EgressConfig  // Pools of addresses and proxies fetches leave through; nil fetches directly
+
+	UserAgent string // User-Agent of plain HTTP fetches; empty sends Go's
+
+	TenantKeys map[string][]byte // AES keys (16, 24 or 32 bytes) encrypting each tenant's entries
+
+	HostOverrides map[string]string // Hostname to IP address used for fetches instead of DNS
+	DNSServer     string            // host:port of a DNS server used for fetches instead of the system's
+
+	CompressMinBytes int // Get responses at least this large are sent compressed; zero leaves it to the client
+	MaxMessageBytes  int // Get responses larger than this are spilled for ReadSpilled; zero never spills
+	StreamChunkBytes int // Most content bytes in one GetStream message, unless the request says otherwise
+	BatchConcurrency int // Most URLs a GetBatch call gets at once
+
+	GatewaySigningKey string // Key for gateway signed URLs; empty leaves the gateway open
+	GatewayBaseURL    string // Prefix for signed URLs, e.g. "https://cache.example.com"
+	// Cache-Control directives for gateway responses, e.g. "public", sent
+	// with a max-age from the page's TTL and an Age from its fetch time;
+	// empty sends neither header.
+	GatewayCacheControl string
+}
+
+// DefaultConfig returns the settings used when nothing is configured.  A
+// fetcher (SeleniumURL, ChromedriverPath or FetcherPluginAddr) must still be set.
+func DefaultConfig() Config {
+	return Config{
+		Port:                 defaultPort,
+		UnixSocketMode:       defaultUnixSocketMode,
+		Storage:              storageDir,
+		LogLevel:             "info",
+		CacheDir:             defaultCacheDir,
+		MaxRedirects:         defaultMaxRedirects,
+		Codec:                string(codecGzip),
+		KeyScheme:            string(keySchemeEscaped),
+		ColdAfter:            defaultColdAfter,
+		TieringInterval:      defaultTieringInterval,
+		RetentionInterval:    defaultRetentionInterval,
+		PauseCooldown:        defaultPauseCooldown,
+		Alerts:               AlertConfig{Interval: defaultAlertInterval},
+		AutoscaleHeadroom:    defaultAutoscaleHeadroom,
+		CompressMinBytes:     defaultCompressMinBytes,
+		MaxMessageBytes:      defaultMaxMessageBytes,
+		StreamChunkBytes:     defaultStreamChunkBytes,
+		BatchConcurrency:     defaultBatchConcurrency,
+		PrefetchInterval:     defaultPrefetchInterval,
+		FetchHardTimeout:     defaultFetchHardTimeout,
+		WriteQueueSize:       defaultWriteQueueSize,
+		LocalChromeWorkers:   defaultLocalChromeWorkers,
+		ChromedriverBasePort: defaultChromedriverBasePort,
+		SessionMaxUses:       defaultSessionMaxUses,
+		SessionIdleTimeout:   defaultSessionIdleTimeout,
+	}
+}
+
+// loadCapacity returns how many browser sessions the server can run at
+// once, or zero if it doesn't know.
+func (cfg Config) loadCapacity() int {
+	if cfg.LoadCapacity == 0 && cfg.ChromedriverPath != "" {
+		return cfg.LocalChromeWorkers
+	}
+	return cfg.LoadCapacity
+}
+
+// LoadConfig reads the server configuration from environment variables.
+func LoadConfig() (Config, error) {
+	cfg := DefaultConfig()
+	cfg.Port = envString("PORT", cfg.Port)
+	if v := os.Getenv("LISTEN_ADDRS"); v != "" {
+		for _, addr := range strings.Split(v, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				cfg.Listen = append(cfg.Listen, addr)
+			}
+		}
+	}
+	if v := os.Getenv("UNIX_SOCKET_MODE"); v != "" {
+		mode, err := strconv.ParseUint(v, 8, 32)
+		if err != nil || mode > 0o777 {
+			return cfg, fmt.Errorf("invalid UNIX_SOCKET_MODE %q: want octal permissions such as 660", v)
+		}
+		cfg.UnixSocketMode = os.FileMode(mode)
+	}
+	cfg.UnixSocketGroup = os.Getenv("UNIX_SOCKET_GROUP")
+	cfg.HTTPPort = os.Getenv("HTTP_PORT")
+	cfg.MetricsPort = os.Getenv("METRICS_PORT")
+	cfg.Storage = envString("CACHE_STORAGE", cfg.Storage)
+	cfg.LogLevel = envString("LOG_LEVEL", cfg.LogLevel)
+	if v := os.Getenv("LOG_REDACT"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.LogRedact = append(cfg.LogRedact, name)
+			}
+		}
+	}
+	cfg.CacheDir = envString("CACHE_DIR", cfg.CacheDir)
+	cfg.SeleniumURL = os.Getenv("SELENIUM_URL")
+	cfg.FetcherPluginAddr = os.Getenv("FETCHER_PLUGIN_ADDR")
+	cfg.ReplayMode = os.Getenv("REPLAY_MODE")
+	cfg.ReplayBundle = os.Getenv("REPLAY_BUNDLE")
+	cfg.GatewaySigningKey = os.Getenv("GATEWAY_SIGNING_KEY")
+	cfg.GatewayBaseURL = os.Getenv("GATEWAY_BASE_URL")
+	cfg.GatewayCacheControl = os.Getenv("GATEWAY_CACHE_CONTROL")
+	cfg.Codec = envString("CACHE_CODEC", cfg.Codec)
+	cfg.KeyScheme = envString("CACHE_KEY_SCHEME", cfg.KeyScheme)
+
+	var err error
+	if cfg.MemoryMaxBytes, err = envInt("CACHE_MEMORY_MAX_BYTES", cfg.MemoryMaxBytes); err != nil {
+		return cfg, err
+	}
+	if cfg.MaxCacheBytes, err = envInt("MAX_CACHE_BYTES", cfg.MaxCacheBytes); err != nil {
+		return cfg, err
+	}
+	if cfg.Offline, err = envBool("OFFLINE_MODE", cfg.Offline); err != nil {
+		return cfg, err
+	}
+	if cfg.MaxRedirects, err = envInt("MAX_REDIRECTS", cfg.MaxRedirects); err != nil {
+		return cfg, err
+	}
+	if cfg.AliasCanonical, err = envBool("CANONICAL_ALIASING", cfg.AliasCanonical); err != nil {
+		return cfg, err
+	}
+	if cfg.SearchIndex, err = envBool("SEARCH_INDEX", cfg.SearchIndex); err != nil {
+		return cfg, err
+	}
+	if cfg.ShardDepth, err = envInt("CACHE_SHARD_DEPTH", cfg.ShardDepth); err != nil {
+		return cfg, err
+	}
+	if cfg.GCInterval, err = envDuration("GC_INTERVAL", cfg.GCInterval); err != nil {
+		return cfg, err
+	}
+	cfg.ColdStorageDir = os.Getenv("COLD_STORAGE_DIR")
+	cfg.ExportDir = os.Getenv("EXPORT_DIR")
+	if cfg.ColdAfter, err = envDuration("COLD_AFTER", cfg.ColdAfter); err != nil {
+		return cfg, err
+	}
+	if cfg.TieringInterval, err = envDuration("TIERING_INTERVAL", cfg.TieringInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.DictTrainInterval, err = envDuration("DICT_TRAIN_INTERVAL", cfg.DictTrainInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.CacheTTL, err = envDuration("CACHE_TTL", cfg.CacheTTL); err != nil {
+		return cfg, err
+	}
+	if cfg.AdaptiveTTLMin, err = envDuration("ADAPTIVE_TTL_MIN", cfg.AdaptiveTTLMin); err != nil {
+		return cfg, err
+	}
+	if cfg.AdaptiveTTLMax, err = envDuration("ADAPTIVE_TTL_MAX", cfg.AdaptiveTTLMax); err != nil {
+		return cfg, err
+	}
+	if cfg.PrefetchInterval, err = envDuration("PREFETCH_INTERVAL", cfg.PrefetchInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.FetchHardTimeout, err = envDuration("FETCH_HARD_TIMEOUT", cfg.FetchHardTimeout); err != nil {
+		return cfg, err
+	}
+	if cfg.WriteWorkers, err = envInt("WRITE_WORKERS", cfg.WriteWorkers); err != nil {
+		return cfg, err
+	}
+	if cfg.WriteQueueSize, err = envInt("WRITE_QUEUE_SIZE", cfg.WriteQueueSize); err != nil {
+		return cfg, err
+	}
+	if cfg.Fsync, err = envBool("FSYNC", cfg.Fsync); err != nil {
+		return cfg, err
+	}
+	if cfg.PreloadEntries, err = envInt("PRELOAD_ENTRIES", cfg.PreloadEntries); err != nil {
+		return cfg, err
+	}
+	if cfg.TrashRetention, err = envDuration("TRASH_RETENTION", cfg.TrashRetention); err != nil {
+		return cfg, err
+	}
+	if cfg.PauseAfterFailures, err = envInt("PAUSE_AFTER_FAILURES", cfg.PauseAfterFailures); err != nil {
+		return cfg, err
+	}
+	if cfg.PauseCooldown, err = envDuration("PAUSE_COOLDOWN", cfg.PauseCooldown); err != nil {
+		return cfg, err
+	}
+	if path := os.Getenv("RETENTION_CONFIG"); path != "" {
+		if cfg.Retention, err = loadRetentionConfig(path); err != nil {
+			return cfg, err
+		}
+	}
+	if cfg.RetentionInterval, err = envDuration("RETENTION_INTERVAL", cfg.RetentionInterval); err != nil {
+		return cfg, err
+	}
+	cfg.Alerts.WebhookURL = os.Getenv("ALERT_WEBHOOK_URL")
+	if cfg.Alerts.MinHitRatio, err = envFloat("ALERT_MIN_HIT_RATIO", cfg.Alerts.MinHitRatio); err != nil {
+		return cfg, err
+	}
+	if cfg.Alerts.MaxErrorRate, err = envFloat("ALERT_MAX_ERROR_RATE", cfg.Alerts.MaxErrorRate); err != nil {
+		return cfg, err
+	}
+	if cfg.Alerts.MaxSeleniumFailures, err = envInt("ALERT_SELENIUM_FAILURES", cfg.Alerts.MaxSeleniumFailures); err != nil {
+		return cfg, err
+	}
+	if cfg.Alerts.Interval, err = envDuration("ALERT_INTERVAL", cfg.Alerts.Interval); err != nil {
+		return cfg, err
+	}
+	if cfg.Faults.FetchDelayRate, err = envFloat("FAULT_FETCH_DELAY_RATE", cfg.Faults.FetchDelayRate); err != nil {
+		return cfg, err
+	}
+	if cfg.Faults.FetchDelay, err = envDuration("FAULT_FETCH_DELAY", cfg.Faults.FetchDelay); err != nil {
+		return cfg, err
+	}
+	if cfg.Faults.FetchErrorRate, err = envFloat("FAULT_FETCH_ERROR_RATE", cfg.Faults.FetchErrorRate); err != nil {
+		return cfg, err
+	}
+	if cfg.Faults.WriteErrorRate, err = envFloat("FAULT_WRITE_ERROR_RATE", cfg.Faults.WriteErrorRate); err != nil {
+		return cfg, err
+	}
+	if cfg.AutoscaleHeadroom, err = envFloat("AUTOSCALE_HEADROOM", cfg.AutoscaleHeadroom); err != nil {
+		return cfg, err
+	}
+	if cfg.LoadCapacity, err = envInt("LOAD_CAPACITY", cfg.LoadCapacity); err != nil {
+		return cfg, err
+	}
+	if cfg.CompressMinBytes, err = envInt("GRPC_COMPRESS_MIN_BYTES", cfg.CompressMinBytes); err != nil {
+		return cfg, err
+	}
+	if cfg.MaxMessageBytes, err = envInt("GRPC_MAX_MESSAGE_BYTES", cfg.MaxMessageBytes); err != nil {
+		return cfg, err
+	}
+	if cfg.StreamChunkBytes, err = envInt("STREAM_CHUNK_BYTES", cfg.StreamChunkBytes); err != nil {
+		return cfg, err
+	}
+	if cfg.BatchConcurrency, err = envInt("BATCH_CONCURRENCY", cfg.BatchConcurrency); err != nil {
+		return cfg, err
+	}
+	if path := os.Getenv("PROCESSORS_CONFIG"); path != "" {
+		if cfg.Processors, err = loadProcessorConfig(path); err != nil {
+			return cfg, err
+		}
+	}
+	if path := os.Getenv("BROWSER_CONFIG"); path != "" {
+		if cfg.Browser, err = loadBrowserConfig(path); err != nil {
+			return cfg, err
+		}
+	}
+	if path := os.Getenv("EGRESS_CONFIG"); path != "" {
+		if cfg.Egress, err = loadEgressConfig(path); err != nil {
+			return cfg, err
+		}
+	}
+	if v := os.Getenv("TENANT_KEYS"); v != "" {
+		if cfg.TenantKeys, err = parseTenantKeys(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := os.Getenv("HOST_OVERRIDES"); v != "" {
+		if cfg.HostOverrides, err = parseHostOverrides(v); err != nil {
+			return cfg, err
+		}
+	}
+	cfg.DNSServer = os.Getenv("DNS_SERVER")
+	cfg.UserAgent = os.Getenv("USER_AGENT")
+	cfg.ChromedriverPath = os.Getenv("CHROMEDRIVER_PATH")
+	if cfg.LocalChromeWorkers, err = envInt("LOCAL_CHROME_WORKERS", cfg.LocalChromeWorkers); err != nil {
+		return cfg, err
+	}
+	if cfg.ChromedriverBasePort, err = envInt("CHROMEDRIVER_BASE_PORT", cfg.ChromedriverBasePort); err != nil {
+		return cfg, err
+	}
+	if cfg.ChromeRecycle.MaxPages, err = envInt("CHROME_RECYCLE_PAGES", cfg.ChromeRecycle.MaxPages); err != nil {
+		return cfg, err
+	}
+	if cfg.ChromeRecycle.MaxAge, err = envDuration("CHROME_RECYCLE_AGE", cfg.ChromeRecycle.MaxAge); err != nil {
+		return cfg, err
+	}
+	if cfg.ChromeRecycle.MaxMemoryMB, err = envInt("CHROME_RECYCLE_MEMORY_MB", cfg.ChromeRecycle.MaxMemoryMB); err != nil {
+		return cfg, err
+	}
+	if cfg.SessionPoolSize, err = envInt("SESSION_POOL_SIZE", cfg.SessionPoolSize); err != nil {
+		return cfg, err
+	}
+	if cfg.SessionMaxUses, err = envInt("SESSION_MAX_USES", cfg.SessionMaxUses); err != nil {
+		return cfg, err
+	}
+	if cfg.SessionIdleTimeout, err = envDuration("SESSION_IDLE_TIMEOUT", cfg.SessionIdleTimeout); err != nil {
+		return cfg, err
+	}
+	if cfg.RawDownloadMaxBytes, err = envInt("RAW_DOWNLOAD_MAX_BYTES", cfg.RawDownloadMaxBytes); err != nil {
+		return cfg, err
+	}
+	if cfg.ThumbnailWidth, err = envInt("THUMBNAIL_WIDTH", cfg.ThumbnailWidth); err != nil {
+		return cfg, err
+	}
+	if cfg.BandwidthLimit, err = envInt("BANDWIDTH_LIMIT", cfg.BandwidthLimit); err != nil {
+		return cfg, err
+	}
+	if cfg.DomainBandwidthLimit, err = envInt("DOMAIN_BANDWIDTH_LIMIT", cfg.DomainBandwidthLimit); err != nil {
+		return cfg, err
+	}
+	cfg.ArchiveURL = os.Getenv("ARCHIVE_URL")
+	return cfg, nil
+}
+
+// validate checks the settings that NewServer doesn't parse itself.
+func (cfg Config) validate() error {
+	if cfg.MaxRedirects < 0 {
+		return fmt.Errorf("MaxRedirects must not be negative")
+	}
+	for _, addr := range cfg.Listen {
+		if _, _, err := parseListenAddr(addr); err != nil {
+			return err
+		}
+	}
+	// Only gRPC can be served on Unix sockets, and with them alone the
+	// other servers would have no interface to bind.
+	if (cfg.HTTPPort != "" || cfg.MetricsPort != "") && len(cfg.portAddrs("0")) == 0 {
+		return fmt.Errorf("HTTPPort and MetricsPort need a TCP address in Listen")
+	}
+	if cfg.Storage != storageDir && cfg.Storage != storageMemory {
+		return fmt.Errorf("unknown storage %q (want %s or %s)", cfg.Storage, storageDir, storageMemory)
+	}
+	if cfg.MemoryMaxBytes < 0 {
+		return fmt.Errorf("MemoryMaxBytes must not be negative")
+	}
+	if cfg.MaxCacheBytes < 0 {
+		return fmt.Errorf("MaxCacheBytes must not be negative")
+	}
+	if cfg.MaxMessageBytes < 0 {
+		return fmt.Errorf("MaxMessageBytes must not be negative")
+	}
+	if cfg.StreamChunkBytes <= 0 {
+		return fmt.Errorf("StreamChunkBytes must be positive")
+	}
+	if cfg.BatchConcurrency <= 0 {
+		return fmt.Errorf("BatchConcurrency must be positive")
+	}
+	switch cfg.ReplayMode {
+	case "":
+	case replayModeRecord, replayModeReplay:
+		if cfg.ReplayBundle == "" {
+			return fmt.Errorf("%s mode requires ReplayBundle", cfg.ReplayMode)
+		}
+	default:
+		return fmt.Errorf("unknown replay mode %q (want %s or %s)", cfg.ReplayMode, replayModeRecord, replayModeReplay)
+	}
+	if cfg.ChromedriverPath != "" && cfg.LocalChromeWorkers < 1 {
+		return fmt.Errorf("LocalChromeWorkers must be positive")
+	}
+	if p := cfg.ChromeRecycle; p.MaxPages < 0 || p.MaxAge < 0 || p.MaxMemoryMB < 0 {
+		return fmt.Errorf("ChromeRecycle limits must not be negative")
+	}
+	if cfg.SessionPoolSize < 0 || cfg.SessionMaxUses < 0 || cfg.SessionIdleTimeout < 0 {
+		return fmt.Errorf("SessionPoolSize, SessionMaxUses and SessionIdleTimeout must not be negative")
+	}
+	if cfg.CacheTTL < 0 {
+		return fmt.Errorf("CacheTTL must not be negative")
+	}
+	if cfg.AdaptiveTTLMin < 0 || cfg.AdaptiveTTLMax < 0 {
+		return fmt.Errorf("AdaptiveTTLMin and AdaptiveTTLMax must not be negative")
+	}
+	if cfg.PrefetchInterval < 0 {
+		return fmt.Errorf("PrefetchInterval must not be negative")
+	}
+	if cfg.FetchHardTimeout < 0 {
+		return fmt.Errorf("FetchHardTimeout must not be negative")
+	}
+	if cfg.PreloadEntries < 0 {
+		return fmt.Errorf("PreloadEntries must not be negative")
+	}
+	if cfg.TrashRetention < 0 {
+		return fmt.Errorf("TrashRetention must not be negative")
+	}
+	if cfg.WriteWorkers < 0 {
+		return fmt.Errorf("WriteWorkers must not be negative")
+	}
+	if cfg.WriteWorkers > 0 && cfg.WriteQueueSize <= 0 {
+		return fmt.Errorf("WriteQueueSize must be positive")
+	}
+	if cfg.AdaptiveTTLMin > cfg.AdaptiveTTLMax {
+		return fmt.Errorf("AdaptiveTTLMin must not exceed AdaptiveTTLMax")
+	}
+	if cfg.DictTrainInterval < 0 {
+		return fmt.Errorf("DictTrainInterval must not be negative")
+	}
+	if cfg.DictTrainInterval > 0 && cfg.Codec != string(codecZstd) {
+		return fmt.Errorf("DictTrainInterval requires the zstd codec")
+	}
+	if cfg.RawDownloadMaxBytes < 0 {
+		return fmt.Errorf("RawDownloadMaxBytes must not be negative")
+	}
+	if cfg.ThumbnailWidth < 0 {
+		return fmt.Errorf("ThumbnailWidth must not be negative")
+	}
+	if cfg.BandwidthLimit < 0 || cfg.DomainBandwidthLimit < 0 {
+		return fmt.Errorf("bandwidth limits must not be negative")
+	}
+	if cfg.ArchiveURL != "" && !isHTTP(cfg.ArchiveURL) {
+		return fmt.Errorf("ArchiveURL must be an http or https URL")
+	}
+	if cfg.PauseAfterFailures < 0 {
+		return fmt.Errorf("PauseAfterFailures must not be negative")
+	}
+	if cfg.PauseAfterFailures > 0 && cfg.PauseCooldown <= 0 {
+		return fmt.Errorf("PauseCooldown must be positive")
+	}
+	if cfg.Retention != nil && cfg.RetentionInterval <= 0 {
+		return fmt.Errorf("RetentionInterval must be positive")
+	}
+	if cfg.Alerts.enabled() && cfg.Alerts.Interval <= 0 {
+		return fmt.Errorf("alert interval must be positive")
+	}
+	if err := cfg.Faults.validate(); err != nil {
+		return err
+	}
+	if cfg.AutoscaleHeadroom < 1 {
+		return fmt.Errorf("AutoscaleHeadroom must be at least 1")
+	}
+	if cfg.LoadCapacity < 0 {
+		return fmt.Errorf("LoadCapacity must not be negative")
+	}
+	for tenant, key := range cfg.TenantKeys {
+		if err := validateTenant(tenant); err != nil || tenant == "" {
+			return fmt.Errorf("invalid tenant name %q", tenant)
+		}
+		if n := len(key); n != 16 && n != 24 && n != 32 {
+			return fmt.Errorf("key for tenant %s is %d bytes (want 16, 24 or 32)", tenant, n)
+		}
+	}
+	for host, ip := range cfg.HostOverrides {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("host override for %s is not an IP address: %q", host, ip)
+		}
+	}
+	return nil
+}
+
+// envString returns the value of an environment variable, or def if unset.
+func envString(name, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
+
+// envInt parses an integer environment variable, returning def if unset.
+func envInt(name string, def int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %q", name, v)
+	}
+	return n, nil
+}
+
+// envDuration parses a duration environment variable (e.g. "30m"), returning def if unset.
+func envDuration(name string, def time.Duration) (time.Duration, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid %s: %q", name, v)
+	}
+	return d, nil
+}
+
+// envFloat parses a floating point environment variable, returning def if unset.
+func envFloat(name string, def float64) (float64, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %q", name, v)
+	}
+	return f, nil
+}
+
+// envBool parses a boolean environment variable, returning def if unset.
+func envBool(name string, def bool) (bool, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %q", name, v)
+	}
+	return b, nil
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tebeka/selenium"
+)
+
+// consentSettleTime is how long a consent banner has to close after its
+// button is clicked, before whatever is left of it is removed.
+const consentSettleTime = 500 * time.Millisecond
+
+// consentRule dismisses the cookie consent banner of one consent management
+// platform (CMP).
+type consentRule struct {
+	Name string `json:"name"`
+	// Banner matches the banner's outermost element, and any overlay
+	// dimming the page behind it, while it is shown.
+	Banner string `json:"banner"`
+	// Buttons close the banner, tried in order: where a banner offers both,
+	// rejecting comes before accepting, which is less likely to reload the
+	// page or load more scripts.
+	Buttons []string `json:"buttons"`
+}
+
+// consentRules covers the most common CMPs.  Banners served in a
+// cross-origin iframe (e.g. Sourcepoint, TrustArc) can't be clicked, and
+// are only removed.
+var consentRules = []consentRule{
+	{
+		Name:    "OneTrust",
+		Banner:  "#onetrust-consent-sdk",
+		Buttons: []string{"#onetrust-reject-all-handler", "#onetrust-accept-btn-handler"},
+	},
+	{
+		Name:    "Cookiebot",
+		Banner:  "#CybotCookiebotDialog, #CybotCookiebotDialogBodyUnderlay",
+		Buttons: []string{"#CybotCookiebotDialogBodyButtonDecline", "#CybotCookiebotDialogBodyLevelButtonLevelOptinAllowAll", "#CybotCookiebotDialogBodyButtonAccept"},
+	},
+	{
+		Name:    "Quantcast Choice",
+		Banner:  "#qc-cmp2-container",
+		Buttons: []string{"#qc-cmp2-ui button[mode=secondary]", "#qc-cmp2-ui button[mode=primary]"},
+	},
+	{
+		Name:    "Didomi",
+		Banner:  "#didomi-host",
+		Buttons: []string{"#didomi-notice-disagree-button", ".didomi-continue-without-agreeing", "#didomi-notice-agree-button"},
+	},
+	{
+		Name:    "Usercentrics",
+		Banner:  "#usercentrics-root, #uc-banner-modal",
+		Buttons: []string{"[data-testid=uc-deny-all-button]", "[data-testid=uc-accept-all-button]"},
+	},
+	{
+		Name:    "TrustArc",
+		Banner:  "#truste-consent-track, .truste_overlay, .truste_box_overlay",
+		Buttons: []string{"#truste-consent-required", "#truste-consent-button"},
+	},
+	{
+		Name:   "Sourcepoint",
+		Banner: "[id^=sp_message_container]",
+	},
+	{
+		Name:    "CookieYes",
+		Banner:  ".cky-consent-container, .cky-overlay",
+		Buttons: []string{".cky-btn-reject", ".cky-btn-accept"},
+	},
+	{
+		Name:    "Complianz",
+		Banner:  "#cmplz-cookiebanner-container",
+		Buttons: []string{".cmplz-btn.cmplz-deny", ".cmplz-btn.cmplz-accept"},
+	},
+	{
+		Name:    "Osano",
+		Banner:  ".osano-cm-window",
+		Buttons: []string{".osano-cm-denyAll", ".osano-cm-accept-all", ".osano-cm-accept"},
+	},
+	{
+		Name:    "Klaro",
+		Banner:  ".klaro .cookie-notice, .klaro .cookie-modal",
+		Buttons: []string{".klaro .cn-decline", ".klaro .cm-btn-accept-all", ".klaro .cm-btn-success"},
+	},
+	{
+		Name:    "Borlabs Cookie",
+		Banner:  "#BorlabsCookieBox",
+		Buttons: []string{"#BorlabsCookieBox a[data-cookie-refuse]", "#BorlabsCookieBox a[data-cookie-accept-all]", "#BorlabsCookieBox a[data-cookie-accept]"},
+	},
+	{
+		Name:    "iubenda",
+		Banner:  "#iubenda-cs-banner",
+		Buttons: []string{".iubenda-cs-reject-btn", ".iubenda-cs-accept-btn"},
+	},
+	{
+		Name:    "Cookie Notice",
+		Banner:  "#cookie-notice",
+		Buttons: []string{"#cn-refuse-cookie", "#cn-accept-cookie"},
+	},
+	{
+		Name:    "Google Funding Choices",
+		Banner:  ".fc-consent-root",
+		Buttons: []string{".fc-cta-do-not-consent", ".fc-cta-consent"},
+	},
+}
+
+// clickConsentScript clicks the first button found of each rule whose
+// banner is shown, returning the names of the CMPs found.
+const clickConsentScript = `
+const found = [];
+for (const rule of arguments[0]) {
+  if (!document.querySelector(rule.banner)) continue;
+  found.push(rule.name);
+  for (const selector of rule.buttons || []) {
+    const button = document.querySelector(selector);
+    if (button) { button.click(); break; }
+  }
+}
+return found;`
+
+// removeConsentScript removes what is left of the banners, and the scroll
+// lock they put on the page.
+const removeConsentScript = `
+for (const rule of arguments[0]) {
+  document.querySelectorAll(rule.banner).forEach(e => e.remove());
+}
+for (const e of [document.documentElement, document.body]) {
+  if (e && getComputedStyle(e).overflow === "hidden") e.style.overflow = "";
+}
+return null;`
+
+// dismissConsent closes the cookie consent banners of known CMPs on the
+// page, so they don't cover the content captured, returning the names of
+// the CMPs whose banners were found.
+func dismissConsent(ctx context.Context, wd selenium.WebDriver) ([]string, error) {
+	v, err := wd.ExecuteScript(clickConsentScript, []interface{}{consentRules})
+	if err != nil {
+		return nil, err
+	}
+	// ExecuteScript returns JSON-decoded values.
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var found []string
+	if err := json.Unmarshal(data, &found); err != nil || len(found) == 0 {
+		return nil, err
+	}
+	sleepContext(ctx, consentSettleTime)
+	if _, err := wd.ExecuteScript(removeConsentScript, []interface{}{consentRules}); err != nil {
+		return found, err
+	}
+	return found, nil
+}
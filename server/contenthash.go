@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"path"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// hashSubdir indexes entries by the SHA-256 of their content: one file per
+// hash, holding the cache key of the entry last stored with that content.
+// Files are only ever overwritten, so lookups check the entry still has
+// the hash.
+const hashSubdir = ".hashes"
+
+// contentHash returns the hex SHA-256 of a page's content.
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// validContentHash reports whether a string is a hex SHA-256.
+func validContentHash(hash string) bool {
+	b, err := hex.DecodeString(hash)
+	return err == nil && len(b) == sha256.Size && hex.EncodeToString(b) == hash
+}
+
+// hashIndexName returns the Storage name of the index file for a hash.
+// Tenants are indexed apart, so a hash only finds the tenant's own pages.
+func hashIndexName(tenant, hash string) string {
+	name := hash
+	if tenant != "" {
+		name += "." + tenant // Tenants can't contain '/'.
+	}
+	return path.Join(hashSubdir, hash[:2], name)
+}
+
+// indexContentHash records the entry under a cache key as holding content
+// with md.ContentHash.  Failures are logged; the entry is just not found by
+// hash.
+func (s *Server) indexContentHash(cacheKey string, md *entryMetadata) {
+	if err := writeHashIndex(s.storage, cacheKey, md); err != nil {
+		s.logger.Printf("Warning: failed to index %s by content hash: %v", md.URL, err)
+	}
+}
+
+// writeHashIndex points the index file for an entry's content hash at its
+// cache key.  Aliases and entries without a hash aren't indexed.
+func writeHashIndex(st Storage, cacheKey string, md *entryMetadata) error {
+	if md.AliasOf != "" || !validContentHash(md.ContentHash) {
+		return nil
+	}
+	return st.Write(hashIndexName(md.Tenant, md.ContentHash), []byte(cacheKey))
+}
+
+// GetByHash handles the gRPC request.
+func (s *Server) GetByHash(ctx context.Context, req *pb.GetByHashRequest) (*pb.GetByHashResponse, error) {
+	hash := req.GetContentHash()
+	if !validContentHash(hash) {
+		return nil, status.Errorf(codes.InvalidArgument, "content_hash must be a lowercase hex SHA-256")
+	}
+	ctx = s.logger.sampleRequest(ctx)
+	s.logger.Requestf(ctx, "Received request for content hash: %s", hash)
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return nil, err
+	}
+
+	notFound := status.Errorf(codes.NotFound, "no cached page has content hash %s", hash)
+	data, err := s.storage.Read(hashIndexName(req.GetTenant(), hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read hash index: %v", err)
+	}
+	cacheKey := string(data)
+	if !s.entryExists(cacheKey) {
+		return nil, notFound // The entry has been removed since.
+	}
+	unlock := s.readLockEntry(cacheKey)
+	md, err := s.readMetadata(cacheKey)
+	if err != nil || md.ContentHash != hash || md.Tenant != req.GetTenant() {
+		unlock()
+		return nil, notFound // The entry has been replaced since.
+	}
+	content, err := s.readContent(ctx, s.contentName(cacheKey), md)
+	unlock()
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read %s: %v", md.URL, err)
+	}
+	s.touch(cacheKey, md)
+	s.usage.record(ctx, req.GetTenant(), func(c *usageCounters) {
+		c.Hits++
+		c.BytesServed += int64(len(content))
+	})
+	resp := s.response(md, content)
+	resp.TotalBytes = int64(len(content))
+	return &pb.GetByHashResponse{Url: md.URL, Page: resp}, nil
+}
@@ -0,0 +1,733 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// crawlSubdir holds one JSON file per crawl, so crawls survive restarts.
+	crawlSubdir          = ".crawls"
+	defaultCrawlMaxPages = 1000
+	// crawlSaveInterval bounds how often a running crawl is saved.  Pages
+	// fetched since the last save are fetched again after a restart, which
+	// is cheap since they're cached by then.
+	crawlSaveInterval = 10 * time.Second
+	// crawlRetryDelay is how long a crawl waits while the server is in
+	// maintenance or offline mode.
+	crawlRetryDelay = 30 * time.Second
+)
+
+// Crawl states, as saved.
+const (
+	crawlRunning = "running"
+	crawlPaused  = "paused"
+	crawlDone    = "done"
+)
+
+// crawlState is a crawl as saved under crawlSubdir: its settings, progress,
+// the pages still to fetch (the frontier) and every page seen so far.
+type crawlState struct {
+	ID           string          `json:"id"`
+	URL          string          `json:"url"`
+	Host         string          `json:"host"`
+	Tenant       string          `json:"tenant,omitempty"`
+	FetchOptions []byte          `json:"fetch_options,omitempty"` // Serialized pb.FetchOptions, as requested
+	MaxPages     int             `json:"max_pages"`
+	MaxDepth     int             `json:"max_depth"` // Zero is unlimited
+	KeepDups     bool            `json:"keep_duplicates,omitempty"`
+	State        string          `json:"state"`
+	Frontier     []crawlItem     `json:"frontier"`
+	Seen         map[string]bool `json:"seen"` // Pages fetched or in the frontier
+	Results      []crawlResult   `json:"results"`
+	PagesDone    int64           `json:"pages_done"`
+	PagesFailed  int64           `json:"pages_failed"`
+	PagesDup     int64           `json:"pages_duplicate,omitempty"`
+	LastError    string          `json:"last_error,omitempty"`
+	StartedAt    time.Time       `json:"started_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// crawlItem is a page in a crawl's frontier.
+type crawlItem struct {
+	URL   string `json:"url"`
+	Depth int    `json:"depth"` // Links away from the crawl's URL
+}
+
+// crawlResult is the outcome of fetching a page in a crawl.
+type crawlResult struct {
+	URL         string        `json:"url"`
+	Depth       int           `json:"depth,omitempty"`
+	Status      int           `json:"status,omitempty"` // HTTP status of the page, if known
+	Code        codes.Code    `json:"code,omitempty"`   // The gRPC status of a failed fetch
+	Retryable   bool          `json:"retryable,omitempty"`
+	Error       string        `json:"error,omitempty"`
+	Duration    time.Duration `json:"duration,omitempty"`
+	Simhash     uint64        `json:"simhash,omitempty"`      // Fingerprint of the page's text; see simhash
+	DuplicateOf string        `json:"duplicate_of,omitempty"` // An earlier page of the crawl with nearly the same text
+}
+
+// retryableCode reports whether a fetch that failed with code is likely to
+// succeed if tried again later.
+func retryableCode(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// itemResult reports the result as a pb.ItemResult.
+func (r *crawlResult) itemResult() *pb.ItemResult {
+	return &pb.ItemResult{
+		Url:       r.URL,
+		Code:      int32(r.Code),
+		Retryable: r.Retryable,
+		Message:   r.Error,
+		Duration:  durationpb.New(r.Duration),
+	}
+}
+
+// crawlJob is a crawl known to the server.
+type crawlJob struct {
+	mu      sync.Mutex
+	state   crawlState
+	stop    context.CancelFunc // Stops the crawl's goroutine, if it is running
+	stopped chan struct{}      // Closed when the goroutine exits
+	saved   time.Time
+}
+
+// crawlRegistry holds the server's crawls.  Its mu is taken before a job's,
+// never while holding one.
+type crawlRegistry struct {
+	mu   sync.Mutex
+	ctx  context.Context // Crawls run until this is done; see Server.Start
+	jobs map[string]*crawlJob
+}
+
+func crawlFileName(id string) string {
+	return path.Join(crawlSubdir, id+".json")
+}
+
+// pageLinks returns the absolute URLs of the pages a page links to, without
+// fragments or duplicates.  URLs resolve against pageURL or the page's
+// <base href>.
+func pageLinks(content []byte, pageURL string) []string {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return nil
+	}
+	var links []string
+	seen := make(map[string]bool)
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			return links
+		}
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			continue
+		}
+		t := z.Token()
+		if t.DataAtom != atom.A && t.DataAtom != atom.Area && t.DataAtom != atom.Base {
+			continue
+		}
+		for _, a := range t.Attr {
+			if a.Key != "href" {
+				continue
+			}
+			u, err := base.Parse(strings.TrimSpace(a.Val))
+			if err != nil {
+				continue
+			}
+			if t.DataAtom == atom.Base {
+				base = u
+				continue
+			}
+			u.Fragment = ""
+			if s := u.String(); isHTTP(s) && !seen[s] {
+				seen[s] = true
+				links = append(links, s)
+			}
+		}
+	}
+}
+
+// next returns the next page to fetch, or false once the crawl has stopped
+// running.  A crawl with nothing left to fetch is done.
+func (j *crawlJob) next() (crawlItem, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	st := &j.state
+	if st.State != crawlRunning {
+		return crawlItem{}, false
+	}
+	if len(st.Frontier) == 0 || st.PagesDone+st.PagesFailed >= int64(st.MaxPages) {
+		st.State = crawlDone
+		st.UpdatedAt = time.Now()
+		return crawlItem{}, false
+	}
+	return st.Frontier[0], true
+}
+
+// duplicateOf returns the earliest page the crawl has done, other than
+// rawURL, that is a near-duplicate of a page with fingerprint fp, or "".
+func (j *crawlJob) duplicateOf(rawURL string, fp uint64) string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.duplicateOfLocked(rawURL, fp)
+}
+
+func (j *crawlJob) duplicateOfLocked(rawURL string, fp uint64) string {
+	if fp == 0 {
+		return ""
+	}
+	for _, r := range j.state.Results {
+		if r.DuplicateOf == "" && r.URL != rawURL && nearDuplicate(r.Simhash, fp) {
+			return r.URL
+		}
+	}
+	return ""
+}
+
+// record takes a page fetched in elapsed off the frontier, queueing the
+// pages it links to on the crawl's hostname.
+func (j *crawlJob) record(item crawlItem, resp *pb.DownloadCacheResponse, err error, elapsed time.Duration) {
+	var fp uint64
+	if err == nil {
+		fp = pageFingerprint(resp.GetContentType(), contents(resp))
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	st := &j.state
+	st.Frontier = st.Frontier[1:]
+	st.UpdatedAt = time.Now()
+	if err != nil {
+		st.PagesFailed++
+		st.LastError = item.URL + ": " + status.Convert(err).Message()
+		st.Results = append(st.Results, crawlResult{
+			URL:       item.URL,
+			Depth:     item.Depth,
+			Code:      status.Code(err),
+			Retryable: retryableCode(status.Code(err)),
+			Error:     status.Convert(err).Message(),
+			Duration:  elapsed,
+		})
+		return
+	}
+	st.PagesDone++
+	result := crawlResult{URL: item.URL, Depth: item.Depth, Duration: elapsed, Simhash: fp, DuplicateOf: j.duplicateOfLocked(item.URL, fp)}
+	if chain := resp.GetRedirectChain(); len(chain) > 0 {
+		result.Status = int(chain[len(chain)-1].GetStatusCode())
+	}
+	if result.DuplicateOf != "" {
+		st.PagesDup++
+	}
+	st.Results = append(st.Results, result)
+	if !isHTML(resp.GetContentType()) || (st.MaxDepth > 0 && item.Depth >= st.MaxDepth) {
+		return
+	}
+	pageURL := item.URL
+	if chain := resp.GetRedirectChain(); len(chain) > 0 {
+		pageURL = chain[len(chain)-1].GetUrl()
+	}
+	for _, link := range pageLinks(contents(resp), pageURL) {
+		if hostOf(link) == st.Host && !st.Seen[link] {
+			st.Seen[link] = true
+			st.Frontier = append(st.Frontier, crawlItem{URL: link, Depth: item.Depth + 1})
+		}
+	}
+}
+
+// status reports the crawl's progress.
+func (j *crawlJob) status() *pb.CrawlStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	st := &j.state
+	state := pb.CrawlState_CRAWL_STATE_UNSPECIFIED
+	switch st.State {
+	case crawlRunning:
+		state = pb.CrawlState_CRAWL_STATE_RUNNING
+	case crawlPaused:
+		state = pb.CrawlState_CRAWL_STATE_PAUSED
+	case crawlDone:
+		state = pb.CrawlState_CRAWL_STATE_DONE
+	}
+	return &pb.CrawlStatus{
+		CrawlId:        st.ID,
+		Url:            st.URL,
+		Tenant:         st.Tenant,
+		State:          state,
+		PagesDone:      st.PagesDone,
+		PagesQueued:    int64(len(st.Frontier)),
+		PagesFailed:    st.PagesFailed,
+		PagesDuplicate: st.PagesDup,
+		StartedAt:      timestamppb.New(st.StartedAt),
+		UpdatedAt:      timestamppb.New(st.UpdatedAt),
+		LastError:      st.LastError,
+	}
+}
+
+// saveCrawl writes a crawl to storage, unless it was saved within
+// crawlSaveInterval and force is false.
+func (s *Server) saveCrawl(job *crawlJob, force bool) error {
+	job.mu.Lock()
+	if !force && time.Since(job.saved) < crawlSaveInterval {
+		job.mu.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(&job.state)
+	id := job.state.ID
+	job.saved = time.Now()
+	job.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return err
+	}
+	defer done()
+	return s.storage.Write(crawlFileName(id), data)
+}
+
+// loadCrawls reads the saved crawls.
+func (s *Server) loadCrawls() error {
+	return s.storage.Walk(crawlSubdir, func(name string, info FileInfo) error {
+		if info.IsDir || !strings.HasSuffix(name, ".json") {
+			return nil
+		}
+		data, err := s.storage.Read(name)
+		if err != nil {
+			return err
+		}
+		job := &crawlJob{}
+		if err := json.Unmarshal(data, &job.state); err != nil {
+			s.logger.Printf("Warning: skipping corrupt crawl %s: %v", name, err)
+			return nil
+		}
+		if job.state.Seen == nil {
+			job.state.Seen = make(map[string]bool)
+		}
+		s.crawls.mu.Lock()
+		if s.crawls.jobs == nil {
+			s.crawls.jobs = make(map[string]*crawlJob)
+		}
+		if _, ok := s.crawls.jobs[job.state.ID]; !ok { // Crawls started since are already running
+			s.crawls.jobs[job.state.ID] = job
+		}
+		s.crawls.mu.Unlock()
+		return nil
+	})
+}
+
+// resumeCrawls loads the saved crawls and carries on with the ones that
+// were running.
+func (s *Server) resumeCrawls(ctx context.Context) {
+	s.crawls.mu.Lock()
+	s.crawls.ctx = ctx
+	s.crawls.mu.Unlock()
+	if err := s.loadCrawls(); err != nil {
+		s.logger.Printf("Error: failed to load crawls: %v", err)
+		return
+	}
+	s.crawls.mu.Lock()
+	jobs := make([]*crawlJob, 0, len(s.crawls.jobs))
+	for _, job := range s.crawls.jobs {
+		jobs = append(jobs, job)
+	}
+	s.crawls.mu.Unlock()
+	for _, job := range jobs {
+		job.mu.Lock()
+		if job.state.State == crawlRunning && job.stopped == nil {
+			s.logger.Printf("Resuming crawl %s of %s with %d pages queued", job.state.ID, job.state.URL, len(job.state.Frontier))
+			s.startCrawlJob(ctx, job)
+		}
+		job.mu.Unlock()
+	}
+}
+
+// startCrawlJob runs a crawl in the background.  The caller holds job.mu.
+func (s *Server) startCrawlJob(ctx context.Context, job *crawlJob) {
+	ctx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	job.stop, job.stopped = cancel, stopped
+	go func() {
+		defer close(stopped)
+		defer cancel()
+		s.crawl(ctx, job)
+	}()
+}
+
+// crawl fetches a crawl's pages one at a time until it is done, paused or
+// ctx is done.
+func (s *Server) crawl(ctx context.Context, job *crawlJob) {
+	job.mu.Lock()
+	req := &pb.DownloadCacheRequest{Tenant: job.state.Tenant, FetchOptions: &pb.FetchOptions{}}
+	if err := proto.Unmarshal(job.state.FetchOptions, req.FetchOptions); err != nil {
+		s.logger.Printf("Warning: ignoring corrupt fetch options of crawl %s: %v", job.state.ID, err)
+	}
+	id := job.state.ID
+	fetchCtx := ctx
+	if !job.state.KeepDups {
+		fetchCtx = withDedup(ctx, job)
+	}
+	job.mu.Unlock()
+
+	for ctx.Err() == nil {
+		item, ok := job.next()
+		if !ok {
+			break
+		}
+		// Wait out offline mode, maintenance and pauses of the domain rather
+		// than failing every page.
+		if wait := s.crawlWait(item.URL); wait > 0 {
+			sleepContext(ctx, wait)
+			continue
+		}
+		done, err := s.maintenance.enter()
+		if err != nil {
+			sleepContext(ctx, crawlRetryDelay)
+			continue
+		}
+		pageReq := proto.Clone(req).(*pb.DownloadCacheRequest)
+		pageReq.Url = item.URL
+		start := time.Now()
+		resp, err := s.Get(fetchCtx, pageReq)
+		done()
+		if ctx.Err() != nil {
+			break // Paused or shutting down; the page stays queued.
+		}
+		job.record(item, resp, err, time.Since(start))
+		if err := s.saveCrawl(job, false); err != nil {
+			s.logger.Printf("Warning: failed to save crawl %s: %v", id, err)
+		}
+	}
+
+	if err := s.saveCrawl(job, true); err != nil {
+		s.logger.Printf("Error: failed to save crawl %s: %v", id, err)
+	}
+	if st := job.status(); st.GetState() == pb.CrawlState_CRAWL_STATE_DONE {
+		s.logger.Printf("Crawl %s of %s finished: %d pages done, %d failed", id, st.GetUrl(), st.GetPagesDone(), st.GetPagesFailed())
+	}
+}
+
+// crawlWait returns how long a crawl should wait before fetching rawURL,
+// because fetches would fail: the server is offline or the domain is paused.
+func (s *Server) crawlWait(rawURL string) time.Duration {
+	if s.offline.Load() {
+		return crawlRetryDelay
+	}
+	if until := s.pauses.pausedUntil(hostOf(rawURL)); !until.IsZero() {
+		return time.Until(until)
+	}
+	return 0
+}
+
+// sleepContext waits for d, or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
+
+// crawlJob returns the crawl with an ID.
+func (s *Server) crawlJob(id string) (*crawlJob, error) {
+	s.crawls.mu.Lock()
+	defer s.crawls.mu.Unlock()
+	job, ok := s.crawls.jobs[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no crawl %q", id)
+	}
+	return job, nil
+}
+
+// crawlContext returns the context crawls run under.
+func (s *Server) crawlContext() context.Context {
+	s.crawls.mu.Lock()
+	defer s.crawls.mu.Unlock()
+	if s.crawls.ctx == nil {
+		return context.Background()
+	}
+	return s.crawls.ctx
+}
+
+// StartCrawl handles the gRPC request.
+func (s *Server) StartCrawl(ctx context.Context, req *pb.StartCrawlRequest) (*pb.CrawlStatus, error) {
+	if !isHTTP(req.GetUrl()) {
+		return nil, status.Errorf(codes.InvalidArgument, "URL must be http or https")
+	}
+	if req.GetMaxPages() < 0 || req.GetMaxDepth() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_pages and max_depth must not be negative")
+	}
+	fetchOpts, _ := s.mergeOptions(&pb.DownloadCacheRequest{Url: req.GetUrl(), FetchOptions: req.GetFetchOptions()})
+	if err := s.validateFetchOptions(fetchOpts); err != nil {
+		return nil, err
+	}
+	if err := s.browser.validateBrowserOptions(req.GetFetchOptions()); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return nil, err
+	}
+	opts, err := proto.Marshal(req.GetFetchOptions())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid fetch options: %v", err)
+	}
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate crawl ID: %v", err)
+	}
+
+	now := time.Now()
+	job := &crawlJob{state: crawlState{
+		ID:           hex.EncodeToString(idBytes),
+		URL:          req.GetUrl(),
+		Host:         hostOf(req.GetUrl()),
+		Tenant:       req.GetTenant(),
+		FetchOptions: opts,
+		MaxPages:     int(req.GetMaxPages()),
+		MaxDepth:     int(req.GetMaxDepth()),
+		KeepDups:     req.GetKeepDuplicates(),
+		State:        crawlRunning,
+		Frontier:     []crawlItem{{URL: req.GetUrl()}},
+		Seen:         map[string]bool{req.GetUrl(): true},
+		StartedAt:    now,
+		UpdatedAt:    now,
+	}}
+	if job.state.MaxPages == 0 {
+		job.state.MaxPages = defaultCrawlMaxPages
+	}
+	if err := s.saveCrawl(job, true); err != nil {
+		if status.Code(err) == codes.Unavailable {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to save crawl: %v", err)
+	}
+
+	// The crawl is running by the time it can be found, so resumeCrawls
+	// never starts it again.  If resumeCrawls loaded it from its file in
+	// the meantime, that copy is the one that runs.
+	crawlCtx := s.crawlContext()
+	s.crawls.mu.Lock()
+	if s.crawls.jobs == nil {
+		s.crawls.jobs = make(map[string]*crawlJob)
+	}
+	if loaded, ok := s.crawls.jobs[job.state.ID]; ok {
+		job = loaded
+	} else {
+		job.mu.Lock()
+		s.startCrawlJob(crawlCtx, job)
+		job.mu.Unlock()
+		s.crawls.jobs[job.state.ID] = job
+	}
+	s.crawls.mu.Unlock()
+	s.logger.Printf("Started crawl %s of %s", job.state.ID, req.GetUrl())
+	return job.status(), nil
+}
+
+// PauseCrawl handles the gRPC request.
+func (s *Server) PauseCrawl(ctx context.Context, req *pb.PauseCrawlRequest) (*pb.CrawlStatus, error) {
+	job, err := s.crawlJob(req.GetCrawlId())
+	if err != nil {
+		return nil, err
+	}
+	job.mu.Lock()
+	switch job.state.State {
+	case crawlDone:
+		job.mu.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "crawl %s is done", req.GetCrawlId())
+	case crawlRunning:
+		job.state.State = crawlPaused
+		job.state.UpdatedAt = time.Now()
+		if job.stop != nil {
+			job.stop()
+		}
+		s.logger.Printf("Paused crawl %s of %s", job.state.ID, job.state.URL)
+	}
+	stopped := job.stopped
+	job.mu.Unlock()
+
+	// The crawl saves itself as it stops.
+	if stopped != nil {
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	} else if err := s.saveCrawl(job, true); err != nil {
+		s.logger.Printf("Warning: failed to save crawl %s: %v", req.GetCrawlId(), err)
+	}
+	return job.status(), nil
+}
+
+// ResumeCrawl handles the gRPC request.
+func (s *Server) ResumeCrawl(ctx context.Context, req *pb.ResumeCrawlRequest) (*pb.CrawlStatus, error) {
+	job, err := s.crawlJob(req.GetCrawlId())
+	if err != nil {
+		return nil, err
+	}
+	job.mu.Lock()
+	state, stopped := job.state.State, job.stopped
+	job.mu.Unlock()
+	switch state {
+	case crawlDone:
+		return nil, status.Errorf(codes.FailedPrecondition, "crawl %s is done", req.GetCrawlId())
+	case crawlRunning:
+		return job.status(), nil
+	}
+
+	// Let a crawl that was just paused finish stopping first.
+	if stopped != nil {
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	crawlCtx := s.crawlContext()
+	job.mu.Lock()
+	if job.state.State == crawlPaused {
+		job.state.State = crawlRunning
+		job.state.UpdatedAt = time.Now()
+		s.startCrawlJob(crawlCtx, job)
+		s.logger.Printf("Resumed crawl %s of %s", job.state.ID, job.state.URL)
+	}
+	job.mu.Unlock()
+	return job.status(), nil
+}
+
+// ListCrawls handles the gRPC request.
+func (s *Server) ListCrawls(ctx context.Context, req *pb.ListCrawlsRequest) (*pb.ListCrawlsResponse, error) {
+	resp := &pb.ListCrawlsResponse{}
+	if req.GetCrawlId() != "" {
+		job, err := s.crawlJob(req.GetCrawlId())
+		if err != nil {
+			return nil, err
+		}
+		resp.Crawls = append(resp.Crawls, job.status())
+		return resp, nil
+	}
+
+	s.crawls.mu.Lock()
+	jobs := make([]*crawlJob, 0, len(s.crawls.jobs))
+	for _, job := range s.crawls.jobs {
+		jobs = append(jobs, job)
+	}
+	s.crawls.mu.Unlock()
+	for _, job := range jobs {
+		resp.Crawls = append(resp.Crawls, job.status())
+	}
+	sort.Slice(resp.Crawls, func(i, j int) bool {
+		return resp.Crawls[i].GetStartedAt().AsTime().After(resp.Crawls[j].GetStartedAt().AsTime())
+	})
+	return resp, nil
+}
+
+// ListCrawlResults handles the gRPC request.
+func (s *Server) ListCrawlResults(ctx context.Context, req *pb.ListCrawlResultsRequest) (*pb.ListCrawlResultsResponse, error) {
+	if runIDPattern.MatchString(req.GetCrawlId()) {
+		return s.listRunFailures(ctx, req.GetCrawlId())
+	}
+	job, err := s.crawlJob(req.GetCrawlId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListCrawlResultsResponse{}
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	for i := range job.state.Results {
+		if r := &job.state.Results[i]; r.Error != "" || !req.GetFailedOnly() {
+			resp.Results = append(resp.Results, r.itemResult())
+		}
+	}
+	return resp, nil
+}
+
+// requeueFailed puts the crawl's failed pages, or only the retryable ones,
+// back at the front of the frontier, returning how many there were.  The
+// caller holds j.mu.
+func (j *crawlJob) requeueFailed(retryableOnly bool) int {
+	st := &j.state
+	var retry []crawlItem
+	kept := st.Results[:0]
+	for _, r := range st.Results {
+		if r.Error == "" || (retryableOnly && !r.Retryable) {
+			kept = append(kept, r)
+			continue
+		}
+		retry = append(retry, crawlItem{URL: r.URL, Depth: r.Depth})
+	}
+	if len(retry) == 0 {
+		return 0
+	}
+	st.Results = kept
+	st.PagesFailed -= int64(len(retry))
+	st.Frontier = append(retry, st.Frontier...)
+	st.UpdatedAt = time.Now()
+	return len(retry)
+}
+
+// RetryFailed handles the gRPC request.
+func (s *Server) RetryFailed(ctx context.Context, req *pb.RetryFailedRequest) (*pb.CrawlStatus, error) {
+	if runIDPattern.MatchString(req.GetCrawlId()) {
+		return s.retryRun(ctx, req.GetCrawlId(), req.GetRetryableOnly())
+	}
+	job, err := s.crawlJob(req.GetCrawlId())
+	if err != nil {
+		return nil, err
+	}
+	job.mu.Lock()
+	retried := job.requeueFailed(req.GetRetryableOnly())
+	state, stopped := job.state.State, job.stopped
+	job.mu.Unlock()
+	if retried == 0 {
+		return job.status(), nil
+	}
+	s.logger.Printf("Retrying %d failed pages of crawl %s", retried, req.GetCrawlId())
+
+	// A paused crawl retries the pages when it is resumed; a running one
+	// gets to them next.
+	if state == crawlDone {
+		if stopped != nil {
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+		}
+		crawlCtx := s.crawlContext()
+		job.mu.Lock()
+		if job.state.State == crawlDone {
+			job.state.State = crawlRunning
+			s.startCrawlJob(crawlCtx, job)
+		}
+		job.mu.Unlock()
+	}
+	if err := s.saveCrawl(job, true); err != nil {
+		s.logger.Printf("Warning: failed to save crawl %s: %v", req.GetCrawlId(), err)
+	}
+	return job.status(), nil
+}
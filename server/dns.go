@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const dnsTimeout = 5 * time.Second
+
+// hostResolver resolves hostnames for fetches with fixed overrides and an
+// optional DNS server, for staging environments and split-horizon DNS.
+// Plain HTTP fetches dial through it; Chrome is given --host-resolver-rules.
+type hostResolver struct {
+	overrides map[string]string // Hostname to IP address
+	resolver  *net.Resolver     // nil uses the system resolver
+}
+
+// newHostResolver returns nil if there is nothing to override.  dnsServer is
+// a host:port, or a host to use port 53.
+func newHostResolver(overrides map[string]string, dnsServer string) *hostResolver {
+	if len(overrides) == 0 && dnsServer == "" {
+		return nil
+	}
+	r := &hostResolver{overrides: make(map[string]string, len(overrides))}
+	for host, ip := range overrides {
+		r.overrides[strings.ToLower(host)] = ip
+	}
+	if dnsServer != "" {
+		if _, _, err := net.SplitHostPort(dnsServer); err != nil {
+			dnsServer = net.JoinHostPort(dnsServer, "53")
+		}
+		r.resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				d := net.Dialer{Timeout: dnsTimeout}
+				return d.DialContext(ctx, network, dnsServer)
+			},
+		}
+	}
+	return r
+}
+
+// parseHostOverrides parses "host=ip,host=ip".
+func parseHostOverrides(s string) (map[string]string, error) {
+	overrides := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		host, ip, ok := strings.Cut(pair, "=")
+		if !ok || host == "" || net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("invalid host override %q (want host=ip)", pair)
+		}
+		overrides[strings.ToLower(host)] = ip
+	}
+	return overrides, nil
+}
+
+// lookup returns the address to connect to for a host, or "" to leave it to
+// the system resolver.
+func (r *hostResolver) lookup(ctx context.Context, host string) (string, error) {
+	if ip, ok := r.overrides[strings.ToLower(host)]; ok {
+		return ip, nil
+	}
+	if r.resolver == nil || net.ParseIP(host) != nil {
+		return "", nil
+	}
+	addrs, err := r.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return "", err
+	}
+	return addrs[0], nil
+}
+
+// dialContextWith returns an http.Transport DialContext that resolves
+// through r and dials with d.
+func (r *hostResolver) dialContextWith(d *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		ip, err := r.lookup(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		if ip != "" {
+			addr = net.JoinHostPort(ip, port)
+		}
+		return d.DialContext(ctx, network, addr)
+	}
+}
+
+// transport returns an HTTP transport that resolves through r.
+func (r *hostResolver) transport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.DialContext = r.dialContextWith(&net.Dialer{})
+	return t
+}
+
+// chromeArg returns a --host-resolver-rules switch applying the overrides,
+// plus the page's own host resolved with the DNS server.  Chrome has no
+// switch for using a DNS server, so other hosts the page loads from are
+// resolved by the browser's usual resolver.
+func (r *hostResolver) chromeArg(ctx context.Context, rawURL string) (string, error) {
+	rules := make(map[string]string, len(r.overrides)+1)
+	for host, ip := range r.overrides {
+		rules[host] = ip
+	}
+	if host := hostOf(rawURL); host != "" {
+		ip, err := r.lookup(ctx, host)
+		if err != nil {
+			return "", err
+		}
+		if ip != "" {
+			rules[strings.ToLower(host)] = ip
+		}
+	}
+	if len(rules) == 0 {
+		return "", nil
+	}
+	hosts := make([]string, 0, len(rules))
+	for host := range rules {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	maps := make([]string, len(hosts))
+	for i, host := range hosts {
+		ip := rules[host]
+		if strings.Contains(ip, ":") {
+			ip = "[" + ip + "]"
+		}
+		maps[i] = "MAP " + host + " " + ip
+	}
+	return "--host-resolver-rules=" + strings.Join(maps, ", "), nil
+}
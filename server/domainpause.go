@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultPauseCooldown = 5 * time.Minute
+
+// domainPauser pauses fetches from a domain after it fails too many times in
+// a row, so a broken or blocking site doesn't tie up the browsers.  Once the
+// cooldown is over, fetches are let through again; the next failure pauses
+// the domain again and a success forgets its failures.
+type domainPauser struct {
+	threshold int // Failures in a row that pause a domain; zero never pauses
+	cooldown  time.Duration
+
+	mu      sync.Mutex
+	domains map[string]*domainFailures
+}
+
+// domainFailures is the recent fetch history of one hostname.
+type domainFailures struct {
+	consecutive int
+	lastError   string
+	pausedUntil time.Time
+}
+
+// pausedUntil returns when fetches from host resume, or the zero time if they
+// aren't paused.
+func (p *domainPauser) pausedUntil(host string) time.Time {
+	if p.threshold <= 0 {
+		return time.Time{}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if f, ok := p.domains[host]; ok && time.Now().Before(f.pausedUntil) {
+		return f.pausedUntil
+	}
+	return time.Time{}
+}
+
+// record notes the outcome of a fetch from host, returning true if it paused
+// the domain.
+func (p *domainPauser) record(host string, err error) bool {
+	if p.threshold <= 0 {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err == nil {
+		delete(p.domains, host)
+		return false
+	}
+	if p.domains == nil {
+		p.domains = make(map[string]*domainFailures)
+	}
+	f, ok := p.domains[host]
+	if !ok {
+		f = &domainFailures{}
+		p.domains[host] = f
+	}
+	f.consecutive++
+	f.lastError = err.Error()
+	if f.consecutive < p.threshold {
+		return false
+	}
+	f.pausedUntil = time.Now().Add(p.cooldown)
+	return true
+}
+
+// checkPaused returns an Unavailable error, with a RetryInfo detail for when
+// fetches resume, if fetches from rawURL's domain are paused.
+func (s *Server) checkPaused(rawURL string) error {
+	host := hostOf(rawURL)
+	until := s.pauses.pausedUntil(host)
+	if until.IsZero() {
+		return nil
+	}
+	msg := "fetches from " + host + " are paused after repeated failures"
+	st, err := status.New(codes.Unavailable, msg).
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Until(until))})
+	if err != nil {
+		return status.Error(codes.Unavailable, msg)
+	}
+	return st.Err()
+}
+
+// recordFetch feeds the outcome of a fetch to the domain pauser.  Fetches
+// the client canceled say nothing about the domain.
+func (s *Server) recordFetch(rawURL string, err error) {
+	if status.Code(err) == codes.Canceled {
+		return
+	}
+	if s.pauses.record(hostOf(rawURL), err) {
+		s.logger.Printf("Warning: pausing fetches from %s for %v after %d failures in a row: %v", hostOf(rawURL), s.pauses.cooldown, s.pauses.threshold, err)
+	}
+}
+
+// ListPausedDomains handles the gRPC request.
+func (s *Server) ListPausedDomains(ctx context.Context, req *pb.ListPausedDomainsRequest) (*pb.ListPausedDomainsResponse, error) {
+	now := time.Now()
+	resp := &pb.ListPausedDomainsResponse{}
+	s.pauses.mu.Lock()
+	for host, f := range s.pauses.domains {
+		if now.Before(f.pausedUntil) {
+			resp.Domains = append(resp.Domains, &pb.PausedDomain{
+				Host:                host,
+				ConsecutiveFailures: int32(f.consecutive),
+				PausedUntil:         timestamppb.New(f.pausedUntil),
+				LastError:           f.lastError,
+			})
+		}
+	}
+	s.pauses.mu.Unlock()
+	sort.Slice(resp.Domains, func(i, j int) bool {
+		return resp.Domains[i].GetPausedUntil().AsTime().Before(resp.Domains[j].GetPausedUntil().AsTime())
+	})
+	return resp, nil
+}
+
+// ResumeDomain handles the gRPC request.
+func (s *Server) ResumeDomain(ctx context.Context, req *pb.ResumeDomainRequest) (*pb.ResumeDomainResponse, error) {
+	host := strings.ToLower(req.GetHost())
+	if host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "host cannot be empty")
+	}
+	was := !s.pauses.pausedUntil(host).IsZero()
+	s.pauses.mu.Lock()
+	delete(s.pauses.domains, host)
+	s.pauses.mu.Unlock()
+	if was {
+		s.logger.Printf("Resumed fetches from %s", host)
+	}
+	return &pb.ResumeDomainResponse{WasPaused: was}, nil
+}
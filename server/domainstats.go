@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDomainStats handles the gRPC request.
+func (s *Server) GetDomainStats(ctx context.Context, req *pb.GetDomainStatsRequest) (*pb.GetDomainStatsResponse, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	domains := make(map[string]*pb.DomainStats)
+	get := func(host string) *pb.DomainStats {
+		d, ok := domains[host]
+		if !ok {
+			d = &pb.DomainStats{Host: host}
+			domains[host] = d
+		}
+		return d
+	}
+
+	// Storage usage comes from the entries themselves.
+	err := s.storage.Walk(metadataSubdir, func(name string, info FileInfo) error {
+		if info.IsDir {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		md, err := readMetadataFile(s.storage, name)
+		if err != nil || md.AliasOf != "" {
+			return nil
+		}
+		host := hostOf(md.URL)
+		if req.GetHost() != "" && host != req.GetHost() {
+			return nil
+		}
+		stats := get(host)
+		stats.Entries++
+		if md.hasLocalContent() {
+			cacheKey := strings.TrimSuffix(path.Base(name), ".json")
+			if info, err := s.storage.Stat(s.contentName(cacheKey)); err == nil {
+				stats.Bytes += info.Size
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Printf("Error: failed to compute domain stats: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to compute domain stats: %v", err)
+	}
+
+	// Traffic comes from the in-memory counters.
+	for host, c := range s.stats.domainSnapshot() {
+		if req.GetHost() != "" && host != req.GetHost() {
+			continue
+		}
+		stats := get(host)
+		stats.Hits, stats.Misses, stats.Errors = c.hits, c.misses, c.errors
+		if c.misses > 0 {
+			stats.AverageFetchSeconds = c.fetchTime.Seconds() / float64(c.misses)
+		}
+		if downloads := c.misses + c.errors; downloads > 0 {
+			stats.FailureRate = float64(c.errors) / float64(downloads)
+		}
+	}
+
+	resp := &pb.GetDomainStatsResponse{Domains: make([]*pb.DomainStats, 0, len(domains))}
+	for _, d := range domains {
+		resp.Domains = append(resp.Domains, d)
+	}
+	sort.Slice(resp.Domains, func(i, j int) bool {
+		a, b := resp.Domains[i], resp.Domains[j]
+		if a.Bytes != b.Bytes {
+			return a.Bytes > b.Bytes
+		}
+		return a.Host < b.Host
+	})
+	if limit := int(req.GetLimit()); limit > 0 && len(resp.Domains) > limit {
+		resp.Domains = resp.Domains[:limit]
+	}
+	return resp, nil
+}
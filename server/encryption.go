@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTenantLen bounds tenant names, which become part of cache keys.
+const maxTenantLen = 64
+
+// ErrUnknownTenant is returned by a KeyProvider for a tenant it has no key for.
+var ErrUnknownTenant = errors.New("unknown tenant")
+
+// A KeyProvider supplies the keys tenants' cache entries are encrypted with,
+// e.g. from a KMS.  Keys are fetched once per tenant and kept for the life of
+// the server.
+type KeyProvider interface {
+	// TenantKey returns a 16, 24 or 32 byte AES key for a tenant, or an error
+	// satisfying errors.Is(err, ErrUnknownTenant) if it has none.
+	TenantKey(ctx context.Context, tenant string) ([]byte, error)
+}
+
+// staticKeys is a KeyProvider for keys given in the configuration.
+type staticKeys map[string][]byte
+
+func (k staticKeys) TenantKey(_ context.Context, tenant string) ([]byte, error) {
+	key, ok := k[tenant]
+	if !ok {
+		return nil, ErrUnknownTenant
+	}
+	return key, nil
+}
+
+// parseTenantKeys parses "tenant=base64key,tenant=base64key".
+func parseTenantKeys(s string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		tenant, encoded, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid tenant key %q (want tenant=base64key)", pair)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key for tenant %s: %w", tenant, err)
+		}
+		keys[tenant] = key
+	}
+	return keys, nil
+}
+
+// validateTenant checks a tenant name, which must be 1-64 letters, digits,
+// '.', '_' or '-'.
+func validateTenant(tenant string) error {
+	if len(tenant) > maxTenantLen {
+		return fmt.Errorf("tenant %q is longer than %d characters", tenant, maxTenantLen)
+	}
+	for _, r := range tenant {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-') {
+			return fmt.Errorf("tenant %q may only contain letters, digits, '.', '_' and '-'", tenant)
+		}
+	}
+	return nil
+}
+
+// tenantCipher returns the cipher for a tenant's entries, fetching its key
+// the first time.
+func (s *Server) tenantCipher(ctx context.Context, tenant string) (cipher.AEAD, error) {
+	if c, ok := s.tenantCiphers.Load(tenant); ok {
+		return c.(cipher.AEAD), nil
+	}
+	if s.keys == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "tenant encryption is not configured")
+	}
+	key, err := s.keys.TenantKey(ctx, tenant)
+	if errors.Is(err, ErrUnknownTenant) {
+		return nil, status.Errorf(codes.PermissionDenied, "no encryption key for tenant %q", tenant)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get the encryption key for tenant %q: %v", tenant, err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid encryption key for tenant %q: %v", tenant, err)
+	}
+	c, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid encryption key for tenant %q: %v", tenant, err)
+	}
+	s.tenantCiphers.Store(tenant, c)
+	return c, nil
+}
+
+// sealContent encrypts stored content for a tenant, as the nonce followed by
+// the ciphertext.  The tenant name is authenticated too, so one tenant's
+// entry can't be passed off as another's.
+func sealContent(c cipher.AEAD, tenant string, data []byte) ([]byte, error) {
+	nonce := make([]byte, c.NonceSize(), c.NonceSize()+len(data)+c.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return c.Seal(nonce, nonce, data, []byte(tenant)), nil
+}
+
+// openContent decrypts content written by sealContent.
+func openContent(c cipher.AEAD, tenant string, data []byte) ([]byte, error) {
+	if len(data) < c.NonceSize() {
+		return nil, errors.New("encrypted content is truncated")
+	}
+	nonce, ciphertext := data[:c.NonceSize()], data[c.NonceSize():]
+	return c.Open(nil, nonce, ciphertext, []byte(tenant))
+}
+
+// checkTenant checks that a request's tenant, if any, is valid and has a key,
+// so a request fails before fetching anything it couldn't store.
+func (s *Server) checkTenant(ctx context.Context, tenant string) error {
+	if tenant == "" {
+		return nil
+	}
+	if err := validateTenant(tenant); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	_, err := s.tenantCipher(ctx, tenant)
+	return err
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// partialSuffix marks files that are still being written.  PathEscape never
+// produces a '%' that isn't followed by two hex digits, and hashed keys are
+// hex, so no cache key can end with this.
+const partialSuffix = "%partial"
+
+// writeFileAtomic writes data to path via a temporary file and a rename, so
+// readers never see a partially written file.  With fsync, the file and its
+// directory are flushed to disk before it returns, so the write survives a
+// crash.
+func writeFileAtomic(path string, data []byte, fsync bool) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmpPath := path + partialSuffix
+	if err := writeFile(tmpPath, data, fsync); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	if !fsync {
+		return nil
+	}
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// writeFile writes data to a file, flushing it to disk with fsync.
+func writeFile(path string, data []byte, fsync bool) error {
+	if !fsync {
+		return os.WriteFile(path, data, 0644)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// walkContentFiles calls fn for every file in the content area of a cache,
+// i.e. everything except the metadata, sitemap, usage, crawl, hash index,
+// dictionary, trash, accessibility tree, thumbnail, eviction index and
+// failure artifact subdirectories.
+func walkContentFiles(st Storage, fn func(name string, info FileInfo) error) error {
+	return st.Walk("", func(name string, info FileInfo) error {
+		if info.IsDir {
+			if name == metadataSubdir || name == sitemapCacheSubdir || name == usageSubdir || name == crawlSubdir || name == hashSubdir || name == dictSubdir || name == trashSubdir || name == axTreeSubdir || name == thumbnailSubdir || name == lruSubdir || name == failureSubdir {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		return fn(name, info)
+	})
+}
+
+// walkDetachedMetadata calls fn for every metadata record without local
+// content: canonical aliases, and entries moved to cold storage.
+func walkDetachedMetadata(st Storage, fn func(name string, md *entryMetadata) error) error {
+	return st.Walk(metadataSubdir, func(name string, info FileInfo) error {
+		if info.IsDir {
+			return nil
+		}
+		md, err := readMetadataFile(st, name)
+		if err != nil || md.hasLocalContent() {
+			return nil
+		}
+		return fn(name, md)
+	})
+}
+
+// loadEntryMetadata returns the metadata for a content file.  Entries cached
+// before metadata was recorded get metadata reconstructed from the key and
+// file, which is only possible with escaped keys.
+func loadEntryMetadata(st Storage, l cacheLayout, cacheKey string) (*entryMetadata, error) {
+	md, err := readMetadataFile(st, l.metadataName(cacheKey))
+	if !errors.Is(err, fs.ErrNotExist) {
+		return md, err
+	}
+
+	if l.keyScheme != keySchemeEscaped {
+		return nil, errors.New("no metadata to recover the URL from")
+	}
+	rawURL, err := url.PathUnescape(cacheKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot recover URL from key: %w", err)
+	}
+	info, err := st.Stat(l.entryName(cacheKey))
+	if err != nil {
+		return nil, err
+	}
+	return &entryMetadata{URL: rawURL, FetchedAt: info.ModTime}, nil
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	pb "downloadcache/pb"
+)
+
+// versionFetcher returns a new version of a page on every fetch.  Each
+// version has its own size and Content-Type, so a response mixing the
+// metadata of one version with the content of another is caught.
+type versionFetcher struct {
+	version atomic.Int64
+}
+
+func (f *versionFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	v := f.version.Add(1)
+	line := fmt.Sprintf("version %d\n", v)
+	return &FetchResult{
+		Content:     []byte(strings.Repeat(line, int(v%64)+1)),
+		ContentType: fmt.Sprintf("text/plain; version=%d", v),
+	}, nil
+}
+
+// checkWhole fails the test unless a response's content is one whole
+// version of the page, matching the metadata it was returned with.
+func checkWhole(t *testing.T, resp *pb.DownloadCacheResponse) {
+	t.Helper()
+	content := contents(resp)
+	if got := contentHash(content); got != resp.GetContentHash() {
+		t.Errorf("content hash %s doesn't match metadata %s", got, resp.GetContentHash())
+	}
+	if int64(len(content)) != resp.GetTotalBytes() {
+		t.Errorf("content is %d bytes, metadata says %d", len(content), resp.GetTotalBytes())
+	}
+	var v int64
+	if _, err := fmt.Sscanf(resp.GetContentType(), "text/plain; version=%d", &v); err != nil {
+		t.Fatalf("unexpected Content-Type %q", resp.GetContentType())
+	}
+	want := strings.Repeat(fmt.Sprintf("version %d\n", v), int(v%64)+1)
+	if !bytes.Equal(content, []byte(want)) {
+		t.Errorf("content of version %d is torn: %.40q", v, content)
+	}
+}
+
+func newTestServer(t *testing.T, f Fetcher) *Server {
+	t.Helper()
+	cfg := DefaultConfig()
+	cfg.SeleniumURL = "http://selenium.invalid"
+	s, err := NewServer(cfg, WithStorage(NewMemoryStorage(0)), WithFetcher(f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// TestConcurrentGetAndInvalidate races plain Gets of a URL against Gets
+// invalidating it: every reader must see one whole entry, before or after
+// each store, never a mix of the two.
+func TestConcurrentGetAndInvalidate(t *testing.T) {
+	f := &versionFetcher{}
+	s := newTestServer(t, f)
+	const rawURL = "https://example.com/page"
+	ctx := context.Background()
+	if _, err := s.Get(ctx, &pb.DownloadCacheRequest{Url: rawURL}); err != nil {
+		t.Fatal(err)
+	}
+
+	const readers, invalidators, rounds = 8, 4, 50
+	var wg sync.WaitGroup
+	for range readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range rounds {
+				resp, err := s.Get(ctx, &pb.DownloadCacheRequest{Url: rawURL})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				checkWhole(t, resp)
+			}
+		}()
+	}
+	for range invalidators {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range rounds {
+				resp, err := s.Get(ctx, &pb.DownloadCacheRequest{Url: rawURL, CacheOptions: &pb.CacheOptions{Invalidate: true}})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				checkWhole(t, resp)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := f.version.Load(), int64(1+invalidators*rounds); got != want {
+		t.Errorf("fetched %d times, want %d", got, want)
+	}
+	resp, err := s.Get(ctx, &pb.DownloadCacheRequest{Url: rawURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkWhole(t, resp)
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// The steps of an escalation ladder, from cheapest to most likely to get
+// past bot detection.
+const (
+	stepHTTP    = "http"    // Plain HTTP, without rendering
+	stepBrowser = "browser" // The headless browser
+	stepStealth = "stealth" // The headless browser, with FetchOptions.stealth
+)
+
+var escalationSteps = []string{stepHTTP, stepBrowser, stepStealth}
+
+const (
+	// escalationMaxBytes caps pages fetched by the http step.
+	escalationMaxBytes = 32 << 20
+	// minStaticText is how much text a page fetched without rendering must
+	// show, if it has scripts, not to be taken for a shell that scripts
+	// fill in.
+	minStaticText = 200
+)
+
+// jsRequiredMarkers match the text of pages telling visitors without
+// JavaScript to turn it on.
+var jsRequiredMarkers = regexp.MustCompile(`(?i)(enable|turn on|activate) javascript|javascript (is )?(required|disabled|must be enabled)|requires javascript`)
+
+// blockedMarkers match bot challenge and block pages of common CDNs and
+// bot managers.
+var blockedMarkers = regexp.MustCompile(`(?i)<title>(just a moment\.\.\.|attention required! \| cloudflare|access denied|pardon our interruption)</title>|cf-browser-verification|challenge-platform|px-captcha|_incapsula_resource|please verify you are a human|captcha-delivery\.com`)
+
+// escalatingFetcher fetches pages from domains with an escalation ladder by
+// trying each of its steps in turn, until one gets a page that doesn't look
+// like a failure: cheap steps are enough for most pages, and the expensive
+// ones are only paid for where they are needed.  Other pages are fetched by
+// the inner fetcher.
+type escalatingFetcher struct {
+	inner   Fetcher // The browser
+	http    *httpFetcher
+	browser *BrowserConfig
+	logger  *levelLogger
+}
+
+func (f *escalatingFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	ladder := f.browser.escalationFor(rawURL)
+	if needsBrowser(opts) {
+		ladder = slices.DeleteFunc(slices.Clone(ladder), func(step string) bool { return step == stepHTTP })
+	}
+	if len(ladder) == 0 {
+		return f.inner.Fetch(ctx, rawURL, opts)
+	}
+	last := len(ladder) - 1
+	for i, step := range ladder[:last] {
+		result, err := f.fetchStep(ctx, step, rawURL, opts)
+		reason := escalationReason(step, result, err)
+		if reason == "" {
+			f.logger.Requestf(ctx, "Fetched %s with the %s step", rawURL, step)
+			return escalated(result, ladder[:i+1]), nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		f.logger.Requestf(ctx, "Escalating %s from the %s step to %s: %s", rawURL, step, ladder[i+1], reason)
+	}
+	result, err := f.fetchStep(ctx, ladder[last], rawURL, opts)
+	if err != nil {
+		return nil, err
+	}
+	return escalated(result, ladder), nil
+}
+
+// escalated records in a result's receipt the steps of a ladder tried to
+// fetch it.
+func escalated(result *FetchResult, steps []string) *FetchResult {
+	if result.Receipt == nil {
+		result.Receipt = &FetchReceipt{}
+	}
+	result.Receipt.Escalation = steps
+	return result
+}
+
+// fetchStep fetches a page with one step of a ladder.
+func (f *escalatingFetcher) fetchStep(ctx context.Context, step, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	switch step {
+	case stepHTTP:
+		return f.http.Fetch(ctx, rawURL, opts)
+	case stepStealth:
+		opts = proto.Clone(opts).(*pb.FetchOptions)
+		opts.Stealth = true
+	}
+	return f.inner.Fetch(ctx, rawURL, opts)
+}
+
+// needsBrowser reports whether fetch options ask for the browser, or for
+// something only the browser can capture.
+func needsBrowser(opts *pb.FetchOptions) bool {
+	return opts.GetRenderMode() == pb.RenderMode_RENDER_MODE_JS ||
+		opts.GetCaptureAccessibilityTree() || opts.GetCollectWebVitals() || opts.GetCaptureFailureArtifact() ||
+		opts.GetNetworkProfile() != pb.NetworkProfile_NETWORK_PROFILE_UNSPECIFIED
+}
+
+// escalationReason returns why the result of a step should be escalated, or
+// "" if it is good.
+func escalationReason(step string, result *FetchResult, err error) string {
+	if err != nil {
+		return status.Convert(err).Message()
+	}
+	if n := len(result.RedirectChain); n > 0 {
+		if code := result.RedirectChain[n-1].StatusCode; code == http.StatusForbidden || code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable {
+			return fmt.Sprintf("HTTP %d", code)
+		}
+	}
+	if !isHTML(result.ContentType) {
+		return ""
+	}
+	if len(bytes.TrimSpace(result.Content)) == 0 {
+		return "empty body"
+	}
+	if blockedMarkers.Match(result.Content) {
+		return "bot challenge page"
+	}
+	if step == stepHTTP {
+		// Text in <noscript> isn't shown by the browser, and doesn't count.
+		_, text := pageText(result.ContentType, result.Content)
+		if jsRequiredMarkers.MatchString(text) {
+			return "the page asks for JavaScript"
+		}
+		if len(strings.TrimSpace(text)) < minStaticText && bytes.Contains(bytes.ToLower(result.Content), []byte("<script")) {
+			return "no text without scripts"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,351 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// lruSubdir holds the eviction index, so the sizes and access times of
+	// entries needn't be read back from every metadata file at startup.
+	lruSubdir        = ".lru"
+	evictionInterval = time.Minute
+)
+
+// lruIndexName is the Storage name of the persisted eviction index.
+var lruIndexName = path.Join(lruSubdir, "index.json")
+
+// lruEntry is what the eviction index keeps for an entry with local content.
+type lruEntry struct {
+	Size       int64     `json:"size"` // Bytes of its content, metadata and accessibility tree
+	LastAccess time.Time `json:"last_access"`
+}
+
+// lruFile is the persisted eviction index.
+type lruFile struct {
+	SavedAt time.Time           `json:"saved_at"`
+	Entries map[string]lruEntry `json:"entries"`
+}
+
+// lruIndex tracks the size and last access of every entry with local
+// content, so the least recently used can be evicted once the cache grows
+// past its size limit.
+type lruIndex struct {
+	maxBytes int64
+	kick     chan struct{} // Wakes the evictor when a store takes the cache over the limit
+
+	mu        sync.Mutex
+	entries   map[string]lruEntry
+	total     int64
+	dirty     bool // Changed since it was last saved
+	ready     bool // Set once loaded; stores before then are picked up by the load
+	evictions int64
+}
+
+func newLRUIndex(maxBytes int64) *lruIndex {
+	return &lruIndex{maxBytes: maxBytes, kick: make(chan struct{}, 1), entries: make(map[string]lruEntry)}
+}
+
+// put records an entry's size and last access, replacing what was recorded
+// for it before.
+func (x *lruIndex) put(cacheKey string, e lruEntry) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.total += e.Size - x.entries[cacheKey].Size
+	x.entries[cacheKey] = e
+	x.dirty = true
+	if x.ready && x.total > x.maxBytes {
+		select {
+		case x.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// touch records an access to an entry already in the index.
+func (x *lruIndex) touch(cacheKey string, at time.Time) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if e, ok := x.entries[cacheKey]; ok && at.After(e.LastAccess) {
+		e.LastAccess = at
+		x.entries[cacheKey] = e
+		x.dirty = true
+	}
+}
+
+// remove drops an entry from the index.
+func (x *lruIndex) remove(cacheKey string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if e, ok := x.entries[cacheKey]; ok {
+		x.total -= e.Size
+		delete(x.entries, cacheKey)
+		x.dirty = true
+	}
+}
+
+// over returns how many bytes the cache is over its limit, and its entries
+// from least to most recently used.
+func (x *lruIndex) over() (int64, []string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	excess := x.total - x.maxBytes
+	if excess <= 0 {
+		return 0, nil
+	}
+	keys := make([]string, 0, len(x.entries))
+	for key := range x.entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return x.entries[keys[i]].LastAccess.Before(x.entries[keys[j]].LastAccess)
+	})
+	return excess, keys
+}
+
+// lastAccess returns the recorded last access of an entry.
+func (x *lruIndex) lastAccess(cacheKey string) (time.Time, bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	e, ok := x.entries[cacheKey]
+	return e.LastAccess, ok
+}
+
+// collectors returns the eviction metrics, read when scraped.
+func (x *lruIndex) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "downloadcache_cache_bytes",
+			Help: "Bytes used by cached entries, counted against MAX_CACHE_BYTES.",
+		}, func() float64 {
+			x.mu.Lock()
+			defer x.mu.Unlock()
+			return float64(x.total)
+		}),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "downloadcache_evictions_total",
+			Help: "Entries evicted to keep the cache under MAX_CACHE_BYTES.",
+		}, func() float64 {
+			x.mu.Lock()
+			defer x.mu.Unlock()
+			return float64(x.evictions)
+		}),
+	}
+}
+
+// trackEntry records the size and last access of an entry in the eviction
+// index.  Entries without local content aren't tracked.
+func (s *Server) trackEntry(cacheKey string, md *entryMetadata) {
+	if s.lru == nil {
+		return
+	}
+	if !md.hasLocalContent() {
+		s.lru.remove(cacheKey)
+		return
+	}
+	s.lru.put(cacheKey, lruEntry{Size: s.entrySize(cacheKey, md), LastAccess: md.lastAccess()})
+}
+
+// untrackEntry drops an entry from the eviction index.
+func (s *Server) untrackEntry(cacheKey string) {
+	if s.lru != nil {
+		s.lru.remove(cacheKey)
+	}
+}
+
+// entrySize returns the bytes an entry takes in storage.
+func (s *Server) entrySize(cacheKey string, md *entryMetadata) int64 {
+	names := []string{s.contentName(cacheKey), s.layout.metadataName(cacheKey)}
+	if md.AXTree {
+		names = append(names, s.layout.axTreeName(cacheKey))
+	}
+	if md.Thumbnail {
+		names = append(names, s.layout.thumbnailName(cacheKey))
+	}
+	var size int64
+	for _, name := range names {
+		if info, err := s.storage.Stat(name); err == nil {
+			size += info.Size
+		}
+	}
+	return size
+}
+
+// runEviction loads the eviction index, then evicts least recently used
+// entries whenever the cache is over its size limit, checking every
+// interval and after stores, until ctx is done.
+func (s *Server) runEviction(ctx context.Context, interval time.Duration) {
+	if err := s.loadLRUIndex(ctx); err != nil {
+		s.logger.Printf("Error: failed to load the eviction index: %v", err)
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		done, err := s.maintenance.enter()
+		if err == nil { // Skip passes during maintenance.
+			s.evict(ctx)
+			if err := s.saveLRUIndex(); err != nil {
+				s.logger.Printf("Warning: failed to save the eviction index: %v", err)
+			}
+			done()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.lru.kick:
+		}
+	}
+}
+
+// loadLRUIndex reads the persisted eviction index, and reconciles it with
+// the content files in the cache: entries gone since are dropped, and ones
+// written since it was saved are read in.  Without a saved index, every
+// entry is read in.
+func (s *Server) loadLRUIndex(ctx context.Context) error {
+	start := time.Now()
+	var saved lruFile
+	if data, err := s.storage.Read(lruIndexName); err == nil {
+		if err := json.Unmarshal(data, &saved); err != nil {
+			s.logger.Printf("Warning: rebuilding the eviction index: %v", err)
+			saved = lruFile{}
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	found := make(map[string]lruEntry)
+	read := 0
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+		if e, ok := saved.Entries[cacheKey]; ok && !info.ModTime.After(saved.SavedAt) {
+			found[cacheKey] = e
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil || !md.hasLocalContent() {
+			return nil
+		}
+		found[cacheKey] = lruEntry{Size: s.entrySize(cacheKey, md), LastAccess: md.lastAccess()}
+		read++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	x := s.lru
+	x.mu.Lock()
+	// Entries stored while the cache was walked are newer than what it found.
+	for key, e := range found {
+		if _, ok := x.entries[key]; !ok {
+			x.entries[key] = e
+			x.total += e.Size
+		}
+	}
+	x.ready, x.dirty = true, true
+	entries, total := len(x.entries), x.total
+	x.mu.Unlock()
+	s.logger.Printf("Eviction index loaded: %d entries (%d read from metadata), %d bytes of %d in %v", entries, read, total, x.maxBytes, time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// saveLRUIndex persists the eviction index if it changed since it was last
+// saved.
+func (s *Server) saveLRUIndex() error {
+	x := s.lru
+	x.mu.Lock()
+	if !x.dirty {
+		x.mu.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(lruFile{SavedAt: time.Now(), Entries: x.entries})
+	x.dirty = false
+	x.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := s.storage.Write(lruIndexName, data); err != nil {
+		x.mu.Lock()
+		x.dirty = true
+		x.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// evict removes least recently used entries until the cache is back under
+// its size limit.  Held entries are kept, however long ago they were used.
+func (s *Server) evict(ctx context.Context) {
+	excess, keys := s.lru.over()
+	if excess <= 0 {
+		return
+	}
+	evicted, freed := 0, int64(0)
+	for _, cacheKey := range keys {
+		if freed >= excess || ctx.Err() != nil {
+			break
+		}
+		size, err := s.evictEntry(cacheKey)
+		if err != nil {
+			s.logger.Printf("Error: failed to evict %s: %v", cacheKey, err)
+			continue
+		}
+		if size > 0 {
+			evicted++
+			freed += size
+		}
+	}
+	s.logger.Printf("Eviction pass finished: evicted %d entries, %d bytes", evicted, freed)
+	if freed < excess {
+		s.logger.Printf("Warning: the cache is still %d bytes over MAX_CACHE_BYTES; the rest is under legal hold or was used since", excess-freed)
+	}
+}
+
+// evictEntry removes an entry unless it is held or was used since the index
+// was sorted, returning the bytes freed.
+func (s *Server) evictEntry(cacheKey string) (int64, error) {
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.lru.remove(cacheKey)
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	if md.LegalHold || !md.hasLocalContent() {
+		s.trackEntry(cacheKey, md)
+		return 0, nil
+	}
+	if last, ok := s.lru.lastAccess(cacheKey); ok && md.lastAccess().After(last) {
+		s.lru.touch(cacheKey, md.lastAccess())
+		return 0, nil
+	}
+	size := s.entrySize(cacheKey, md)
+	if err := s.removeEntry(cacheKey, md); err != nil {
+		return 0, err
+	}
+	s.lru.mu.Lock()
+	s.lru.evictions++
+	s.lru.mu.Unlock()
+	s.logger.Printf("Evicted %s, last used %v (%d bytes)", md.URL, md.lastAccess(), size)
+	return size, nil
+}
@@ -0,0 +1,298 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the largest piece of an export sent in one message.
+const exportChunkSize = 64 << 10
+
+// exportRecord is one line of a JSON lines export.
+type exportRecord struct {
+	URL         string     `json:"url"`
+	FetchedAt   *time.Time `json:"fetched_at,omitempty"`
+	Status      int        `json:"status,omitempty"`
+	ContentType string     `json:"content_type,omitempty"`
+	Text        string     `json:"text,omitempty"`
+	DuplicateOf string     `json:"duplicate_of,omitempty"`
+	DurationMS  int64      `json:"duration_ms"`
+	Code        string     `json:"code,omitempty"` // The gRPC status of a failed fetch
+	Retryable   bool       `json:"retryable,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// hiddenElements hold no visible text.
+var hiddenElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+}
+
+// blockElements start a new line of text.
+var blockElements = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true,
+	atom.Br: true, atom.Dd: true, atom.Div: true, atom.Dl: true, atom.Dt: true,
+	atom.Figcaption: true, atom.Footer: true, atom.Form: true, atom.H1: true,
+	atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Header: true, atom.Hr: true, atom.Li: true, atom.Main: true, atom.Nav: true,
+	atom.Ol: true, atom.P: true, atom.Pre: true, atom.Section: true, atom.Table: true,
+	atom.Td: true, atom.Th: true, atom.Title: true, atom.Tr: true, atom.Ul: true,
+}
+
+// htmlText returns the visible text of a page, a line per block of text.
+func htmlText(content []byte) string {
+	var b strings.Builder
+	hidden := 0
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			var lines []string
+			for _, line := range strings.Split(b.String(), "\n") {
+				if line = strings.Join(strings.Fields(line), " "); line != "" {
+					lines = append(lines, line)
+				}
+			}
+			return strings.Join(lines, "\n")
+		case html.TextToken:
+			if hidden == 0 {
+				b.Write(z.Text())
+			}
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if hiddenElements[a] {
+				if tt == html.StartTagToken {
+					hidden++
+				} else if tt == html.EndTagToken && hidden > 0 {
+					hidden--
+				}
+			}
+			if blockElements[a] {
+				b.WriteByte('\n')
+			}
+		}
+	}
+}
+
+// exportStreamWriter sends what is written to it as export chunks.
+type exportStreamWriter struct {
+	stream pb.DownloadCache_ExportCrawlServer
+}
+
+func (w exportStreamWriter) Write(p []byte) (int, error) {
+	for sent := 0; sent < len(p); {
+		n := min(len(p)-sent, exportChunkSize)
+		if err := w.stream.Send(&pb.ExportCrawlChunk{Data: p[sent : sent+n]}); err != nil {
+			return sent, err
+		}
+		sent += n
+	}
+	return len(p), nil
+}
+
+// exporter writes the records of an export, each compressed on its own if
+// gzip is set, as WARC tools expect.
+type exporter struct {
+	w    io.Writer
+	gzip bool
+}
+
+func (e *exporter) write(record []byte) error {
+	if !e.gzip {
+		_, err := e.w.Write(record)
+		return err
+	}
+	zw := gzip.NewWriter(e.w)
+	if _, err := zw.Write(record); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// warcRecord formats a WARC/1.1 record.
+func warcRecord(warcType string, date time.Time, headers [][2]string, block []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("WARC/1.1\r\n")
+	fmt.Fprintf(&b, "WARC-Type: %s\r\n", warcType)
+	fmt.Fprintf(&b, "WARC-Record-ID: <urn:uuid:%s>\r\n", newUUID())
+	fmt.Fprintf(&b, "WARC-Date: %s\r\n", date.UTC().Format(time.RFC3339))
+	for _, h := range headers {
+		fmt.Fprintf(&b, "%s: %s\r\n", h[0], h[1])
+	}
+	fmt.Fprintf(&b, "Content-Length: %d\r\n\r\n", len(block))
+	b.Write(block)
+	b.WriteString("\r\n\r\n")
+	return b.Bytes()
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() string {
+	var u [16]byte
+	rand.Read(u[:])
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
+
+// readCrawledPage reads a page from the cache, however old it is.
+func (s *Server) readCrawledPage(ctx context.Context, tenant, rawURL string) (*entryMetadata, []byte, error) {
+	cacheKey := s.resolveAlias(s.layout.tenantKey(tenant, rawURL))
+	if !s.entryExists(cacheKey) {
+		return nil, nil, fs.ErrNotExist
+	}
+	unlock := s.readLockEntry(cacheKey)
+	defer unlock()
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := s.readContent(ctx, s.contentName(cacheKey), md)
+	if err != nil {
+		return nil, nil, err
+	}
+	return md, content, nil
+}
+
+// ExportCrawl handles the gRPC request.
+func (s *Server) ExportCrawl(req *pb.ExportCrawlRequest, stream pb.DownloadCache_ExportCrawlServer) error {
+	ctx := stream.Context()
+	job, err := s.crawlJob(req.GetCrawlId())
+	if err != nil {
+		return err
+	}
+	var ext string
+	switch req.GetFormat() {
+	case pb.ExportFormat_EXPORT_FORMAT_JSONL:
+		ext = ".jsonl"
+	case pb.ExportFormat_EXPORT_FORMAT_WARC:
+		ext = ".warc"
+	default:
+		return status.Errorf(codes.InvalidArgument, "format must be JSONL or WARC")
+	}
+	if req.GetGzip() {
+		ext += ".gz"
+	}
+	if req.GetSave() && s.exportDir == "" {
+		return status.Errorf(codes.FailedPrecondition, "EXPORT_DIR is not set")
+	}
+
+	job.mu.Lock()
+	id, seedURL, tenant := job.state.ID, job.state.URL, job.state.Tenant
+	results := slices.Clone(job.state.Results)
+	job.mu.Unlock()
+	s.logger.Printf("Exporting %d pages of crawl %s as %s", len(results), id, ext)
+
+	var out io.Writer
+	var file *os.File
+	if req.GetSave() {
+		if file, err = os.CreateTemp(s.exportDir, ".export-*"); err != nil {
+			return status.Errorf(codes.Internal, "failed to create export: %v", err)
+		}
+		defer os.Remove(file.Name()) // Fails harmlessly once renamed.
+		defer file.Close()
+		out = file
+	} else {
+		out = exportStreamWriter{stream: stream}
+	}
+	buf := bufio.NewWriterSize(out, exportChunkSize)
+	e := &exporter{w: buf, gzip: req.GetGzip()}
+
+	if req.GetFormat() == pb.ExportFormat_EXPORT_FORMAT_WARC {
+		info := fmt.Sprintf("software: downloadcache\r\nformat: WARC File Format 1.1\r\ndescription: crawl %s of %s\r\n", id, seedURL)
+		record := warcRecord("warcinfo", time.Now(), [][2]string{{"Content-Type", "application/warc-fields"}}, []byte(info))
+		if err := e.write(record); err != nil {
+			return status.Errorf(codes.Internal, "export failed: %v", err)
+		}
+	}
+	pages := 0
+	for _, r := range results {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		md, content, err := s.readCrawledPage(ctx, tenant, r.URL)
+		if err != nil && r.Error == "" {
+			if !errors.Is(err, fs.ErrNotExist) {
+				s.logger.Printf("Warning: failed to read %s for export: %v", r.URL, err)
+			}
+			r.Error = "no longer cached"
+		}
+
+		var record []byte
+		if req.GetFormat() == pb.ExportFormat_EXPORT_FORMAT_WARC {
+			if r.Error != "" || r.DuplicateOf != "" {
+				continue
+			}
+			contentType := md.ContentType
+			if contentType == "" {
+				contentType = "text/html; charset=utf-8"
+			}
+			record = warcRecord("resource", md.FetchedAt, [][2]string{
+				{"WARC-Target-URI", r.URL},
+				{"Content-Type", contentType},
+			}, content)
+		} else {
+			rec := exportRecord{URL: r.URL, Status: r.Status, DuplicateOf: r.DuplicateOf, DurationMS: r.Duration.Milliseconds(), Error: r.Error}
+			if r.Code != codes.OK {
+				rec.Code, rec.Retryable = r.Code.String(), r.Retryable
+			}
+			if r.Error == "" && r.DuplicateOf == "" {
+				rec.FetchedAt = &md.FetchedAt
+				rec.ContentType = md.ContentType
+				if isHTML(md.ContentType) {
+					rec.Text = htmlText(content)
+				} else if isText(md.ContentType) {
+					rec.Text = string(content)
+				}
+			}
+			if record, err = json.Marshal(rec); err != nil {
+				return status.Errorf(codes.Internal, "export failed: %v", err)
+			}
+			record = append(record, '\n')
+		}
+		if err := e.write(record); err != nil {
+			return status.Errorf(codes.Internal, "export failed after %d pages: %v", pages, err)
+		}
+		pages++
+	}
+	if err := buf.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "export failed after %d pages: %v", pages, err)
+	}
+
+	if file != nil {
+		name := id + ext
+		if err := file.Close(); err != nil {
+			return status.Errorf(codes.Internal, "failed to write export: %v", err)
+		}
+		if err := os.Rename(file.Name(), filepath.Join(s.exportDir, name)); err != nil {
+			return status.Errorf(codes.Internal, "failed to write export: %v", err)
+		}
+		s.logger.Printf("Exported %d pages of crawl %s to %s", pages, id, name)
+		return stream.Send(&pb.ExportCrawlChunk{FileName: name, Pages: int64(pages)})
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// failedRunSubdir, under failureSubdir, holds one JSON file per GetBatch or
+// WarmFromFile call with URLs that failed, so RetryFailed can try them
+// again.  Garbage collection removes them after failureRetention, like
+// failure artifacts.
+const failedRunSubdir = ".runs"
+
+// Kinds of runs whose failures are kept, and the prefixes of their IDs.
+const (
+	runBatch = "batch"
+	runWarm  = "warm"
+)
+
+// runIDPattern matches the IDs of runs, which are unlike crawl IDs.
+var runIDPattern = regexp.MustCompile(`^(batch|warm)-[0-9a-f]{16}$`)
+
+// failedRun is the URLs a GetBatch or WarmFromFile call failed to cache.
+type failedRun struct {
+	ID        string       `json:"id"`
+	Tenant    string       `json:"tenant,omitempty"`
+	StartedAt time.Time    `json:"started_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	Failures  []failedItem `json:"failures"`
+
+	mu sync.Mutex // Guards Failures while the run is going
+}
+
+// failedItem is a URL of a run that failed, with the request that failed,
+// so a retry fetches it the same way.
+type failedItem struct {
+	Request   json.RawMessage `json:"request"` // The DownloadCacheRequest, as protojson
+	URL       string          `json:"url"`
+	Code      codes.Code      `json:"code"`
+	Retryable bool            `json:"retryable,omitempty"`
+	Error     string          `json:"error"`
+	Duration  time.Duration   `json:"duration,omitempty"`
+}
+
+func (item *failedItem) itemResult() *pb.ItemResult {
+	return &pb.ItemResult{
+		Url:       item.URL,
+		Code:      int32(item.Code),
+		Retryable: item.Retryable,
+		Message:   item.Error,
+		Duration:  durationpb.New(item.Duration),
+	}
+}
+
+// newFailedRun starts recording the failures of a run of a kind.
+func newFailedRun(kind, tenant string) (*failedRun, error) {
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate %s ID: %v", kind, err)
+	}
+	now := time.Now()
+	return &failedRun{ID: kind + "-" + hex.EncodeToString(idBytes), Tenant: tenant, StartedAt: now, UpdatedAt: now}, nil
+}
+
+// fail records a request of the run that failed with err.
+func (r *failedRun) fail(req *pb.DownloadCacheRequest, err error, elapsed time.Duration) {
+	data, marshalErr := protojson.Marshal(req)
+	if marshalErr != nil {
+		return // Can't be retried, and is reported to the caller anyway.
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Failures = append(r.Failures, failedItem{
+		Request:   data,
+		URL:       req.GetUrl(),
+		Code:      status.Code(err),
+		Retryable: retryableCode(status.Code(err)),
+		Error:     status.Convert(err).Message(),
+		Duration:  elapsed,
+	})
+}
+
+func failedRunName(id string) string {
+	return path.Join(failureSubdir, failedRunSubdir, id+".json")
+}
+
+// saveFailedRun writes a run's failures, or removes its file once it has
+// none.  Failures are logged; the run just can't be retried.
+func (s *Server) saveFailedRun(r *failedRun) {
+	r.mu.Lock()
+	data, err := json.Marshal(r)
+	empty := len(r.Failures) == 0
+	r.mu.Unlock()
+	if err == nil {
+		if empty {
+			err = s.storage.Remove(failedRunName(r.ID))
+		} else {
+			err = s.storage.Write(failedRunName(r.ID), data)
+		}
+	}
+	if err != nil {
+		s.logger.Printf("Warning: failed to save the failures of %s: %v", r.ID, err)
+	}
+}
+
+// loadFailedRun reads the failures of a run the caller may see.
+func (s *Server) loadFailedRun(ctx context.Context, id string) (*failedRun, error) {
+	data, err := s.storage.Read(failedRunName(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "no failures of %s", id)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read the failures of %s: %v", id, err)
+	}
+	var r failedRun
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid failures of %s: %v", id, err)
+	}
+	if err := s.checkTenant(ctx, r.Tenant); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// listRunFailures answers ListCrawlResults for a run: only its failures are
+// kept.
+func (s *Server) listRunFailures(ctx context.Context, id string) (*pb.ListCrawlResultsResponse, error) {
+	r, err := s.loadFailedRun(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListCrawlResultsResponse{}
+	for i := range r.Failures {
+		resp.Results = append(resp.Results, r.Failures[i].itemResult())
+	}
+	return resp, nil
+}
+
+// retryRun fetches a run's failures, or only the retryable ones, again in
+// the background, as a warm does, and reports the run as a crawl: the URLs
+// being retried are queued, and the rest failed.  Those that fail again are
+// kept for another retry.
+func (s *Server) retryRun(ctx context.Context, id string, retryableOnly bool) (*pb.CrawlStatus, error) {
+	if _, busy := s.retryingRuns.LoadOrStore(id, true); busy {
+		return nil, status.Errorf(codes.FailedPrecondition, "the failures of %s are already being retried", id)
+	}
+	r, err := s.loadFailedRun(ctx, id)
+	if err != nil {
+		s.retryingRuns.Delete(id)
+		return nil, err
+	}
+	var retry, kept []failedItem
+	for _, item := range r.Failures {
+		if retryableOnly && !item.Retryable {
+			kept = append(kept, item)
+		} else {
+			retry = append(retry, item)
+		}
+	}
+	st := &pb.CrawlStatus{
+		CrawlId:     r.ID,
+		Tenant:      r.Tenant,
+		State:       pb.CrawlState_CRAWL_STATE_DONE,
+		PagesQueued: int64(len(retry)),
+		PagesFailed: int64(len(kept)),
+		StartedAt:   timestamppb.New(r.StartedAt),
+		UpdatedAt:   timestamppb.New(r.UpdatedAt),
+	}
+	if len(retry) == 0 {
+		s.retryingRuns.Delete(id)
+		return st, nil
+	}
+	st.State = pb.CrawlState_CRAWL_STATE_RUNNING
+	s.logger.Printf("Retrying %d failed URLs of %s", len(retry), id)
+
+	r.Failures = kept
+	progress := &warmProgress{queued: int64(len(retry))}
+	jobCtx, finish := s.startJob(s.crawlContext(), pb.JobKind_JOB_KIND_RETRY, fmt.Sprintf("failures of %s", id), r.Tenant, progress.counts)
+	go func() {
+		defer s.retryingRuns.Delete(id)
+		defer finish()
+		items := make(chan failedItem)
+		slots := &domainSlots{limit: defaultWarmPerDomain, slots: make(map[string]chan struct{})}
+		var workers sync.WaitGroup
+		for range min(defaultWarmConcurrency, len(retry)) {
+			workers.Add(1)
+			go func() {
+				defer workers.Done()
+				for item := range items {
+					req := &pb.DownloadCacheRequest{}
+					if err := protojson.Unmarshal(item.Request, req); err != nil {
+						continue
+					}
+					start := time.Now()
+					err := s.warm(jobCtx, slots, req)
+					switch {
+					case err == nil:
+						progress.mu.Lock()
+						progress.done++
+						progress.mu.Unlock()
+					case jobCtx.Err() != nil:
+						// Stopped by shutdown or CancelJob: kept as it was.
+						r.mu.Lock()
+						r.Failures = append(r.Failures, item)
+						r.mu.Unlock()
+					default:
+						progress.fail(req.GetUrl(), err, time.Since(start))
+						r.fail(req, err, time.Since(start))
+					}
+				}
+			}()
+		}
+		for _, item := range retry {
+			items <- item
+		}
+		close(items)
+		workers.Wait()
+		r.UpdatedAt = time.Now()
+		s.saveFailedRun(r)
+		_, done, failed := progress.counts()
+		s.logger.Printf("Retry of %s finished: %d URLs done, %d failed", id, done, failed)
+	}()
+	return st, nil
+}
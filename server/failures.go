@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"github.com/tebeka/selenium"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// failureSubdir holds what the browser showed when a fetch failed, one
+	// file per cache key of the URL requested, gzipped, and encrypted for
+	// tenants.  Each failure replaces the last one saved for the URL.  The
+	// failed URLs of batches and warms are kept under it too; see
+	// failedRunSubdir.
+	failureSubdir = ".failures"
+	// failureRetention is how long garbage collection keeps failure
+	// artifacts.
+	failureRetention = 7 * 24 * time.Hour
+	// failureCaptureTimeout bounds how long a failed fetch waits for the
+	// browser to hand over its page and screenshot.  A fetch past its hard
+	// timeout still returns within fetchAbandonGrace: this, after stopping
+	// the navigation.
+	failureCaptureTimeout = stopNavigationTimeout
+)
+
+// failureName returns the Storage name of the failure artifact for a key.
+func (l cacheLayout) failureName(cacheKey string) string {
+	return path.Join(failureSubdir, l.relPath(cacheKey))
+}
+
+// failureArtifact is what the browser showed when a fetch failed.
+type failureArtifact struct {
+	Error      string     `json:"error"`
+	Code       codes.Code `json:"code"`
+	FailedAt   time.Time  `json:"failed_at"`
+	PageURL    string     `json:"page_url"`
+	Content    []byte     `json:"content"`    // The page's HTML as it had rendered
+	Screenshot []byte     `json:"screenshot"` // PNG
+}
+
+// failedFetch is a fetch error with a failure artifact captured for it.
+type failedFetch struct {
+	err      error
+	artifact *failureArtifact
+}
+
+func (e *failedFetch) Error() string              { return e.err.Error() }
+func (e *failedFetch) Unwrap() error              { return e.err }
+func (e *failedFetch) GRPCStatus() *status.Status { return status.Convert(e.err) }
+
+// captureFailure returns err with what the browser of a WebDriver session is
+// showing attached, or err itself if the browser shows nothing or doesn't
+// answer in time.
+func (f *seleniumFetcher) captureFailure(wd selenium.WebDriver, err error) error {
+	captured := make(chan *failureArtifact, 1)
+	go func() {
+		a := &failureArtifact{Error: status.Convert(err).Message(), Code: status.Code(err), FailedAt: time.Now()}
+		a.PageURL, _ = wd.CurrentURL()
+		if source, err := wd.PageSource(); err == nil {
+			a.Content = []byte(source)
+		}
+		if png, err := wd.Screenshot(); err == nil {
+			a.Screenshot = png
+		}
+		captured <- a
+	}()
+	select {
+	case a := <-captured:
+		if len(a.Content) == 0 && len(a.Screenshot) == 0 {
+			return err
+		}
+		return &failedFetch{err: err, artifact: a}
+	case <-time.After(failureCaptureTimeout):
+		f.logger.Printf("Warning: the browser didn't hand over a failure artifact within %v", failureCaptureTimeout)
+		return err
+	}
+}
+
+// keepFailureArtifact saves the failure artifact attached to a fetch error,
+// if any, under the cache key of the URL requested, and returns the error
+// to report.
+func (s *Server) keepFailureArtifact(ctx context.Context, rawURL, tenant string, err error) error {
+	var failed *failedFetch
+	if !errors.As(err, &failed) {
+		return err
+	}
+	if writeErr := s.writeFailureArtifact(ctx, s.layout.tenantKey(tenant, rawURL), tenant, failed.artifact); writeErr != nil {
+		s.logger.Printf("Warning: failed to save the failure artifact of %s: %v", rawURL, writeErr)
+		return failed.err
+	}
+	s.logger.Requestf(ctx, "Saved a failure artifact for %s", rawURL)
+	return status.Errorf(status.Code(err), "%s (failure artifact saved; see GetFailureArtifact)", status.Convert(err).Message())
+}
+
+// writeFailureArtifact stores a failure artifact, replacing the last one
+// saved for the key.
+func (s *Server) writeFailureArtifact(ctx context.Context, cacheKey, tenant string, a *failureArtifact) error {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	if data, err = codecGzip.encode(data); err != nil {
+		return err
+	}
+	if tenant != "" {
+		c, err := s.tenantCipher(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if data, err = sealContent(c, tenant, data); err != nil {
+			return err
+		}
+	}
+	return s.storage.Write(s.layout.failureName(cacheKey), data)
+}
+
+// readFailureArtifact reads the failure artifact saved for a key.
+func (s *Server) readFailureArtifact(ctx context.Context, cacheKey, tenant string) (*failureArtifact, error) {
+	data, err := s.storage.Read(s.layout.failureName(cacheKey))
+	if err != nil {
+		return nil, err
+	}
+	if tenant != "" {
+		c, err := s.tenantCipher(ctx, tenant)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = openContent(c, tenant, data); err != nil {
+			return nil, err
+		}
+	}
+	if data, err = codecGzip.decode(data); err != nil {
+		return nil, err
+	}
+	var a failureArtifact
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, fmt.Errorf("invalid failure artifact: %w", err)
+	}
+	return &a, nil
+}
+
+// GetFailureArtifact handles the gRPC request.
+func (s *Server) GetFailureArtifact(ctx context.Context, req *pb.GetFailureArtifactRequest) (*pb.GetFailureArtifactResponse, error) {
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return nil, err
+	}
+	a, err := s.readFailureArtifact(ctx, s.layout.tenantKey(req.GetTenant(), req.GetUrl()), req.GetTenant())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "no failure artifact saved for %s", req.GetUrl())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read the failure artifact of %s: %v", req.GetUrl(), err)
+	}
+	return &pb.GetFailureArtifactResponse{
+		Error:      a.Error,
+		Code:       int32(a.Code),
+		FailedAt:   timestamppb.New(a.FailedAt),
+		PageUrl:    a.PageURL,
+		Content:    string(a.Content),
+		Screenshot: a.Screenshot,
+	}, nil
+}
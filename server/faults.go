@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FaultConfig injects faults, so users can test how their pipelines behave
+// when the cache degrades.  Rates are fractions of calls, from 0 to 1.  It
+// is meant for test deployments only.
+type FaultConfig struct {
+	FetchDelayRate float64       // Fetches delayed by a random time up to FetchDelay
+	FetchDelay     time.Duration // Longest delay injected
+	FetchErrorRate float64       // Fetches failing as if the renderer had failed
+	WriteErrorRate float64       // Storage writes failing
+}
+
+func (c FaultConfig) enabled() bool {
+	return c.FetchDelayRate > 0 || c.FetchErrorRate > 0 || c.WriteErrorRate > 0
+}
+
+func (c FaultConfig) validate() error {
+	for _, rate := range []float64{c.FetchDelayRate, c.FetchErrorRate, c.WriteErrorRate} {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("fault rates must be between 0 and 1")
+		}
+	}
+	if c.FetchDelay < 0 {
+		return fmt.Errorf("FetchDelay must not be negative")
+	}
+	if c.FetchDelayRate > 0 && c.FetchDelay == 0 {
+		return fmt.Errorf("FetchDelay must be positive to delay fetches")
+	}
+	return nil
+}
+
+// errInjectedWrite is the error of storage writes failed on purpose.
+var errInjectedWrite = errors.New("injected storage write failure")
+
+// faultyFetcher delays and fails fetches of the fetcher it wraps.
+type faultyFetcher struct {
+	inner Fetcher
+	cfg   FaultConfig
+}
+
+func (f *faultyFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	if f.cfg.FetchDelayRate > 0 && rand.Float64() < f.cfg.FetchDelayRate {
+		sleepContext(ctx, rand.N(f.cfg.FetchDelay)+1)
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+	}
+	if f.cfg.FetchErrorRate > 0 && rand.Float64() < f.cfg.FetchErrorRate {
+		return nil, status.Errorf(codes.Internal, "failed to navigate to URL with Selenium %s: injected renderer failure", rawURL)
+	}
+	return f.inner.Fetch(ctx, rawURL, opts)
+}
+
+// faultyStorage fails writes to the storage it wraps.
+type faultyStorage struct {
+	Storage
+	cfg FaultConfig
+}
+
+func (s *faultyStorage) Write(name string, data []byte) error {
+	if s.cfg.WriteErrorRate > 0 && rand.Float64() < s.cfg.WriteErrorRate {
+		return errInjectedWrite
+	}
+	return s.Storage.Write(name, data)
+}
+
+// injectFaults wraps the server's fetcher and storage to inject the
+// configured faults.
+func (s *Server) injectFaults(cfg FaultConfig) {
+	if !cfg.enabled() {
+		return
+	}
+	if s.fetcher != nil && (cfg.FetchDelayRate > 0 || cfg.FetchErrorRate > 0) {
+		s.fetcher = &faultyFetcher{inner: s.fetcher, cfg: cfg}
+	}
+	if cfg.WriteErrorRate > 0 {
+		s.storage = &faultyStorage{Storage: s.storage, cfg: cfg}
+	}
+	s.logger.Printf("Warning: injecting faults: %.0f%% of fetches delayed up to %v, %.0f%% of fetches failed, %.0f%% of writes failed",
+		100*cfg.FetchDelayRate, cfg.FetchDelay, 100*cfg.FetchErrorRate, 100*cfg.WriteErrorRate)
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Fetcher retrieves pages on a cache miss.  Errors should be gRPC status
+// errors; they are returned to the caller of Get as they are.
+type Fetcher interface {
+	// Fetch retrieves a page.  opts has every field set.
+	Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error)
+}
+
+type fetchTenantKey struct{}
+
+// withFetchTenant returns a context for fetching a page for tenant.
+func withFetchTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, fetchTenantKey{}, tenant)
+}
+
+// fetchTenant returns the tenant a page is fetched for in ctx, or "" for
+// none.
+func fetchTenant(ctx context.Context) string {
+	tenant, _ := ctx.Value(fetchTenantKey{}).(string)
+	return tenant
+}
+
+// FetchResult is a page as returned by a Fetcher, before minification.
+type FetchResult struct {
+	Content       []byte
+	RedirectChain []RedirectHop  // Documents loaded on the way to the page, ending with the page itself; may be empty
+	ContentType   string         // The page's Content-Type; empty if unknown
+	Usage         *ResourceUsage // What rendering the page cost; nil if not measured
+	AXTree        []byte         // The page's accessibility tree as JSON, if asked for
+	Thumbnail     []byte         // A JPEG thumbnail of the page, if the fetcher takes them
+	Vitals        *WebVitals     // How fast the page loaded, if asked for
+	TLS           *TLSInfo       // The page's TLS connection; nil if unknown
+	Receipt       *FetchReceipt  // How the page was fetched, as far as the fetcher knows; nil if it doesn't say
+}
+
+// WebVitals are how fast a page loaded, measured from the start of its
+// navigation.  Zero durations weren't reported.
+type WebVitals struct {
+	TTFB                   time.Duration `json:"ttfb,omitempty"`
+	FirstContentfulPaint   time.Duration `json:"fcp,omitempty"`
+	LargestContentfulPaint time.Duration `json:"lcp,omitempty"`
+	DOMContentLoaded       time.Duration `json:"dom_content_loaded,omitempty"`
+	Load                   time.Duration `json:"load,omitempty"`
+	CumulativeLayoutShift  float64       `json:"cls,omitempty"`
+}
+
+// ResourceUsage is what rendering a page cost the browser.
+type ResourceUsage struct {
+	CPUTime          time.Duration // Time the page's main thread spent running tasks
+	HeapBytes        int64         // JavaScript heap in use once the page rendered
+	BytesTransferred int64         // Received for the page and its resources, as sent over the network
+}
+
+// isHTML reports whether a Content-Type is an HTML document.  Pages of
+// unknown type are taken to be HTML, since that's what fetchers render.
+func isHTML(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return true
+	}
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// isText reports whether a Content-Type is a text document, as opposed to a
+// binary one such as a PDF.  Pages of unknown type are taken to be text.
+func isText(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return true
+	}
+	switch mediaType {
+	case "application/json", "application/xml", "application/javascript", "application/x-javascript", "application/xhtml+xml":
+		return true
+	}
+	return strings.HasPrefix(mediaType, "text/") || strings.HasSuffix(mediaType, "+json") || strings.HasSuffix(mediaType, "+xml")
+}
+
+// pluginFetcher delegates fetching to an out-of-process plugin implementing
+// the Fetcher gRPC service (see pb/fetcher.proto).
+type pluginFetcher struct {
+	client pb.FetcherClient
+}
+
+// newPluginFetcher connects to a fetcher plugin.  The connection is made
+// lazily, so the plugin doesn't have to be up before the server starts.
+func newPluginFetcher(addr string) (*pluginFetcher, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to fetcher plugin %s: %w", addr, err)
+	}
+	return &pluginFetcher{client: pb.NewFetcherClient(conn)}, nil
+}
+
+func (p *pluginFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	resp, err := p.client.Fetch(ctx, &pb.FetchRequest{Url: rawURL, Options: opts})
+	if err != nil {
+		return nil, err
+	}
+	result := &FetchResult{Content: []byte(resp.GetPageContents()), ContentType: resp.GetContentType(), Receipt: &FetchReceipt{Fetcher: fetcherPlugin}}
+	for _, hop := range resp.GetRedirectChain() {
+		result.RedirectChain = append(result.RedirectChain, RedirectHop{URL: hop.GetUrl(), StatusCode: int(hop.GetStatusCode())})
+	}
+	return result, nil
+}
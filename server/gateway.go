@@ -0,0 +1,139 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GatewayHandler serves cached pages over plain HTTP, for clients such as
+// browsers and HTTP caches that can't speak gRPC:
+//
+//	GET /page?url=<url>[&invalidate=true][&absolute_urls=true][&link_prefix=<prefix>]
+//
+// The parameters mirror DownloadCacheRequest.  If a gateway signing key is
+// configured, requests must carry a signature from CreateSignedURL, and
+// only read the cache.
+func (s *Server) GatewayHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /page", s.servePage)
+	return mux
+}
+
+func (s *Server) servePage(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if code, msg := s.checkSignature(q); code != http.StatusOK {
+		http.Error(w, msg, code)
+		return
+	}
+	// A signed URL grants read access to the cached page, not a fetch: a
+	// page that isn't cached, or has expired, is not found.
+	signed := len(s.signingKey) > 0
+	if signed && queryBool(q.Get("invalidate")) {
+		http.Error(w, "invalidate is not allowed on signed URLs", http.StatusForbidden)
+		return
+	}
+	req := &pb.DownloadCacheRequest{
+		Url:          q.Get("url"),
+		AbsoluteUrls: queryBool(q.Get("absolute_urls")),
+		LinkPrefix:   q.Get("link_prefix"),
+		CacheOptions: &pb.CacheOptions{Invalidate: queryBool(q.Get("invalidate")), CacheOnly: signed},
+	}
+	resp, err := s.Get(r.Context(), req)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+
+	var modTime time.Time
+	if fetchedAt := resp.GetFetchedAt(); fetchedAt != nil {
+		modTime = fetchedAt.AsTime()
+		w.Header().Set("ETag", entryETag(modTime))
+	}
+	s.setCacheHeaders(w.Header(), resp, time.Now())
+	if canonical := resp.GetCanonicalUrl(); canonical != "" {
+		w.Header().Set("Link", "<"+canonical+">; rel=\"canonical\"")
+	}
+	if contentType := resp.GetContentType(); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	} else {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	}
+	// ServeContent sets Last-Modified and answers conditional requests
+	// (If-None-Match, If-Modified-Since) with 304, and Range requests
+	// (including If-Range) with 206, so large downloads can be resumed.
+	http.ServeContent(w, r, "", modTime, bytes.NewReader(contents(resp)))
+}
+
+// entryETag identifies one fetch of a URL.  A new fetch always gets a new
+// tag, so the tag doesn't need to be derived from the content.
+func entryETag(fetchedAt time.Time) string {
+	return `"` + strconv.FormatInt(fetchedAt.UnixNano(), 36) + `"`
+}
+
+// setCacheHeaders tells HTTP caches in front of the gateway, such as CDNs,
+// how long they may keep a page: the configured directives with a max-age of
+// the page's TTL, and an Age of how long ago it was fetched, so a cache
+// holds it only until the cached copy would expire here.  Pages that never
+// expire get no max-age, unless the directives set one.
+func (s *Server) setCacheHeaders(h http.Header, resp *pb.DownloadCacheResponse, now time.Time) {
+	if s.cacheControl == "" {
+		return
+	}
+	cacheControl := s.cacheControl
+	fetchedAt, expiresAt := resp.GetFetchedAt(), resp.GetRevalidateAfter()
+	if fetchedAt != nil && expiresAt != nil && !strings.Contains(cacheControl, "max-age") {
+		ttl := expiresAt.AsTime().Sub(fetchedAt.AsTime())
+		cacheControl += ", max-age=" + strconv.FormatInt(int64(ttl/time.Second), 10)
+	}
+	h.Set("Cache-Control", cacheControl)
+	if fetchedAt != nil {
+		age := max(now.Sub(fetchedAt.AsTime()), 0)
+		h.Set("Age", strconv.FormatInt(int64(age/time.Second), 10))
+	}
+}
+
+func queryBool(v string) bool {
+	b, _ := strconv.ParseBool(v)
+	return b
+}
+
+// writeGatewayError reports a gRPC error with the closest HTTP status.
+func writeGatewayError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.InvalidArgument, codes.OutOfRange:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.FailedPrecondition:
+		code = http.StatusUnprocessableEntity
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		code = http.StatusTooManyRequests
+	case codes.Unavailable:
+		code = http.StatusServiceUnavailable
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(info.GetRetryDelay().AsDuration().Seconds())))
+			}
+		}
+	case codes.DeadlineExceeded:
+		code = http.StatusGatewayTimeout
+	case codes.Canceled:
+		code = 499 // Client closed request
+	}
+	http.Error(w, st.Message(), code)
+}
@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gcGracePeriod protects files that may still be in the middle of a write:
+// content is written before its metadata, so a young file without metadata
+// is not necessarily an orphan.
+const gcGracePeriod = time.Hour
+
+// gcResult summarizes a garbage collection pass.
+type gcResult struct {
+	filesRemoved   int
+	bytesReclaimed int64
+}
+
+// CollectGarbage handles the gRPC request.
+func (s *Server) CollectGarbage(ctx context.Context, req *pb.CollectGarbageRequest) (*pb.CollectGarbageResponse, error) {
+	s.logger.Printf("Received garbage collection request, DryRun: %v", req.GetDryRun())
+	if !req.GetDryRun() {
+		done, err := s.maintenance.enter()
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+	}
+
+	result, err := s.collectGarbage(req.GetDryRun())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "garbage collection failed: %v", err)
+	}
+	return &pb.CollectGarbageResponse{
+		FilesRemoved:   int32(result.filesRemoved),
+		BytesReclaimed: result.bytesReclaimed,
+	}, nil
+}
+
+// runGarbageCollector collects garbage every interval until ctx is done.
+func (s *Server) runGarbageCollector(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			done, err := s.maintenance.enter()
+			if err != nil {
+				continue // Skip passes during maintenance.
+			}
+			if _, err := s.collectGarbage(false); err != nil {
+				s.logger.Printf("Error: garbage collection failed: %v", err)
+			}
+			done()
+		}
+	}
+}
+
+// collectGarbage removes files that no cache entry refers to:
+//   - partially written files abandoned by a crash,
+//   - content files at paths the current cache layout never reads,
+//   - content left under a key that has since become a canonical alias,
+//   - metadata for content that no longer exists,
+//   - accessibility trees and thumbnails of entries whose content no longer
+//     exists,
+//   - content hash index files for entries that are gone or no longer have
+//     the hash,
+//   - failure artifacts older than failureRetention.
+//
+// Entries cached before metadata was recorded have content but no metadata;
+// they are still served, so they are kept.
+func (s *Server) collectGarbage(dryRun bool) (gcResult, error) {
+	var result gcResult
+	cutoff := time.Now().Add(-gcGracePeriod)
+
+	remove := func(name string, info FileInfo, reason string) error {
+		s.logger.Printf("GC: removing %s (%s), DryRun: %v", name, reason, dryRun)
+		if !dryRun {
+			if err := s.storage.Remove(name); err != nil {
+				return err
+			}
+		}
+		result.filesRemoved++
+		result.bytesReclaimed += info.Size
+		return nil
+	}
+
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		if info.ModTime.After(cutoff) {
+			return nil
+		}
+		if strings.HasSuffix(name, partialSuffix) {
+			return remove(name, info, "partial write")
+		}
+		cacheKey := path.Base(name)
+		if name != s.contentName(cacheKey) {
+			return remove(name, info, "not part of the cache layout")
+		}
+		if md, err := s.readMetadata(cacheKey); err == nil && md.AliasOf != "" {
+			return remove(name, info, "superseded by alias")
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	err = s.storage.Walk(metadataSubdir, func(name string, info FileInfo) error {
+		if info.IsDir || info.ModTime.After(cutoff) {
+			return nil
+		}
+		if strings.HasSuffix(name, partialSuffix) {
+			return remove(name, info, "partial write")
+		}
+		cacheKey := strings.TrimSuffix(path.Base(name), ".json")
+		if name != s.layout.metadataName(cacheKey) {
+			return remove(name, info, "not part of the cache layout")
+		}
+		md, err := readMetadataFile(s.storage, name)
+		if err != nil || !md.hasLocalContent() {
+			return nil
+		}
+		if _, err := s.storage.Stat(s.contentName(cacheKey)); errors.Is(err, fs.ErrNotExist) {
+			return remove(name, info, "metadata without content")
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// Artifacts stored with entries, under their cache keys, go with the
+	// entries' content.
+	artifacts := []struct {
+		subdir string
+		name   func(cacheKey string) string
+	}{
+		{axTreeSubdir, s.layout.axTreeName},
+		{thumbnailSubdir, s.layout.thumbnailName},
+	}
+	for _, a := range artifacts {
+		err = s.storage.Walk(a.subdir, func(name string, info FileInfo) error {
+			if info.IsDir || info.ModTime.After(cutoff) {
+				return nil
+			}
+			if strings.HasSuffix(name, partialSuffix) {
+				return remove(name, info, "partial write")
+			}
+			cacheKey := path.Base(name)
+			if name != a.name(cacheKey) {
+				return remove(name, info, "not part of the cache layout")
+			}
+			if s.contentGone(cacheKey) {
+				return remove(name, info, "artifact without content")
+			}
+			return nil
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	err = s.storage.Walk(hashSubdir, func(name string, info FileInfo) error {
+		if info.IsDir || info.ModTime.After(cutoff) {
+			return nil
+		}
+		if strings.HasSuffix(name, partialSuffix) {
+			return remove(name, info, "partial write")
+		}
+		hash, tenant, _ := strings.Cut(path.Base(name), ".")
+		if !validContentHash(hash) || name != hashIndexName(tenant, hash) {
+			return remove(name, info, "not part of the cache layout")
+		}
+		data, err := s.storage.Read(name)
+		if err != nil {
+			return nil
+		}
+		cacheKey := string(data)
+		if cacheKey == "" || strings.Contains(cacheKey, "/") {
+			return remove(name, info, "not part of the cache layout")
+		}
+		if s.contentGone(cacheKey) {
+			return remove(name, info, "hash index without content")
+		}
+		md, err := s.readMetadata(cacheKey)
+		if errors.Is(err, fs.ErrNotExist) || (err == nil && (md.ContentHash != hash || md.Tenant != tenant || md.AliasOf != "")) {
+			return remove(name, info, "hash index of replaced content")
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	failureCutoff := time.Now().Add(-failureRetention)
+	err = s.storage.Walk(failureSubdir, func(name string, info FileInfo) error {
+		if info.IsDir || info.ModTime.After(failureCutoff) {
+			return nil
+		}
+		return remove(name, info, "failure artifact past retention")
+	})
+	if err != nil {
+		return result, err
+	}
+
+	s.logger.Printf("GC finished: %d files, %d bytes reclaimed, DryRun: %v", result.filesRemoved, result.bytesReclaimed, dryRun)
+	return result, nil
+}
+
+// contentGone reports whether the entry under a cache key has lost its
+// content: its content file is missing, and it is neither an alias nor in
+// cold storage.
+func (s *Server) contentGone(cacheKey string) bool {
+	if _, err := s.storage.Stat(s.contentName(cacheKey)); !errors.Is(err, fs.ErrNotExist) {
+		return false
+	}
+	md, err := s.readMetadata(cacheKey)
+	return errors.Is(err, fs.ErrNotExist) || (err == nil && md.hasLocalContent())
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	pb "downloadcache/pb"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip" // Registers the gzip compressor
+)
+
+// defaultCompressMinBytes is the response size above which Get compresses
+// its response even if the client didn't compress its request.  Pages
+// compress several times over, and clients are often across a WAN.
+const defaultCompressMinBytes = 32 << 10
+
+// Transport compressors, most preferred first.
+var responseCompressors = []string{"zstd", "gzip"}
+
+func init() {
+	encoding.RegisterCompressor(zstdCompressor{})
+}
+
+// zstdCompressor is gRPC transport compression with zstd, which is faster
+// than gzip for the same ratio on HTML.
+type zstdCompressor struct{}
+
+func (zstdCompressor) Name() string { return "zstd" }
+
+func (zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+}
+
+func (zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return &zstdStreamReader{d}, nil
+}
+
+// zstdStreamReader releases its decoder once the message has been read,
+// since gRPC never closes the readers it gets from Decompress.
+type zstdStreamReader struct {
+	*zstd.Decoder
+}
+
+func (r *zstdStreamReader) Read(p []byte) (int, error) {
+	n, err := r.Decoder.Read(p)
+	if err == io.EOF {
+		r.Decoder.Close()
+	}
+	return n, err
+}
+
+// compressLargeResponse asks gRPC to compress a response of size bytes with
+// the best compressor the client accepts, if the response is large enough to
+// be worth it.  Outside a gRPC call (e.g. from the HTTP gateway) it does
+// nothing, and within a GetBatch call it leaves the compressor GetBatch set.
+func (s *Server) compressLargeResponse(ctx context.Context, size int) {
+	if s.compressMinBytes <= 0 || size < s.compressMinBytes {
+		return
+	}
+	if method, _ := grpc.Method(ctx); method == pb.DownloadCache_GetBatch_FullMethodName {
+		return
+	}
+	s.setResponseCompressor(ctx)
+}
+
+// setResponseCompressor asks gRPC to compress the responses of the call with
+// the best compressor the client accepts.
+func (s *Server) setResponseCompressor(ctx context.Context) {
+	accepted, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return
+	}
+	for _, name := range responseCompressors {
+		if slices.Contains(accepted, name) {
+			if err := grpc.SetSendCompressor(ctx, name); err != nil {
+				s.logger.Printf("Warning: failed to set response compressor %s: %v", name, err)
+			}
+			return
+		}
+	}
+}
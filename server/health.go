@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// orcaTrailer is the trailer gRPC load balancers read per-call ORCA load
+// reports from, such as the weighted_round_robin policy.
+const orcaTrailer = "endpoint-load-metrics-bin"
+
+// rateWindow is how far back call rates are averaged, in seconds.
+const rateWindow = 10
+
+// callRate tracks how many calls finish, and how many fail, per second.
+type callRate struct {
+	mu      sync.Mutex
+	buckets [rateWindow]rateBucket
+}
+
+// rateBucket holds the calls finished during one second.
+type rateBucket struct {
+	second        int64
+	calls, errors int
+}
+
+// record counts a finished call.
+func (r *callRate) record(failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	second := time.Now().Unix()
+	b := &r.buckets[second%rateWindow]
+	if b.second != second {
+		*b = rateBucket{second: second}
+	}
+	b.calls++
+	if failed {
+		b.errors++
+	}
+}
+
+// rates returns the calls and failures per second over the window.
+func (r *callRate) rates() (qps, eps float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	second := time.Now().Unix()
+	var calls, errors int
+	for _, b := range r.buckets {
+		if second-b.second < rateWindow {
+			calls += b.calls
+			errors += b.errors
+		}
+	}
+	return float64(calls) / rateWindow, float64(errors) / rateWindow
+}
+
+// HealthServer returns the server's gRPC health service, for registering
+// alongside it.  The server and the DownloadCache service report NOT_SERVING
+// in maintenance mode, so load balancers stop sending requests.
+func (s *Server) HealthServer() healthgrpc.HealthServer {
+	return s.health
+}
+
+// setServing updates the health service.
+func (s *Server) setServing(serving bool) {
+	st := healthgrpc.HealthCheckResponse_SERVING
+	if !serving {
+		st = healthgrpc.HealthCheckResponse_NOT_SERVING
+	}
+	s.health.SetServingStatus("", st)
+	s.health.SetServingStatus(pb.DownloadCache_ServiceDesc.ServiceName, st)
+}
+
+func newHealthServer() *health.Server {
+	h := health.NewServer()
+	h.SetServingStatus(pb.DownloadCache_ServiceDesc.ServiceName, healthgrpc.HealthCheckResponse_SERVING)
+	return h
+}
+
+// ServerOptions returns the options the gRPC server should be created with:
+// interceptors attaching an ORCA load report to every call's trailer, so
+// proxyless gRPC clients can balance load by downloads in flight rather than
+// round-robin, and recording every call for MetricsHandler.
+func (s *Server) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.rpcMetrics.UnaryServerInterceptor(), func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			resp, err := handler(ctx, req)
+			s.calls.record(callFailed(err))
+			grpc.SetTrailer(ctx, s.loadReport())
+			return resp, err
+		}),
+		grpc.ChainStreamInterceptor(s.rpcMetrics.StreamServerInterceptor(), func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			err := handler(srv, ss)
+			s.calls.record(callFailed(err))
+			ss.SetTrailer(s.loadReport())
+			return err
+		}),
+	}
+}
+
+// loadReport returns the server's load as ORCA trailer metadata.
+func (s *Server) loadReport() metadata.MD {
+	queued, active, _ := s.load.snapshot()
+	qps, eps := s.calls.rates()
+	named := map[string]float64{
+		"queued_sessions": float64(queued),
+		"active_sessions": float64(active),
+		"in_flight":       float64(s.maintenance.pending()),
+	}
+	utilization := -1.0
+	if s.loadCapacity > 0 {
+		utilization = float64(queued+active) / float64(s.loadCapacity)
+	}
+	return metadata.Pairs(orcaTrailer, string(orcaLoadReport(utilization, qps, eps, named)))
+}
+
+// orcaLoadReport encodes an xds.data.orca.v3.OrcaLoadReport, whose generated
+// code this module doesn't otherwise need.  A negative utilization is left
+// out.
+func orcaLoadReport(utilization, qps, eps float64, named map[string]float64) []byte {
+	appendDouble := func(b []byte, field protowire.Number, v float64) []byte {
+		b = protowire.AppendTag(b, field, protowire.Fixed64Type)
+		return protowire.AppendFixed64(b, math.Float64bits(v))
+	}
+	var b []byte
+	b = appendDouble(b, 6, qps) // rps_fractional
+	b = appendDouble(b, 7, eps) // eps
+	names := make([]string, 0, len(named))
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names { // named_metrics
+		var entry []byte
+		entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+		entry = protowire.AppendString(entry, name)
+		entry = appendDouble(entry, 2, named[name])
+		b = protowire.AppendTag(b, 8, protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+	if utilization >= 0 {
+		b = appendDouble(b, 9, utilization) // application_utilization
+	}
+	return b
+}
+
+// callFailed reports whether a call's error counts against the server in
+// load reports.  Errors the client caused don't.
+func callFailed(err error) bool {
+	switch status.Code(err) {
+	case codes.OK, codes.InvalidArgument, codes.NotFound, codes.Canceled, codes.FailedPrecondition, codes.PermissionDenied, codes.Unauthenticated:
+		return false
+	}
+	return true
+}
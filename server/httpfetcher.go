@@ -0,0 +1,153 @@
+package server
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"slices"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpFetcher downloads documents over plain HTTP, without a browser, so
+// they are cached exactly as served.
+type httpFetcher struct {
+	client    *http.Client
+	maxBytes  int64             // Larger documents fail with ResourceExhausted
+	bandwidth *bandwidthLimiter // nil if downloads are unlimited
+	userAgent string            // Empty sends Go's
+}
+
+// errTooManyRedirects stops a fetch that redirects past its max_redirects.
+var errTooManyRedirects = errors.New("too many redirects")
+
+// newHTTPFetcher returns a fetcher of documents up to maxBytes over plain
+// HTTP.
+func (s *Server) newHTTPFetcher(maxBytes int64) *httpFetcher {
+	return &httpFetcher{client: s.httpClient, maxBytes: maxBytes, bandwidth: s.bandwidth, userAgent: s.userAgent}
+}
+
+// newRequest returns a request for rawURL with the fetcher's headers.
+func (f *httpFetcher) newRequest(ctx context.Context, method, rawURL string) (*http.Request, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if f.userAgent != "" {
+		httpReq.Header.Set("User-Agent", f.userAgent)
+	}
+	return httpReq, nil
+}
+
+func (f *httpFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	// The connection the response came over, after any redirects, is the
+	// last one got.
+	var egressIP string
+	trace := &httptrace.ClientTrace{GotConn: func(info httptrace.GotConnInfo) {
+		if addr, ok := info.Conn.LocalAddr().(*net.TCPAddr); ok {
+			egressIP = addr.IP.String()
+		}
+	}}
+	httpReq, err := f.newRequest(httptrace.WithClientTrace(ctx, trace), http.MethodGet, rawURL)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid URL %s: %v", rawURL, err)
+	}
+	// Asking for gzip ourselves, rather than leaving it to the transport,
+	// keeps the bandwidth limits counting the bytes sent over the network.
+	httpReq.Header.Set("Accept-Encoding", "gzip")
+	client, pool, identity := f.clientFor(ctx, rawURL)
+	if opts.MaxRedirects != nil {
+		client = withRedirectLimit(client, int(opts.GetMaxRedirects()))
+	}
+	httpResp, err := client.Do(httpReq)
+	if errors.Is(err, errTooManyRedirects) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s redirected more than %d times", rawURL, opts.GetMaxRedirects())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to download %s: %v", rawURL, err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, status.Errorf(codes.Unavailable, "failed to download %s: HTTP %d", rawURL, httpResp.StatusCode)
+	}
+	if httpResp.ContentLength > f.maxBytes {
+		return nil, status.Errorf(codes.ResourceExhausted, "%s is %d bytes, exceeding the limit of %d", rawURL, httpResp.ContentLength, f.maxBytes)
+	}
+	var r io.Reader = f.bandwidth.reader(ctx, hostOf(rawURL), httpResp.Body)
+	switch encoding := httpResp.Header.Get("Content-Encoding"); encoding {
+	case "", "identity":
+	case "gzip", "x-gzip":
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to download %s: %v", rawURL, err)
+		}
+		defer gz.Close()
+		r = gz
+	default:
+		return nil, status.Errorf(codes.Unavailable, "failed to download %s: unsupported Content-Encoding %q", rawURL, encoding)
+	}
+	body, err := io.ReadAll(io.LimitReader(r, f.maxBytes+1))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to download %s: %v", rawURL, err)
+	}
+	if int64(len(body)) > f.maxBytes {
+		return nil, status.Errorf(codes.ResourceExhausted, "%s exceeds the limit of %d bytes", rawURL, f.maxBytes)
+	}
+	receipt := egressReceipt(fetcherHTTP, pool, identity)
+	if identity == nil || identity.proxy == nil {
+		receipt.EgressIP = egressIP // Not the proxy's
+	}
+	return &FetchResult{
+		Content:       body,
+		RedirectChain: httpRedirectChain(httpResp),
+		ContentType:   httpResp.Header.Get("Content-Type"),
+		TLS:           tlsInfoFromConn(httpResp.TLS),
+		Receipt:       receipt,
+	}, nil
+}
+
+// clientFor returns the client to fetch rawURL with: one leaving through an
+// identity of the egress pool of ctx, if it has one.
+func (f *httpFetcher) clientFor(ctx context.Context, rawURL string) (*http.Client, *egressPool, *egressIdentity) {
+	pool := egressFrom(ctx)
+	if pool == nil {
+		return f.client, nil, nil
+	}
+	identity := pool.pick(hostOf(rawURL), false)
+	return identity.client(f.client), pool, identity
+}
+
+// withRedirectLimit returns a client like c that fails with
+// errTooManyRedirects past limit redirects.
+func withRedirectLimit(c *http.Client, limit int) *http.Client {
+	limited := *c
+	limited.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) > limit {
+			return fmt.Errorf("%w (%d)", errTooManyRedirects, limit)
+		}
+		if c.CheckRedirect != nil {
+			return c.CheckRedirect(req, via)
+		}
+		return nil
+	}
+	return &limited
+}
+
+// httpRedirectChain returns the documents an HTTP client loaded on its way
+// to a response, ending with the response itself.
+func httpRedirectChain(httpResp *http.Response) []RedirectHop {
+	var chain []RedirectHop
+	for r := httpResp; r != nil; r = r.Request.Response {
+		chain = append(chain, RedirectHop{URL: r.Request.URL.String(), StatusCode: r.StatusCode})
+	}
+	slices.Reverse(chain)
+	return chain
+}
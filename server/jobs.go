@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// jobRegistry tracks the fetches and warms in progress, so operators can
+// list and cancel them.  Crawls are tracked by crawlRegistry.
+type jobRegistry struct {
+	mu   sync.Mutex
+	next int64
+	jobs map[string]*job
+}
+
+// job is a fetch or warm in progress.
+type job struct {
+	id          string
+	kind        pb.JobKind
+	description string
+	tenant      string
+	caller      string
+	started     time.Time
+	cancel      context.CancelFunc
+	progress    func() (queued, done, failed int64) // nil if the job has no progress to report
+}
+
+// startJob registers a job, returning the context to run it with, which
+// CancelJob cancels, and a function to call once it is over.
+func (s *Server) startJob(ctx context.Context, kind pb.JobKind, description, tenant string, progress func() (int64, int64, int64)) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	j := &job{
+		kind:        kind,
+		description: description,
+		tenant:      tenant,
+		caller:      callerOf(ctx),
+		started:     time.Now(),
+		cancel:      cancel,
+		progress:    progress,
+	}
+	r := &s.jobs
+	r.mu.Lock()
+	r.next++
+	j.id = fmt.Sprintf("%s-%d", strings.ToLower(strings.TrimPrefix(kind.String(), "JOB_KIND_")), r.next)
+	if r.jobs == nil {
+		r.jobs = make(map[string]*job)
+	}
+	r.jobs[j.id] = j
+	r.mu.Unlock()
+	return context.WithValue(ctx, jobIDKey{}, j.id), func() {
+		cancel()
+		r.mu.Lock()
+		delete(r.jobs, j.id)
+		r.mu.Unlock()
+	}
+}
+
+// jobIDKey is the context key of the ID of the job a context runs.
+type jobIDKey struct{}
+
+// jobIDOf returns the ID of the job ctx runs, or "" if it isn't a job's.
+func jobIDOf(ctx context.Context) string {
+	id, _ := ctx.Value(jobIDKey{}).(string)
+	return id
+}
+
+func (j *job) proto() *pb.Job {
+	p := &pb.Job{
+		JobId:       j.id,
+		Kind:        j.kind,
+		Description: j.description,
+		Tenant:      j.tenant,
+		Caller:      j.caller,
+		StartedAt:   timestamppb.New(j.started),
+	}
+	if j.progress != nil {
+		p.Queued, p.Done, p.Failed = j.progress()
+	}
+	return p
+}
+
+// crawlJobProto reports a running crawl as a job, or nil if it isn't
+// running.
+func crawlJobProto(c *crawlJob) *pb.Job {
+	st := c.status()
+	if st.GetState() != pb.CrawlState_CRAWL_STATE_RUNNING {
+		return nil
+	}
+	return &pb.Job{
+		JobId:       st.GetCrawlId(),
+		Kind:        pb.JobKind_JOB_KIND_CRAWL,
+		Description: st.GetUrl(),
+		Tenant:      st.GetTenant(),
+		StartedAt:   st.GetStartedAt(),
+		Queued:      st.GetPagesQueued(),
+		Done:        st.GetPagesDone(),
+		Failed:      st.GetPagesFailed(),
+	}
+}
+
+// ListJobs handles the gRPC request.
+func (s *Server) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	kind := req.GetKind()
+	if _, ok := pb.JobKind_name[int32(kind)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown kind %d", kind)
+	}
+	resp := &pb.ListJobsResponse{}
+	s.jobs.mu.Lock()
+	jobs := make([]*job, 0, len(s.jobs.jobs))
+	for _, j := range s.jobs.jobs {
+		if kind == pb.JobKind_JOB_KIND_UNSPECIFIED || j.kind == kind {
+			jobs = append(jobs, j)
+		}
+	}
+	s.jobs.mu.Unlock()
+	for _, j := range jobs {
+		resp.Jobs = append(resp.Jobs, j.proto())
+	}
+
+	if kind == pb.JobKind_JOB_KIND_UNSPECIFIED || kind == pb.JobKind_JOB_KIND_CRAWL {
+		s.crawls.mu.Lock()
+		crawls := make([]*crawlJob, 0, len(s.crawls.jobs))
+		for _, c := range s.crawls.jobs {
+			crawls = append(crawls, c)
+		}
+		s.crawls.mu.Unlock()
+		for _, c := range crawls {
+			if j := crawlJobProto(c); j != nil {
+				resp.Jobs = append(resp.Jobs, j)
+			}
+		}
+	}
+	sort.SliceStable(resp.Jobs, func(i, j int) bool {
+		return resp.Jobs[i].GetStartedAt().AsTime().Before(resp.Jobs[j].GetStartedAt().AsTime())
+	})
+	return resp, nil
+}
+
+// CancelJob handles the gRPC request.
+func (s *Server) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.CancelJobResponse, error) {
+	s.jobs.mu.Lock()
+	j, ok := s.jobs.jobs[req.GetJobId()]
+	s.jobs.mu.Unlock()
+	if ok {
+		j.cancel()
+		s.logger.Printf("Canceled job %s: %s", j.id, j.description)
+		return &pb.CancelJobResponse{Job: j.proto()}, nil
+	}
+
+	s.crawls.mu.Lock()
+	c, ok := s.crawls.jobs[req.GetJobId()]
+	s.crawls.mu.Unlock()
+	var crawl *pb.Job
+	if ok {
+		crawl = crawlJobProto(c)
+	}
+	if crawl == nil {
+		return nil, status.Errorf(codes.NotFound, "no job %q", req.GetJobId())
+	}
+	if _, err := s.PauseCrawl(ctx, &pb.PauseCrawlRequest{CrawlId: req.GetJobId()}); err != nil {
+		return nil, err
+	}
+	return &pb.CancelJobResponse{Job: crawl}, nil
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keyScheme selects how URLs are turned into cache keys (file names).
+type keyScheme string
+
+const (
+	// keySchemeEscaped uses the path-escaped URL.  Keys are readable, but long
+	// URLs can exceed the filesystem's file name limit.
+	keySchemeEscaped keyScheme = "escaped"
+	// keySchemeSHA256 uses the hex SHA-256 of the URL.  The URL itself is
+	// kept in the entry's metadata.
+	keySchemeSHA256 keyScheme = "sha256"
+)
+
+// maxShardDepth bounds how many directory levels entries can be spread over.
+const maxShardDepth = 4
+
+// cacheLayout describes where entries live in the cache.  With a
+// shard depth of N, each entry is placed N directories deep, in directories
+// named after successive byte pairs of the SHA-256 of its key, so no single
+// directory grows too large.
+type cacheLayout struct {
+	keyScheme  keyScheme
+	shardDepth int
+}
+
+// parseLayout validates a key scheme and shard depth.
+func parseLayout(scheme string, shardDepth int) (cacheLayout, error) {
+	switch keyScheme(scheme) {
+	case keySchemeEscaped, keySchemeSHA256:
+	default:
+		return cacheLayout{}, fmt.Errorf("unknown key scheme %q (want escaped or sha256)", scheme)
+	}
+	if shardDepth < 0 || shardDepth > maxShardDepth {
+		return cacheLayout{}, fmt.Errorf("shard depth must be between 0 and %d", maxShardDepth)
+	}
+	return cacheLayout{keyScheme: keyScheme(scheme), shardDepth: shardDepth}, nil
+}
+
+// key returns the cache key for a URL.
+func (l cacheLayout) key(rawURL string) string {
+	if l.keyScheme == keySchemeSHA256 {
+		sum := sha256.Sum256([]byte(rawURL))
+		return hex.EncodeToString(sum[:])
+	}
+	return sanitizeURLForFilename(rawURL)
+}
+
+// tenantKey returns the cache key for a URL fetched for a tenant.  Each
+// tenant's entries are keyed apart from everyone else's; an empty tenant
+// gives the shared key.
+func (l cacheLayout) tenantKey(tenant, rawURL string) string {
+	if tenant == "" {
+		return l.key(rawURL)
+	}
+	// Requests only take http and https URLs (see validateURL), so this
+	// can't collide with a shared key.
+	return l.key("tenant/" + tenant + "/" + rawURL)
+}
+
+// validateURL checks a requested URL before it is keyed.  Only http and
+// https URLs are taken, so a shared key can't name a tenant's entry.
+func validateURL(rawURL string) error {
+	if rawURL == "" {
+		return status.Errorf(codes.InvalidArgument, "URL cannot be empty")
+	}
+	if !isHTTP(rawURL) {
+		return status.Errorf(codes.InvalidArgument, "URL must be http or https")
+	}
+	return nil
+}
+
+// relPath returns the name of a key relative to the cache (or metadata) directory.
+func (l cacheLayout) relPath(cacheKey string) string {
+	if l.shardDepth == 0 {
+		return cacheKey
+	}
+	sum := sha256.Sum256([]byte(cacheKey))
+	prefix := hex.EncodeToString(sum[:l.shardDepth])
+	parts := make([]string, 0, l.shardDepth+1)
+	for i := 0; i < len(prefix); i += 2 {
+		parts = append(parts, prefix[i:i+2])
+	}
+	return path.Join(append(parts, cacheKey)...)
+}
+
+// entryName returns the Storage name of the content file for a key.
+func (l cacheLayout) entryName(cacheKey string) string {
+	return l.relPath(cacheKey)
+}
+
+// metadataName returns the Storage name of the metadata sidecar for a key.
+func (l cacheLayout) metadataName(cacheKey string) string {
+	return path.Join(metadataSubdir, l.relPath(cacheKey)+".json")
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetLegalHold handles the gRPC request.
+func (s *Server) SetLegalHold(ctx context.Context, req *pb.SetLegalHoldRequest) (*pb.SetLegalHoldResponse, error) {
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := validateTenant(req.GetTenant()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	cacheKey := s.resolveAlias(s.layout.tenantKey(req.GetTenant(), req.GetUrl()))
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "%s is not cached", req.GetUrl())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read metadata for %s: %v", req.GetUrl(), err)
+	}
+
+	was := md.LegalHold
+	if was != req.GetHold() {
+		md.LegalHold = req.GetHold()
+		if err := s.writeMetadata(cacheKey, md); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write metadata for %s: %v", req.GetUrl(), err)
+		}
+		s.logger.Printf("Legal hold on %s changed from %v to %v", md.URL, was, md.LegalHold)
+	}
+	return &pb.SetLegalHoldResponse{Hold: md.LegalHold, WasHeld: was, FetchedAt: timestamppb.New(md.FetchedAt)}, nil
+}
+
+// held reports whether the entry under a cache key is under legal hold.
+func (s *Server) held(cacheKey string) bool {
+	md, err := s.readMetadata(cacheKey)
+	return err == nil && md.LegalHold
+}
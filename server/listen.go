@@ -0,0 +1,157 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Listener address schemes.  An address without one is a TCP host:port,
+// which with an empty host or "::" accepts both IPv4 and IPv6.
+var listenNetworks = map[string]string{
+	"tcp":  "tcp",
+	"tcp4": "tcp4", // IPv4 only
+	"tcp6": "tcp6", // IPv6 only
+	"unix": "unix", // A socket file path, e.g. unix:///run/downloadcache/grpc.sock
+}
+
+// defaultUnixSocketMode lets the socket's owner and group connect.
+const defaultUnixSocketMode = 0o660
+
+// parseListenAddr splits a listener address such as "tcp6://[::1]:50051"
+// into a network and address for net.Listen.
+func parseListenAddr(addr string) (network, address string, err error) {
+	network, address = "tcp", addr
+	if scheme, rest, ok := strings.Cut(addr, "://"); ok {
+		if network, ok = listenNetworks[scheme]; !ok {
+			return "", "", fmt.Errorf("invalid listen address %q: unknown scheme %q", addr, scheme)
+		}
+		address = rest
+	}
+	if network == "unix" {
+		if address == "" {
+			return "", "", fmt.Errorf("invalid listen address %q: no socket path", addr)
+		}
+		return network, address, nil
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return "", "", fmt.Errorf("invalid listen address %q: %w", addr, err)
+	}
+	return network, address, nil
+}
+
+// listenAddrs returns the gRPC listener addresses: Listen if set, otherwise
+// Port on every interface.
+func (cfg Config) listenAddrs() []string {
+	if len(cfg.Listen) > 0 {
+		return cfg.Listen
+	}
+	return []string{":" + cfg.Port}
+}
+
+// portAddrs returns the addresses for the HTTP gateway or metrics listener
+// on port: the TCP Listen addresses with their port replaced, so they bind
+// the same interfaces as gRPC, or port on every interface without Listen.
+// Unix sockets are skipped.
+func (cfg Config) portAddrs(port string) []string {
+	if len(cfg.Listen) == 0 {
+		return []string{":" + port}
+	}
+	var addrs []string
+	for _, addr := range cfg.Listen {
+		network, address, err := parseListenAddr(addr)
+		if err != nil || network == "unix" {
+			continue
+		}
+		host, _, _ := net.SplitHostPort(address)
+		addr = network + "://" + net.JoinHostPort(host, port)
+		if !slices.Contains(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// Listen opens the configured gRPC listeners, to pass to grpc.Server.Serve.
+// If one fails, those already opened are closed.
+func Listen(cfg Config) ([]net.Listener, error) {
+	return listen(cfg, cfg.listenAddrs())
+}
+
+// ListenPort opens the listeners for the HTTP gateway or metrics on port,
+// on the interfaces the gRPC listeners bind; see Listen.
+func ListenPort(cfg Config, port string) ([]net.Listener, error) {
+	return listen(cfg, cfg.portAddrs(port))
+}
+
+func listen(cfg Config, addrs []string) ([]net.Listener, error) {
+	var listeners []net.Listener
+	for _, addr := range addrs {
+		network, address, err := parseListenAddr(addr)
+		if err == nil {
+			var lis net.Listener
+			if network == "unix" {
+				lis, err = listenUnix(address, cfg.UnixSocketMode, cfg.UnixSocketGroup)
+			} else {
+				lis, err = net.Listen(network, address)
+			}
+			if err == nil {
+				listeners = append(listeners, lis)
+				continue
+			}
+		}
+		for _, lis := range listeners {
+			lis.Close()
+		}
+		return nil, err
+	}
+	return listeners, nil
+}
+
+// listenUnix listens on a Unix socket at path, readable and writable by
+// mode and, if group is set, owned by that group.  A socket left behind by a
+// server that didn't shut down cleanly is replaced; one still in use is an
+// error.
+func listenUnix(path string, mode os.FileMode, group string) (net.Listener, error) {
+	if fi, err := os.Lstat(path); err == nil {
+		if fi.Mode().Type() != os.ModeSocket {
+			return nil, fmt.Errorf("failed to listen on %s: file exists and is not a socket", path)
+		}
+		if conn, err := net.Dial("unix", path); err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to listen on %s: socket is in use", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	gid := -1
+	if group != "" {
+		g, err := user.LookupGroup(group)
+		if err != nil {
+			return nil, err
+		}
+		if gid, err = strconv.Atoi(g.Gid); err != nil {
+			return nil, fmt.Errorf("group %s has a non-numeric gid %q", group, g.Gid)
+		}
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	if gid >= 0 {
+		if err := os.Chown(path, -1, gid); err != nil {
+			lis.Close()
+			return nil, err
+		}
+	}
+	return lis, nil
+}
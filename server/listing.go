@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultListPageSize = 100
+	maxListPageSize     = 1000
+)
+
+// errPageFull stops a walk once a page of results has been collected.
+var errPageFull = errors.New("page full")
+
+// ListEntries handles the gRPC request.
+func (s *Server) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	if req.GetPageSize() < 0 || req.GetPageSize() > maxListPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", maxListPageSize)
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultListPageSize
+	}
+
+	// The page token is the metadata name, relative to the metadata
+	// directory, of the last entry returned.  Storage walks files in a
+	// stable order, so we resume just after it.
+	after := req.GetPageToken()
+	resp := &pb.ListEntriesResponse{}
+	err := s.storage.Walk(metadataSubdir, func(name string, info FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(name, metadataSubdir), "/")
+		if info.IsDir {
+			// Skip directories that sort entirely before the token.
+			if after != "" && rel != "" && pathLess(rel, after) && !strings.HasPrefix(after, rel+"/") {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if after != "" && !pathLess(after, rel) {
+			return nil
+		}
+		if strings.HasSuffix(name, partialSuffix) {
+			return nil
+		}
+		md, err := readMetadataFile(s.storage, name)
+		if err != nil || !strings.HasPrefix(md.URL, req.GetUrlPrefix()) || !md.annotated(req.GetAnnotations()) {
+			return nil
+		}
+		if before := req.GetCertificateExpiresBefore(); before != nil && !md.TLS.expiresBefore(before.AsTime()) {
+			return nil
+		}
+
+		if len(resp.Entries) == pageSize {
+			return errPageFull
+		}
+		cacheKey := strings.TrimSuffix(path.Base(name), ".json")
+		entry := s.cacheEntryProto(cacheKey, md)
+		if req.GetIncludeThumbnails() {
+			s.addThumbnail(ctx, entry, md)
+		}
+		resp.Entries = append(resp.Entries, entry)
+		resp.NextPageToken = rel
+		return nil
+	})
+	switch {
+	case errors.Is(err, errPageFull):
+	case err == nil:
+		resp.NextPageToken = "" // Reached the end.
+	default:
+		s.logger.Printf("Error: failed to list entries: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %v", err)
+	}
+	return resp, nil
+}
+
+// GetMetadata handles the gRPC request.
+func (s *Server) GetMetadata(ctx context.Context, req *pb.GetMetadataRequest) (*pb.GetMetadataResponse, error) {
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return nil, err
+	}
+	cacheKey := s.resolveAlias(s.layout.tenantKey(req.GetTenant(), req.GetUrl()))
+	md, err := s.readMetadata(cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "%s is not cached", req.GetUrl())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read metadata for %s: %v", req.GetUrl(), err)
+	}
+	entry := s.cacheEntryProto(cacheKey, md)
+	s.addThumbnail(ctx, entry, md)
+	return &pb.GetMetadataResponse{Entry: entry}, nil
+}
+
+// addThumbnail adds an entry's thumbnail, if it has one, to its listing.
+// Failures are logged; the entry is listed without one.
+func (s *Server) addThumbnail(ctx context.Context, entry *pb.CacheEntry, md *entryMetadata) {
+	if !md.Thumbnail {
+		return
+	}
+	jpg, err := s.readThumbnail(ctx, entry.GetCacheKey(), md)
+	if err != nil {
+		s.logger.Printf("Warning: failed to read the thumbnail of %s: %v", md.URL, err)
+		return
+	}
+	entry.Thumbnail = jpg
+}
+
+// annotated reports whether an entry has all the given annotations.
+func (md *entryMetadata) annotated(annotations map[string]string) bool {
+	for k, v := range annotations {
+		if got, ok := md.Annotations[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// cacheEntryProto describes an entry for listings.
+func (s *Server) cacheEntryProto(cacheKey string, md *entryMetadata) *pb.CacheEntry {
+	entry := &pb.CacheEntry{
+		Url:          md.URL,
+		CacheKey:     cacheKey,
+		FetchedAt:    timestamppb.New(md.FetchedAt),
+		CanonicalUrl: md.CanonicalURL,
+		AliasOf:      md.AliasOf,
+		Cold:         md.Cold,
+		LegalHold:    md.LegalHold,
+		Annotations:  md.Annotations,
+		WebVitals:    md.WebVitals.proto(),
+		Tls:          md.TLS.proto(),
+		Receipt:      md.Receipt.proto(),
+	}
+	if !md.LastAccessedAt.IsZero() {
+		entry.LastAccessedAt = timestamppb.New(md.LastAccessedAt)
+	}
+	if md.TTL > 0 {
+		entry.Ttl = durationpb.New(md.TTL)
+	}
+	if md.hasLocalContent() {
+		entry.Codec = string(md.codec())
+		if info, err := s.storage.Stat(s.contentName(cacheKey)); err == nil {
+			entry.SizeBytes = info.Size
+		}
+	} else if md.Cold {
+		entry.Codec = string(md.codec())
+	}
+	return entry
+}
+
+// pathLess orders slash-separated names the way Storage.Walk visits them:
+// component by component.
+func pathLess(a, b string) bool {
+	as := strings.Split(a, "/")
+	bs := strings.Split(b, "/")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] != bs[i] {
+			return as[i] < bs[i]
+		}
+	}
+	return len(as) < len(bs)
+}
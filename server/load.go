@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+)
+
+// loadWindow is how far back peak demand is remembered, in minutes.  Scaling
+// on the recent peak rather than the instantaneous value keeps an autoscaler
+// from removing capacity between bursts.
+const loadWindow = 5
+
+// renderLoad tracks how many downloads want a browser session.
+type renderLoad struct {
+	mu             sync.Mutex
+	queued, active int
+	peaks          [loadWindow]loadBucket
+}
+
+// loadBucket holds the peak demand seen during one minute.
+type loadBucket struct {
+	minute int64
+	peak   int
+}
+
+// update adjusts the queued and active counts.
+func (l *renderLoad) update(queuedDelta, activeDelta int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.queued += queuedDelta
+	l.active += activeDelta
+
+	minute := time.Now().Unix() / 60
+	b := &l.peaks[minute%loadWindow]
+	if b.minute != minute {
+		*b = loadBucket{minute: minute}
+	}
+	b.peak = max(b.peak, l.queued+l.active)
+}
+
+// snapshot returns the current counts and the peak demand over the window.
+func (l *renderLoad) snapshot() (queued, active, peak int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	minute := time.Now().Unix() / 60
+	peak = l.queued + l.active
+	for _, b := range l.peaks {
+		if minute-b.minute < loadWindow {
+			peak = max(peak, b.peak)
+		}
+	}
+	return l.queued, l.active, peak
+}
+
+// GetRenderLoad handles the gRPC request.
+func (s *Server) GetRenderLoad(ctx context.Context, req *pb.GetRenderLoadRequest) (*pb.GetRenderLoadResponse, error) {
+	queued, active, peak := s.load.snapshot()
+	return &pb.GetRenderLoadResponse{
+		QueuedSessions:  int32(queued),
+		ActiveSessions:  int32(active),
+		PeakDemand:      int32(peak),
+		DesiredSessions: int32(math.Ceil(float64(peak) * s.autoscaleHeadroom)),
+		WindowSeconds:   loadWindow * 60,
+	}, nil
+}
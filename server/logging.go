@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// levelLogger filters the server's log output by severity, which is taken
+// from the prefix log messages already carry: "Error: ", "Warning: ", or
+// neither for informational messages.  The level and request sampling can
+// be changed at runtime with SetLogLevel.
+type levelLogger struct {
+	out         *log.Logger
+	level       atomic.Int32 // A pb.LogLevel; messages below it are dropped
+	sampleEvery atomic.Int64 // Log the informational messages of 1 in this many requests
+	requests    atomic.Uint64
+	redact      *redactor // Applied to every message; nil logs them as is
+}
+
+func newLevelLogger(out *log.Logger) *levelLogger {
+	l := &levelLogger{out: out}
+	l.level.Store(int32(pb.LogLevel_LOG_LEVEL_INFO))
+	l.sampleEvery.Store(1)
+	return l
+}
+
+// parseLogLevel parses a level name such as "warning".
+func parseLogLevel(name string) (pb.LogLevel, error) {
+	level, ok := pb.LogLevel_value["LOG_LEVEL_"+strings.ToUpper(name)]
+	if !ok || level == int32(pb.LogLevel_LOG_LEVEL_UNSPECIFIED) {
+		return 0, fmt.Errorf("unknown log level %q (want info, warning or error)", name)
+	}
+	return pb.LogLevel(level), nil
+}
+
+// Printf logs a message if its severity is at or above the current level.
+func (l *levelLogger) Printf(format string, args ...any) {
+	if messageLevel(format) < pb.LogLevel(l.level.Load()) {
+		return
+	}
+	if l.redact == nil {
+		l.out.Printf(format, args...)
+		return
+	}
+	l.out.Print(string(l.redact.redact([]byte(fmt.Sprintf(format, args...)))))
+}
+
+// Requestf logs a message about a request.  Informational messages are only
+// logged for the requests picked by sampleRequest.
+func (l *levelLogger) Requestf(ctx context.Context, format string, args ...any) {
+	if messageLevel(format) == pb.LogLevel_LOG_LEVEL_INFO && ctx.Value(unsampledKey{}) != nil {
+		return
+	}
+	l.Printf(format, args...)
+}
+
+type unsampledKey struct{}
+
+// sampleRequest decides whether a new request's informational messages are
+// logged, recording the decision in the returned context.
+func (l *levelLogger) sampleRequest(ctx context.Context) context.Context {
+	every := uint64(l.sampleEvery.Load())
+	if every <= 1 || l.requests.Add(1)%every == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, unsampledKey{}, true)
+}
+
+func messageLevel(format string) pb.LogLevel {
+	switch {
+	case strings.HasPrefix(format, "Error:"):
+		return pb.LogLevel_LOG_LEVEL_ERROR
+	case strings.HasPrefix(format, "Warning:"):
+		return pb.LogLevel_LOG_LEVEL_WARNING
+	default:
+		return pb.LogLevel_LOG_LEVEL_INFO
+	}
+}
+
+// SetLogLevel handles the gRPC request.
+func (s *Server) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
+	if req.GetSampleEvery() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "sample_every must not be negative")
+	}
+	if _, ok := pb.LogLevel_name[int32(req.GetLevel())]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown log level %d", req.GetLevel())
+	}
+
+	if req.GetLevel() != pb.LogLevel_LOG_LEVEL_UNSPECIFIED {
+		s.logger.level.Store(int32(req.GetLevel()))
+	}
+	if req.GetSampleEvery() > 0 {
+		s.logger.sampleEvery.Store(int64(req.GetSampleEvery()))
+	}
+	resp := &pb.SetLogLevelResponse{
+		Level:       pb.LogLevel(s.logger.level.Load()),
+		SampleEvery: int32(s.logger.sampleEvery.Load()),
+	}
+	// Logged regardless of the level, so the change itself is on record.
+	s.logger.out.Printf("Log level set to %v, logging 1 in %d requests", resp.GetLevel(), resp.GetSampleEvery())
+	return resp, nil
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	defaultMaintenanceRetryAfter = time.Minute
+	drainPollInterval            = 100 * time.Millisecond
+)
+
+// maintenanceGate tracks the work that writes to storage (fetches, sitemap
+// downloads, access times, thawing, restores and background passes), so that
+// maintenance mode can turn new work away and wait for the rest to finish.
+type maintenanceGate struct {
+	mu         sync.Mutex
+	on         bool
+	retryAfter time.Duration
+	inFlight   int
+}
+
+// enter registers a unit of work, returning a function to call when it is
+// done.  In maintenance mode it returns an Unavailable error carrying a
+// RetryInfo detail instead.
+func (g *maintenanceGate) enter() (func(), error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.on {
+		st, err := status.New(codes.Unavailable, "server is in maintenance mode").
+			WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(g.retryAfter)})
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "server is in maintenance mode")
+		}
+		return nil, st.Err()
+	}
+	g.inFlight++
+	return func() {
+		g.mu.Lock()
+		g.inFlight--
+		g.mu.Unlock()
+	}, nil
+}
+
+// set switches maintenance mode, returning the previous mode.
+func (g *maintenanceGate) set(on bool, retryAfter time.Duration) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	was := g.on
+	g.on, g.retryAfter = on, retryAfter
+	return was
+}
+
+func (g *maintenanceGate) pending() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.inFlight
+}
+
+// drain waits until no work is in flight or ctx is done, returning the
+// amount of work still in flight.
+func (g *maintenanceGate) drain(ctx context.Context) int {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		n := g.pending()
+		if n == 0 {
+			return 0
+		}
+		select {
+		case <-ctx.Done():
+			return n
+		case <-ticker.C:
+		}
+	}
+}
+
+// SetMaintenanceMode handles the gRPC request.
+func (s *Server) SetMaintenanceMode(ctx context.Context, req *pb.SetMaintenanceModeRequest) (*pb.SetMaintenanceModeResponse, error) {
+	if req.GetRetryAfterSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retry_after_seconds must not be negative")
+	}
+	retryAfter := time.Duration(req.GetRetryAfterSeconds()) * time.Second
+	if retryAfter == 0 {
+		retryAfter = defaultMaintenanceRetryAfter
+	}
+
+	was := s.maintenance.set(req.GetMaintenance(), retryAfter)
+	if was != req.GetMaintenance() {
+		s.logger.Printf("Maintenance mode changed from %v to %v", was, req.GetMaintenance())
+		s.setServing(!req.GetMaintenance())
+	}
+	resp := &pb.SetMaintenanceModeResponse{Maintenance: req.GetMaintenance(), WasInMaintenance: was}
+	if req.GetMaintenance() {
+		resp.InFlight = int32(s.maintenance.drain(ctx))
+		if resp.InFlight > 0 {
+			s.logger.Printf("Warning: entered maintenance mode with %d fetches or writes still in flight", resp.InFlight)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrStorageFull is returned by a write that would take memory storage past
+// its size cap.
+var ErrStorageFull = errors.New("storage full")
+
+// memStorage keeps the cache in memory, for tests and ephemeral jobs that
+// shouldn't need a writable disk or leave files behind.  Directories exist
+// implicitly while they contain a file.
+type memStorage struct {
+	maxBytes int64 // Zero means unlimited
+
+	mu    sync.Mutex
+	files map[string]memFile
+	size  int64
+}
+
+type memFile struct {
+	data    []byte
+	modTime time.Time
+}
+
+// NewMemoryStorage returns empty Storage held in memory.  If maxBytes is
+// positive, writes that would grow the total size of the files past it fail
+// with ErrStorageFull.
+func NewMemoryStorage(maxBytes int64) Storage {
+	return &memStorage{maxBytes: maxBytes, files: make(map[string]memFile)}
+}
+
+func (m *memStorage) Read(name string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, ok := m.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+	return append([]byte(nil), f.data...), nil
+}
+
+func (m *memStorage) Write(name string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	size := m.size - int64(len(m.files[name].data)) + int64(len(data))
+	if m.maxBytes > 0 && size > m.maxBytes {
+		return fmt.Errorf("failed to write %s: %w (limit %d bytes)", name, ErrStorageFull, m.maxBytes)
+	}
+	m.files[name] = memFile{data: append([]byte(nil), data...), modTime: time.Now()}
+	m.size = size
+	return nil
+}
+
+func (m *memStorage) Remove(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if f, ok := m.files[name]; ok {
+		m.size -= int64(len(f.data))
+		delete(m.files, name)
+	}
+	return nil
+}
+
+func (m *memStorage) Stat(name string) (FileInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if f, ok := m.files[name]; ok {
+		return FileInfo{Size: int64(len(f.data)), ModTime: f.modTime}, nil
+	}
+	for n := range m.files {
+		if strings.HasPrefix(n, name+"/") {
+			return FileInfo{IsDir: true}, nil
+		}
+	}
+	return FileInfo{}, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+}
+
+func (m *memStorage) Walk(dir string, fn func(name string, info FileInfo) error) error {
+	// Snapshot the tree so fn can modify the storage as it goes.
+	entries := make(map[string]FileInfo)
+	m.mu.Lock()
+	for name, f := range m.files {
+		if dir != "" && !strings.HasPrefix(name, dir+"/") {
+			continue
+		}
+		entries[name] = FileInfo{Size: int64(len(f.data)), ModTime: f.modTime}
+		for d := path.Dir(name); d != "." && d != dir; d = path.Dir(d) {
+			entries[d] = FileInfo{IsDir: true}
+		}
+	}
+	m.mu.Unlock()
+	if len(entries) == 0 {
+		return nil // Missing or empty.
+	}
+	entries[dir] = FileInfo{IsDir: true}
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	// Comparing with the separators replaced by the lowest byte orders names
+	// component by component, the order filepath.WalkDir uses.
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ReplaceAll(names[i], "/", "\x00") < strings.ReplaceAll(names[j], "/", "\x00")
+	})
+
+	skip := "" // Prefix of the directory being skipped, if any.
+	for _, name := range names {
+		if skip != "" && strings.HasPrefix(name, skip) {
+			continue
+		}
+		skip = ""
+		info := entries[name]
+		err := fn(name, info)
+		switch {
+		case errors.Is(err, fs.SkipAll):
+			return nil
+		case errors.Is(err, fs.SkipDir) && info.IsDir:
+			if name == dir {
+				return nil
+			}
+			skip = name + "/"
+		case errors.Is(err, fs.SkipDir):
+			// Skip the rest of the file's directory.
+			if parent := path.Dir(name); parent != "." && parent != dir {
+				skip = parent + "/"
+			} else {
+				return nil
+			}
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package server
+
+import (
+	"encoding/json"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// metadataSubdir holds one JSON sidecar per cache entry, named after the cache key.
+const metadataSubdir = ".meta"
+
+// entryMetadata records how and when a cache entry was fetched.
+type entryMetadata struct {
+	URL       string    `json:"url"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Codec     codec     `json:"codec,omitempty"`
+	// Dict is the ID of the zstd dictionary the content was compressed
+	// with, if any; see zstdDicts.
+	Dict          uint32        `json:"dict,omitempty"`
+	RedirectChain []RedirectHop `json:"redirect_chain,omitempty"`
+	// ContentType is the Content-Type the page was served with, if known.
+	ContentType string `json:"content_type,omitempty"`
+	// CanonicalURL is the page's rel=canonical URL, when the entry is stored under it.
+	CanonicalURL string `json:"canonical_url,omitempty"`
+	// AliasOf is set on alias records, which have no content of their own;
+	// the content lives in the entry for this URL.
+	AliasOf string `json:"alias_of,omitempty"`
+	// LastAccessedAt is updated on cache hits, at most once per accessResolution.
+	LastAccessedAt time.Time `json:"last_accessed_at"`
+	// Cold is set while the entry's content lives in cold storage.
+	Cold bool `json:"cold,omitempty"`
+	// Tenant is set on entries fetched for a tenant, whose content is
+	// encrypted with the tenant's key.
+	Tenant string `json:"tenant,omitempty"`
+	// LegalHold protects the entry from deletion, expiry and replacement;
+	// see SetLegalHold.
+	LegalHold bool `json:"legal_hold,omitempty"`
+	// ArchivedAt and ArchiveURL are set on entries fetched from the web
+	// archive because the page's site failed: when the archive captured the
+	// page, if known, and its URL for the copy.
+	ArchivedAt time.Time `json:"archived_at,omitempty"`
+	ArchiveURL string    `json:"archive_url,omitempty"`
+	// ContentHash is the hex SHA-256 of the content as stored, before
+	// compression and encryption; see GetByHash.
+	ContentHash string `json:"content_hash,omitempty"`
+	// Annotations are the client's key-value pairs from the request that
+	// stored the entry; see CacheOptions.annotations.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// TTL is how long the entry stays fresh, learned from how often the
+	// page changes; see adaptiveTTL.  Zero uses the server's TTL.
+	TTL time.Duration `json:"ttl,omitempty"`
+	// AXTree is set if the page's accessibility tree was captured with it;
+	// see GetAccessibilityTree.
+	AXTree bool `json:"ax_tree,omitempty"`
+	// Thumbnail is set if a thumbnail of the page was taken with it.
+	Thumbnail bool `json:"thumbnail,omitempty"`
+	// WebVitals are how fast the page loaded when it was fetched, if
+	// measured.
+	WebVitals *WebVitals `json:"web_vitals,omitempty"`
+	// TLS is the connection the page was served over, if known.
+	TLS *TLSInfo `json:"tls,omitempty"`
+	// Receipt is how the page was fetched.
+	Receipt *FetchReceipt `json:"receipt,omitempty"`
+}
+
+// accessResolution bounds how often a hit rewrites an entry's metadata.
+const accessResolution = time.Hour
+
+// RedirectHop is one document the browser loaded on its way to the final page.
+type RedirectHop struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status_code"`
+}
+
+// readMetadata loads the metadata for a cache key.  Entries cached before
+// metadata was recorded return an error satisfying errors.Is(err, fs.ErrNotExist).
+func (s *Server) readMetadata(cacheKey string) (*entryMetadata, error) {
+	return readMetadataFile(s.storage, s.layout.metadataName(cacheKey))
+}
+
+// writeMetadata stores the metadata for a cache key.
+func (s *Server) writeMetadata(cacheKey string, md *entryMetadata) error {
+	return writeMetadataFile(s.storage, s.layout.metadataName(cacheKey), md)
+}
+
+func readMetadataFile(st Storage, name string) (*entryMetadata, error) {
+	data, err := st.Read(name)
+	if err != nil {
+		return nil, err
+	}
+	var md entryMetadata
+	if err := json.Unmarshal(data, &md); err != nil {
+		return nil, err
+	}
+	return &md, nil
+}
+
+func writeMetadataFile(st Storage, name string, md *entryMetadata) error {
+	data, err := json.Marshal(md)
+	if err != nil {
+		return err
+	}
+	return st.Write(name, data)
+}
+
+// resolveAlias returns the key holding the content for a cache key, following
+// a canonical alias if there is one.
+func (s *Server) resolveAlias(cacheKey string) string {
+	md, err := s.readMetadata(cacheKey)
+	if err != nil || md.AliasOf == "" {
+		return cacheKey
+	}
+	return s.layout.tenantKey(md.Tenant, md.AliasOf)
+}
+
+// writeAlias points a cache key at the entry for canonicalURL, removing any
+// content previously stored under the key itself.  The alias is written
+// first, so readers follow it rather than finding old metadata without its
+// content; content left behind by a failed removal is collected as garbage.
+func (s *Server) writeAlias(rawURL, cacheKey, canonicalURL, tenant string) error {
+	if err := s.writeMetadata(cacheKey, &entryMetadata{URL: rawURL, FetchedAt: time.Now(), AliasOf: canonicalURL, Tenant: tenant}); err != nil {
+		return err
+	}
+	return s.storage.Remove(s.layout.entryName(cacheKey))
+}
+
+// codec returns the codec the entry's content was stored with.  Entries
+// written before codecs were recorded are gzip.
+func (md *entryMetadata) codec() codec {
+	if md.Codec == "" {
+		return codecGzip
+	}
+	return md.Codec
+}
+
+// key returns the cache key the metadata is stored under in a layout.
+func (md *entryMetadata) key(l cacheLayout) string {
+	if md.AliasOf != "" {
+		return l.tenantKey(md.Tenant, md.URL)
+	}
+	return l.tenantKey(md.Tenant, md.contentURL())
+}
+
+// encrypted reports whether the entry's content is encrypted with its
+// tenant's key.
+func (md *entryMetadata) encrypted() bool {
+	return md.Tenant != ""
+}
+
+// hasLocalContent reports whether the entry has a content file in the cache.
+func (md *entryMetadata) hasLocalContent() bool {
+	return md.AliasOf == "" && !md.Cold
+}
+
+// lastAccess returns when the entry was last served or fetched.
+func (md *entryMetadata) lastAccess() time.Time {
+	if md.LastAccessedAt.After(md.FetchedAt) {
+		return md.LastAccessedAt
+	}
+	return md.FetchedAt
+}
+
+// touch records a cache hit on an entry.
+func (s *Server) touch(cacheKey string, md *entryMetadata) {
+	if md.URL == "" || time.Since(md.lastAccess()) < accessResolution {
+		return
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return // Access times can wait; cache hits are still served.
+	}
+	defer done()
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+	// Reread under the lock, so a concurrent change such as a legal hold isn't
+	// lost, and an entry removed since the hit isn't brought back.
+	md, err = s.readMetadata(cacheKey)
+	if err != nil {
+		return
+	}
+	md.LastAccessedAt = time.Now()
+	if err := s.writeMetadata(cacheKey, md); err != nil {
+		s.logger.Printf("Warning: failed to record access to %s: %v", md.URL, err)
+	} else if s.lru != nil {
+		s.lru.touch(cacheKey, md.LastAccessedAt)
+	}
+}
+
+// contentURL returns the URL the entry's content is keyed by.
+func (md *entryMetadata) contentURL() string {
+	if md.CanonicalURL != "" {
+		return md.CanonicalURL
+	}
+	return md.URL
+}
+
+// finalURL returns the URL of the captured page, after any redirects.
+func (md *entryMetadata) finalURL() string {
+	if len(md.RedirectChain) > 0 {
+		return md.RedirectChain[len(md.RedirectChain)-1].URL
+	}
+	return md.URL
+}
+
+// response builds a response for the entry's content.
+func (md *entryMetadata) response(content []byte) *pb.DownloadCacheResponse {
+	resp := &pb.DownloadCacheResponse{
+		RedirectChain: md.redirectChainProto(),
+		CanonicalUrl:  md.CanonicalURL,
+		ContentType:   md.ContentType,
+		ContentHash:   md.ContentHash,
+		WebVitals:     md.WebVitals.proto(),
+		Tls:           md.TLS.proto(),
+		Receipt:       md.Receipt.proto(),
+	}
+	setContents(resp, content)
+	if !md.FetchedAt.IsZero() {
+		resp.FetchedAt = timestamppb.New(md.FetchedAt)
+	}
+	if md.ArchiveURL != "" {
+		resp.ArchiveUrl = md.ArchiveURL
+		if !md.ArchivedAt.IsZero() {
+			resp.ArchivedAt = timestamppb.New(md.ArchivedAt)
+		}
+	}
+	return resp
+}
+
+// setContents puts content in the response field for its type: binary
+// documents can't go in page_contents, since proto strings must be UTF-8.
+func setContents(resp *pb.DownloadCacheResponse, content []byte) {
+	if isText(resp.GetContentType()) {
+		resp.PageContents, resp.BinaryContents = string(content), nil
+	} else {
+		resp.PageContents, resp.BinaryContents = "", content
+	}
+}
+
+// contents returns the content of a response, from whichever field holds it.
+func contents(resp *pb.DownloadCacheResponse) []byte {
+	if isText(resp.GetContentType()) {
+		return []byte(resp.GetPageContents())
+	}
+	return resp.GetBinaryContents()
+}
+
+func (md *entryMetadata) redirectChainProto() []*pb.RedirectHop {
+	if len(md.RedirectChain) == 0 {
+		return nil
+	}
+	hops := make([]*pb.RedirectHop, 0, len(md.RedirectChain))
+	for _, hop := range md.RedirectChain {
+		hops = append(hops, &pb.RedirectHop{Url: hop.URL, StatusCode: int32(hop.StatusCode)})
+	}
+	return hops
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// latencyBuckets are the upper bounds, in seconds, of the RPC latency
+// histogram buckets: Prometheus's defaults, extended for slow renders.
+var latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// heapBuckets are the upper bounds, in bytes, of the browser heap
+// histogram buckets.
+var heapBuckets = []float64{1 << 22, 1 << 24, 1 << 26, 1 << 28, 1 << 30}
+
+// newRPCMetrics returns go-grpc-middleware's server metrics, with a latency
+// histogram, for ServerOptions' interceptors to count every RPC.
+func newRPCMetrics() *grpcprom.ServerMetrics {
+	return grpcprom.NewServerMetrics(grpcprom.WithServerHandlingTimeHistogram(grpcprom.WithHistogramBuckets(latencyBuckets)))
+}
+
+// renderMetrics totals what rendering pages cost the browsers, for
+// capacity planning, from the fetches that measured it.
+type renderMetrics struct {
+	cpuSeconds       prometheus.Counter
+	bytesTransferred prometheus.Counter
+	heapBytes        prometheus.Histogram
+}
+
+func newRenderMetrics() *renderMetrics {
+	return &renderMetrics{
+		cpuSeconds: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "downloadcache_browser_cpu_seconds_total",
+			Help: "Time the browsers' main threads spent running tasks for rendered pages.",
+		}),
+		bytesTransferred: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "downloadcache_bytes_transferred_total",
+			Help: "Bytes received by the browsers for rendered pages and their resources.",
+		}),
+		heapBytes: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "downloadcache_browser_heap_bytes",
+			Help:    "Histogram of the JavaScript heap in use by rendered pages.",
+			Buckets: heapBuckets,
+		}),
+	}
+}
+
+func (m *renderMetrics) record(u *ResourceUsage) {
+	m.cpuSeconds.Add(u.CPUTime.Seconds())
+	m.bytesTransferred.Add(float64(u.BytesTransferred))
+	m.heapBytes.Observe(float64(u.HeapBytes))
+}
+
+func (m *renderMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.cpuSeconds, m.bytesTransferred, m.heapBytes}
+}
+
+// newMetricsRegistry returns a registry of the server's metrics, its own
+// rather than Prometheus's default, so servers in one process don't clash.
+func (s *Server) newMetricsRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(s.rpcMetrics)
+	reg.MustRegister(s.renderMetrics.collectors()...)
+	reg.MustRegister(s.urlLocks.collectors()...)
+	if s.lru != nil {
+		reg.MustRegister(s.lru.collectors()...)
+	}
+	return reg
+}
+
+// MetricsHandler serves request counts, status codes and latency
+// histograms for every RPC, by method, and what rendering pages cost the
+// browsers, for Prometheus to scrape.
+func (s *Server) MetricsHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(s.metrics, promhttp.HandlerOpts{}))
+	return mux
+}
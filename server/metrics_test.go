@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// scrape returns what MetricsHandler serves.
+func scrape(t *testing.T, s *Server) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /metrics = %d", rec.Code)
+	}
+	return rec.Body.String()
+}
+
+// wantLines fails unless every line of want is a line of got.
+func wantLines(t *testing.T, got string, want ...string) {
+	t.Helper()
+	lines := make(map[string]bool)
+	for _, line := range strings.Split(got, "\n") {
+		lines[line] = true
+	}
+	for _, line := range want {
+		if !lines[line] {
+			t.Errorf("missing %q in:\n%s", line, got)
+		}
+	}
+}
+
+// TestRPCMetrics calls the server through ServerOptions' interceptors and
+// checks the metric names and labels of go-grpc-middleware, which
+// dashboards and alerts built for it rely on, and the latency buckets.
+func TestRPCMetrics(t *testing.T) {
+	s := newTestServer(t, &versionFetcher{})
+	lis := bufconn.Listen(1 << 20)
+	g := grpc.NewServer(s.ServerOptions()...)
+	pb.RegisterDownloadCacheServer(g, s)
+	go g.Serve(lis)
+	defer g.Stop()
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewDownloadCacheClient(conn)
+
+	ctx := context.Background()
+	if _, err := client.Get(ctx, &pb.DownloadCacheRequest{Url: "https://example.com/", CacheOptions: &pb.CacheOptions{CacheOnly: true}}); status.Code(err) != codes.NotFound {
+		t.Fatalf("cache_only Get of a miss = %v, want NotFound", err)
+	}
+	if _, err := client.Get(ctx, &pb.DownloadCacheRequest{Url: "https://example.com/"}); err != nil {
+		t.Fatal(err)
+	}
+
+	const labels = `grpc_method="Get",grpc_service="downloadcache.DownloadCache",grpc_type="unary"`
+	wantLines(t, scrape(t, s),
+		"# TYPE grpc_server_started_total counter",
+		"grpc_server_started_total{"+labels+"} 2",
+		"# TYPE grpc_server_handled_total counter",
+		`grpc_server_handled_total{grpc_code="NotFound",`+labels+"} 1",
+		`grpc_server_handled_total{grpc_code="OK",`+labels+"} 1",
+		"# TYPE grpc_server_handling_seconds histogram",
+		"grpc_server_handling_seconds_bucket{"+labels+`,le="60"} 2`,
+		"grpc_server_handling_seconds_bucket{"+labels+`,le="+Inf"} 2`,
+		"grpc_server_handling_seconds_count{"+labels+"} 2",
+	)
+}
+
+// TestRenderMetrics checks the browser metrics and their buckets.
+func TestRenderMetrics(t *testing.T) {
+	s := newTestServer(t, &versionFetcher{})
+	s.renderMetrics.record(&ResourceUsage{CPUTime: 1500 * time.Millisecond, BytesTransferred: 2048, HeapBytes: 1 << 25})
+	wantLines(t, scrape(t, s),
+		"# TYPE downloadcache_browser_cpu_seconds_total counter",
+		"downloadcache_browser_cpu_seconds_total 1.5",
+		"# TYPE downloadcache_bytes_transferred_total counter",
+		"downloadcache_bytes_transferred_total 2048",
+		"# TYPE downloadcache_browser_heap_bytes histogram",
+		`downloadcache_browser_heap_bytes_bucket{le="1.6777216e+07"} 0`,
+		`downloadcache_browser_heap_bytes_bucket{le="6.7108864e+07"} 1`,
+		`downloadcache_browser_heap_bytes_bucket{le="1.073741824e+09"} 1`,
+		`downloadcache_browser_heap_bytes_bucket{le="+Inf"} 1`,
+		"downloadcache_browser_heap_bytes_count 1",
+		"# TYPE downloadcache_url_locks gauge",
+		"downloadcache_url_locks 0",
+	)
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// cacheMigrator rewrites every entry of a cache from one layout (and codec) to another.
+type cacheMigrator struct {
+	cacheDir      string
+	storage       Storage
+	from, to      cacheLayout
+	codec         codec // Empty keeps each entry's current codec
+	progressEvery int
+	// lockEntry locks a key in the target layout while a server runs on it;
+	// nil for the migrate subcommand.
+	lockEntry func(cacheKey string) func()
+
+	seen, migrated, skipped, failed int
+}
+
+// RunMigrate implements the "migrate" subcommand, which recompresses, rekeys
+// or reshards an existing cache in place.  The server must not be running
+// against the cache.  Entries are migrated one at a time and entries already
+// in the target layout are skipped, so an interrupted migration can be
+// resumed by running the same command again.
+func RunMigrate(cfg Config, args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	cacheDir := flags.String("cache-dir", cfg.CacheDir, "cache directory to migrate")
+	fromScheme := flags.String("from-key-scheme", cfg.KeyScheme, "key scheme the cache currently uses")
+	fromDepth := flags.Int("from-shard-depth", cfg.ShardDepth, "shard depth the cache currently uses")
+	toScheme := flags.String("key-scheme", "", "key scheme to migrate to (default: unchanged)")
+	toDepth := flags.Int("shard-depth", -1, "shard depth to migrate to (default: unchanged)")
+	toCodec := flags.String("codec", "", "codec to recompress entries with (default: unchanged)")
+	progressEvery := flags.Int("progress-every", 1000, "log progress after this many entries")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	m := &cacheMigrator{cacheDir: *cacheDir, storage: dirStorage{root: *cacheDir}, progressEvery: *progressEvery}
+	var err error
+	if m.from, err = parseLayout(*fromScheme, *fromDepth); err != nil {
+		return err
+	}
+	if *toScheme == "" {
+		*toScheme = *fromScheme
+	}
+	if *toDepth < 0 {
+		*toDepth = *fromDepth
+	}
+	if m.to, err = parseLayout(*toScheme, *toDepth); err != nil {
+		return err
+	}
+	if *toCodec != "" {
+		if m.codec, err = parseCodec(*toCodec); err != nil {
+			return err
+		}
+	}
+	if m.from == m.to && m.codec == "" {
+		return errors.New("nothing to do: pass -codec, -key-scheme or -shard-depth")
+	}
+
+	log.Printf("Migrating %s from %+v to %+v (codec: %q)", m.cacheDir, m.from, m.to, m.codec)
+	return m.run()
+}
+
+func (m *cacheMigrator) run() error {
+	// Content entries, together with their metadata.
+	err := walkContentFiles(m.storage, func(name string, info FileInfo) error {
+		if strings.HasSuffix(name, partialSuffix) {
+			// Left behind by an interrupted run; the original is still in place.
+			return m.storage.Remove(name)
+		}
+		m.record(m.migrateEntry(name), name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = walkDetachedMetadata(m.storage, func(name string, md *entryMetadata) error {
+		m.record(m.migrateDetached(name, md), name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.to.shardDepth != m.from.shardDepth {
+		removeEmptyDirs(m.cacheDir)
+	}
+	log.Printf("Migration finished: %d entries seen, %d migrated, %d already migrated, %d failed",
+		m.seen, m.migrated, m.skipped, m.failed)
+	if m.failed > 0 {
+		return fmt.Errorf("%d entries failed to migrate; see log", m.failed)
+	}
+	return nil
+}
+
+// errAlreadyMigrated reports an entry that is already in the target layout.
+var errAlreadyMigrated = errors.New("already migrated")
+
+func (m *cacheMigrator) record(err error, name string) {
+	m.seen++
+	switch {
+	case err == nil:
+		m.migrated++
+	case errors.Is(err, errAlreadyMigrated):
+		m.skipped++
+	default:
+		m.failed++
+		log.Printf("Error: failed to migrate %s: %v", name, err)
+	}
+	if m.progressEvery > 0 && m.seen%m.progressEvery == 0 {
+		log.Printf("Progress: %d entries seen, %d migrated, %d already migrated, %d failed",
+			m.seen, m.migrated, m.skipped, m.failed)
+	}
+}
+
+// migrateEntry moves one content file, and its metadata, to the target layout.
+func (m *cacheMigrator) migrateEntry(name string) error {
+	key := path.Base(name)
+
+	// The stored bytes are authoritative for the codec: an interrupted run can
+	// leave recompressed content next to metadata that still names the old codec.
+	data, err := m.storage.Read(name)
+	if err != nil {
+		return err
+	}
+	current := sniffCodec(data)
+
+	if name == m.to.entryName(key) {
+		md, err := readMetadataFile(m.storage, m.to.metadataName(key))
+		if err == nil && md.key(m.to) == key && (md.encrypted() || md.Dict != 0 || md.codec() == current && (m.codec == "" || m.codec == current)) {
+			return errAlreadyMigrated
+		}
+	}
+	if name != m.from.entryName(key) {
+		return fmt.Errorf("file is not part of the %+v layout", m.from)
+	}
+
+	md, err := loadEntryMetadata(m.storage, m.from, key)
+	if err != nil {
+		return err
+	}
+
+	target := m.codec
+	if md.encrypted() || md.Dict != 0 {
+		// Recompressing would take the tenant's key, or the dictionary, so
+		// such content keeps its codec and only moves.
+		current, target = md.codec(), md.codec()
+	} else if target == "" {
+		target = current
+	}
+	newKey := md.key(m.to)
+	newName := m.to.entryName(newKey)
+	if m.lockEntry != nil {
+		unlock := m.lockEntry(newKey)
+		defer unlock()
+		if m.refetched(newKey, md) {
+			return m.removeOld(name, m.from.metadataName(key), m.from.axTreeName(key), m.from.thumbnailName(key))
+		}
+	}
+
+	if target != current {
+		content, err := current.decode(data)
+		if err != nil {
+			return err
+		}
+		if err := writeToCache(m.storage, newName, content, target); err != nil {
+			return err
+		}
+	} else if newName != name {
+		if err := m.storage.Write(newName, data); err != nil {
+			return err
+		}
+	}
+
+	// Artifacts stored with the entry move with it.
+	type artifact struct{ oldName, newName string }
+	var artifacts []artifact
+	if md.AXTree {
+		artifacts = append(artifacts, artifact{m.from.axTreeName(key), m.to.axTreeName(newKey)})
+	}
+	if md.Thumbnail {
+		artifacts = append(artifacts, artifact{m.from.thumbnailName(key), m.to.thumbnailName(newKey)})
+	}
+	for _, a := range artifacts {
+		if a.newName == a.oldName {
+			continue
+		}
+		data, err := m.storage.Read(a.oldName)
+		if err != nil {
+			return err
+		}
+		if err := m.storage.Write(a.newName, data); err != nil {
+			return err
+		}
+	}
+
+	md.Codec = target
+	newMetadataName := m.to.metadataName(newKey)
+	if err := writeMetadataFile(m.storage, newMetadataName, md); err != nil {
+		return err
+	}
+	if newKey != key {
+		if err := writeHashIndex(m.storage, newKey, md); err != nil {
+			return err
+		}
+	}
+
+	if newName != name {
+		if err := m.storage.Remove(name); err != nil {
+			return err
+		}
+	}
+	for _, a := range artifacts {
+		if a.newName != a.oldName {
+			if err := m.storage.Remove(a.oldName); err != nil {
+				return err
+			}
+		}
+	}
+	if oldMetadataName := m.from.metadataName(key); oldMetadataName != newMetadataName {
+		if err := m.storage.Remove(oldMetadataName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refetched reports whether a server running on the target layout has
+// fetched an entry again since it switched layouts, so the copy being
+// migrated is stale.  The caller holds the lock of the entry's new key.
+func (m *cacheMigrator) refetched(newKey string, md *entryMetadata) bool {
+	current, err := readMetadataFile(m.storage, m.to.metadataName(newKey))
+	return err == nil && !current.FetchedAt.Before(md.FetchedAt)
+}
+
+// removeOld removes the files of a stale entry from the old layout.
+func (m *cacheMigrator) removeOld(names ...string) error {
+	for _, name := range names {
+		if err := m.storage.Remove(name); err != nil {
+			return err
+		}
+	}
+	return errAlreadyMigrated
+}
+
+// migrateDetached moves metadata without local content (an alias, or an
+// entry in cold storage) to the target layout.
+func (m *cacheMigrator) migrateDetached(name string, md *entryMetadata) error {
+	newName := m.to.metadataName(md.key(m.to))
+	if name == newName {
+		return errAlreadyMigrated
+	}
+	if m.lockEntry != nil {
+		unlock := m.lockEntry(md.key(m.to))
+		defer unlock()
+		if m.refetched(md.key(m.to), md) {
+			return m.removeOld(name)
+		}
+	}
+	if err := writeMetadataFile(m.storage, newName, md); err != nil {
+		return err
+	}
+	if err := writeHashIndex(m.storage, md.key(m.to), md); err != nil {
+		return err
+	}
+	return m.storage.Remove(name)
+}
+
+// removeEmptyDirs removes shard directories left empty by a reshard.
+func removeEmptyDirs(root string) {
+	var dirs []string
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() && path != root {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	// Deepest first, so parents are empty by the time we reach them.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if entries, err := os.ReadDir(dirs[i]); err == nil && len(entries) == 0 {
+			os.Remove(dirs[i])
+		}
+	}
+}
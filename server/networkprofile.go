@@ -0,0 +1,35 @@
+package server
+
+import (
+	pb "downloadcache/pb"
+)
+
+// networkConditions are the arguments to DevTools' network emulation.
+type networkConditions struct {
+	Offline   bool    `json:"offline"`
+	LatencyMs float64 `json:"latency"`
+	Download  int64   `json:"downloadThroughput"` // Bytes per second; -1 is unlimited
+	Upload    int64   `json:"uploadThroughput"`
+}
+
+// unthrottled leaves the network as it is.
+var unthrottled = networkConditions{Download: -1, Upload: -1}
+
+// networkProfiles are the network conditions of each profile, as defined by
+// Chrome's DevTools.
+var networkProfiles = map[pb.NetworkProfile]networkConditions{
+	pb.NetworkProfile_NETWORK_PROFILE_SLOW_3G: {LatencyMs: 2000, Download: 50000, Upload: 50000},
+	pb.NetworkProfile_NETWORK_PROFILE_FAST_3G: {LatencyMs: 562.5, Download: 180000, Upload: 84375},
+	pb.NetworkProfile_NETWORK_PROFILE_FAST_4G: {LatencyMs: 165, Download: 1012500, Upload: 168750},
+}
+
+// capped returns the conditions with throughput limited to bytesPerSec.
+func (c networkConditions) capped(bytesPerSec int64) networkConditions {
+	if c.Download < 0 || c.Download > bytesPerSec {
+		c.Download = bytesPerSec
+	}
+	if c.Upload < 0 || c.Upload > bytesPerSec {
+		c.Upload = bytesPerSec
+	}
+	return c
+}
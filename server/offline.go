@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetOfflineMode handles the gRPC request.  Offline, the server answers from
+// the cache only: misses and invalidations fail with Unavailable, and entries
+// past their TTL are served rather than refetched.
+func (s *Server) SetOfflineMode(ctx context.Context, req *pb.SetOfflineModeRequest) (*pb.SetOfflineModeResponse, error) {
+	was := s.offline.Swap(req.GetOffline())
+	if was != req.GetOffline() {
+		s.logger.Printf("Offline mode changed from %v to %v", was, req.GetOffline())
+	}
+	return &pb.SetOfflineModeResponse{Offline: req.GetOffline(), WasOffline: was}, nil
+}
+
+// checkOnline returns an Unavailable error if the server is offline, for
+// callers about to fetch rawURL.
+func (s *Server) checkOnline(rawURL string) error {
+	if s.offline.Load() {
+		return status.Errorf(codes.Unavailable, "server is in offline mode; %s must be fetched", rawURL)
+	}
+	return nil
+}
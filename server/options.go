@@ -0,0 +1,122 @@
+package server
+
+import (
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultRenderWait    = 2 * time.Second
+	defaultMaxRenderWait = 30 * time.Second
+	defaultNetworkIdle   = 500 * time.Millisecond
+)
+
+// mergeOptions returns a request's fetch and cache options with every unset
+// field filled in from the server defaults.  The request is not modified.
+func (s *Server) mergeOptions(req *pb.DownloadCacheRequest) (*pb.FetchOptions, *pb.CacheOptions) {
+	fetch := &pb.FetchOptions{}
+	if req.GetFetchOptions() != nil {
+		fetch = proto.Clone(req.GetFetchOptions()).(*pb.FetchOptions)
+	}
+	if fetch.RenderWaitMs == nil {
+		wait := min(defaultRenderWait, s.maxRenderWait)
+		switch {
+		case fetch.GetDisableJavascript():
+			wait = 0 // No scripts to wait for.
+		case fetch.GetWaitStrategy() == pb.WaitStrategy_WAIT_STRATEGY_NETWORK_IDLE:
+			wait = s.maxRenderWait // Only a cap; the network going idle ends the wait.
+		}
+		fetch.RenderWaitMs = proto.Int32(int32(wait / time.Millisecond))
+	}
+	if fetch.NetworkIdleMs == nil && fetch.GetWaitStrategy() == pb.WaitStrategy_WAIT_STRATEGY_NETWORK_IDLE {
+		fetch.NetworkIdleMs = proto.Int32(int32(defaultNetworkIdle / time.Millisecond))
+	}
+	if fetch.MaxRedirects == nil {
+		fetch.MaxRedirects = proto.Int32(int32(s.maxRedirects))
+	}
+	s.browser.applyDomainDefaults(req.GetUrl(), fetch)
+	s.egress.applyDomainDefaults(req.GetUrl(), fetch)
+
+	cache := &pb.CacheOptions{}
+	if req.GetCacheOptions() != nil {
+		cache = proto.Clone(req.GetCacheOptions()).(*pb.CacheOptions)
+	}
+	// The flat field predates CacheOptions; honor it for old clients.
+	cache.Invalidate = cache.GetInvalidate() || req.GetInvalidate()
+	return fetch, cache
+}
+
+// validateFetchOptions checks merged fetch options against the server limits.
+func (s *Server) validateFetchOptions(fetch *pb.FetchOptions) error {
+	if wait := fetch.GetRenderWaitMs(); wait < 0 || time.Duration(wait)*time.Millisecond > s.maxRenderWait {
+		return status.Errorf(codes.InvalidArgument, "render_wait_ms must be between 0 and %d", s.maxRenderWait.Milliseconds())
+	}
+	if redirects := fetch.GetMaxRedirects(); redirects < 0 || int(redirects) > s.maxRedirects {
+		return status.Errorf(codes.InvalidArgument, "max_redirects must be between 0 and %d", s.maxRedirects)
+	}
+	if _, ok := pb.WaitStrategy_name[int32(fetch.GetWaitStrategy())]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown wait_strategy %d", fetch.GetWaitStrategy())
+	}
+	if idle := fetch.GetNetworkIdleMs(); idle < 0 || time.Duration(idle)*time.Millisecond > s.maxRenderWait {
+		return status.Errorf(codes.InvalidArgument, "network_idle_ms must be between 0 and %d", s.maxRenderWait.Milliseconds())
+	}
+	if _, ok := pb.NetworkProfile_name[int32(fetch.GetNetworkProfile())]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown network_profile %d", fetch.GetNetworkProfile())
+	}
+	if fetch.GetArchiveFallback() && s.archive == nil {
+		return status.Errorf(codes.FailedPrecondition, "archive_fallback requires an archive; set ARCHIVE_URL")
+	}
+	if _, ok := pb.RenderMode_name[int32(fetch.GetRenderMode())]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown render_mode %d", fetch.GetRenderMode())
+	}
+	if fetch.GetRenderMode() == pb.RenderMode_RENDER_MODE_STATIC && (needsBrowser(fetch) || fetch.GetStealth()) {
+		return status.Errorf(codes.InvalidArgument, "render_mode STATIC can't capture accessibility trees, web vitals or failure artifacts, emulate networks, or use stealth")
+	}
+	if pool := fetch.GetEgressPool(); pool != "" && !s.egress.has(pool) {
+		return status.Errorf(codes.InvalidArgument, "unknown egress_pool %q", pool)
+	}
+	return nil
+}
+
+const (
+	maxAnnotations          = 32
+	maxAnnotationKeyBytes   = 128
+	maxAnnotationValueBytes = 1024
+)
+
+// validateCacheOptions checks the cache options of a request.
+func validateCacheOptions(cache *pb.CacheOptions) error {
+	if cache.GetCacheOnly() && cache.GetInvalidate() {
+		return status.Errorf(codes.InvalidArgument, "cache_only and invalidate can't both be set")
+	}
+	annotations := cache.GetAnnotations()
+	if len(annotations) > maxAnnotations {
+		return status.Errorf(codes.InvalidArgument, "at most %d annotations are allowed", maxAnnotations)
+	}
+	for k, v := range annotations {
+		if k == "" || len(k) > maxAnnotationKeyBytes {
+			return status.Errorf(codes.InvalidArgument, "annotation keys must be 1 to %d bytes", maxAnnotationKeyBytes)
+		}
+		if len(v) > maxAnnotationValueBytes {
+			return status.Errorf(codes.InvalidArgument, "annotation %q is longer than %d bytes", k, maxAnnotationValueBytes)
+		}
+	}
+	return nil
+}
+
+// renderWait returns how long to let a page's scripts run before capturing
+// it, or with the network idle strategy, the longest to wait.
+func renderWait(fetch *pb.FetchOptions) time.Duration {
+	return time.Duration(fetch.GetRenderWaitMs()) * time.Millisecond
+}
+
+// networkIdle returns how long the network must be idle before a page is
+// captured with the network idle strategy.
+func networkIdle(fetch *pb.FetchOptions) time.Duration {
+	return time.Duration(fetch.GetNetworkIdleMs()) * time.Millisecond
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tebeka/selenium"
+	slog "github.com/tebeka/selenium/log"
+)
+
+// perfLogEvent is a DevTools protocol event taken from Chrome's performance log.
+type perfLogEvent struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// readPerformanceLog drains the performance log of a session.  The session
+// must have been created with goog:loggingPrefs enabling the performance log.
+func readPerformanceLog(wd selenium.WebDriver) ([]perfLogEvent, error) {
+	messages, err := wd.Log(slog.Performance)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]perfLogEvent, 0, len(messages))
+	for _, m := range messages {
+		var wrapper struct {
+			Message perfLogEvent `json:"message"`
+		}
+		if err := json.Unmarshal([]byte(m.Message), &wrapper); err != nil {
+			continue
+		}
+		events = append(events, wrapper.Message)
+	}
+	return events, nil
+}
+
+// documentEvent holds the fields shared by the Network.* events we care about.
+// Only top-level document loads have RequestID == LoaderID.
+type documentEvent struct {
+	Type      string `json:"type"`
+	FrameID   string `json:"frameId"`
+	RequestID string `json:"requestId"`
+	LoaderID  string `json:"loaderId"`
+	Request   struct {
+		URL string `json:"url"`
+	} `json:"request"`
+	Response *struct {
+		URL      string            `json:"url"`
+		Status   int               `json:"status"`
+		MimeType string            `json:"mimeType"`
+		Headers  map[string]string `json:"headers"`
+		// Set for responses served over TLS.
+		SecurityDetails *securityDetails `json:"securityDetails"`
+	} `json:"response"`
+	RedirectResponse *struct {
+		URL    string `json:"url"`
+		Status int    `json:"status"`
+	} `json:"redirectResponse"`
+}
+
+// mainFrameEvent is a Network.* event for a document loaded into the main frame.
+type mainFrameEvent struct {
+	method string
+	documentEvent
+}
+
+// mainFrameEvents picks out the events for documents loaded into the main
+// frame, in order.
+func mainFrameEvents(events []perfLogEvent) []mainFrameEvent {
+	var docs []mainFrameEvent
+	mainFrame := ""
+	for _, e := range events {
+		if e.Method != "Network.requestWillBeSent" && e.Method != "Network.responseReceived" {
+			continue
+		}
+		var ev documentEvent
+		if err := json.Unmarshal(e.Params, &ev); err != nil || ev.Type != "Document" || ev.RequestID != ev.LoaderID {
+			continue
+		}
+		if mainFrame == "" {
+			mainFrame = ev.FrameID
+		}
+		if ev.FrameID != mainFrame {
+			continue
+		}
+		docs = append(docs, mainFrameEvent{method: e.Method, documentEvent: ev})
+	}
+	return docs
+}
+
+// redirectChainFromLog reconstructs the documents loaded into the main frame,
+// in order.  HTTP redirects and client-side (meta refresh / JavaScript)
+// navigations both appear as hops; the last hop is the page that was captured.
+func redirectChainFromLog(events []perfLogEvent) []RedirectHop {
+	var chain []RedirectHop
+	for _, ev := range mainFrameEvents(events) {
+		switch ev.method {
+		case "Network.requestWillBeSent":
+			if ev.RedirectResponse != nil && len(chain) > 0 {
+				chain[len(chain)-1].StatusCode = ev.RedirectResponse.Status
+			}
+			chain = append(chain, RedirectHop{URL: ev.Request.URL})
+		case "Network.responseReceived":
+			if ev.Response != nil && len(chain) > 0 && chain[len(chain)-1].URL == ev.Response.URL {
+				chain[len(chain)-1].StatusCode = ev.Response.Status
+			}
+		}
+	}
+	return chain
+}
+
+// contentTypeFromLog returns the Content-Type of the last document loaded
+// into the main frame, which is the page that was captured, or "" if the log
+// doesn't have it.
+func contentTypeFromLog(events []perfLogEvent) string {
+	contentType := ""
+	for _, ev := range mainFrameEvents(events) {
+		if ev.method != "Network.responseReceived" || ev.Response == nil {
+			continue
+		}
+		// Header names are as sent, so HTTP/1 and HTTP/2 responses differ in case.
+		contentType = ev.Response.MimeType
+		for name, value := range ev.Response.Headers {
+			if strings.EqualFold(name, "Content-Type") {
+				contentType = value
+				break
+			}
+		}
+	}
+	return contentType
+}
+
+// bytesTransferredFromLog totals the bytes received for every request in a
+// performance log, as sent over the network.
+func bytesTransferredFromLog(events []perfLogEvent) int64 {
+	var total float64
+	for _, e := range events {
+		if e.Method != "Network.loadingFinished" {
+			continue
+		}
+		var ev struct {
+			EncodedDataLength float64 `json:"encodedDataLength"`
+		}
+		if err := json.Unmarshal(e.Params, &ev); err == nil {
+			total += ev.EncodedDataLength
+		}
+	}
+	return int64(total)
+}
+
+// networkIdlePoll is how often the performance log is read while waiting for
+// the network to go idle.
+const networkIdlePoll = 100 * time.Millisecond
+
+// waitForNetworkIdle waits until no XHR or fetch request has been in flight
+// for idle, at most max.  Reading the performance log drains it, so it
+// returns the events it read, which come before any read after it.
+func waitForNetworkIdle(ctx context.Context, wd selenium.WebDriver, idle, max time.Duration) ([]perfLogEvent, error) {
+	deadline := time.Now().Add(max)
+	quietSince := time.Now()
+	inFlight := make(map[string]bool)
+	var events []perfLogEvent
+	for {
+		batch, err := readPerformanceLog(wd)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, batch...)
+		active := false
+		for _, e := range batch {
+			var ev struct {
+				RequestID string `json:"requestId"`
+				Type      string `json:"type"`
+			}
+			switch e.Method {
+			case "Network.requestWillBeSent":
+				if err := json.Unmarshal(e.Params, &ev); err == nil && (ev.Type == "XHR" || ev.Type == "Fetch") {
+					inFlight[ev.RequestID] = true
+					active = true
+				}
+			case "Network.loadingFinished", "Network.loadingFailed":
+				if err := json.Unmarshal(e.Params, &ev); err == nil && inFlight[ev.RequestID] {
+					delete(inFlight, ev.RequestID)
+					active = true
+				}
+			}
+		}
+		now := time.Now()
+		if active || len(inFlight) > 0 {
+			quietSince = now
+		}
+		if now.Sub(quietSince) >= idle || !now.Before(deadline) || ctx.Err() != nil {
+			return events, nil
+		}
+		sleepContext(ctx, min(networkIdlePoll, deadline.Sub(now)))
+	}
+}
+
+// originsFromLog returns the origins of every HTTP request in the log, such
+// as those of the page, its iframes and the scripts it ran, in the order
+// they were first requested.
+func originsFromLog(events []perfLogEvent) []string {
+	var origins []string
+	seen := make(map[string]bool)
+	for _, e := range events {
+		if e.Method != "Network.requestWillBeSent" {
+			continue
+		}
+		var ev documentEvent
+		if err := json.Unmarshal(e.Params, &ev); err != nil {
+			continue
+		}
+		if origin := originOf(ev.Request.URL); origin != "" && !seen[origin] {
+			seen[origin] = true
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// originOf returns the origin of an HTTP or HTTPS URL, e.g.
+// "https://example.com:8443", or "" for other URLs.
+func originOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
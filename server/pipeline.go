@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// processingStages maps the stage names used in PROCESSORS_CONFIG to stages.
+var processingStages = map[string]pb.ProcessingStage{
+	"after_fetch":    pb.ProcessingStage_PROCESSING_STAGE_AFTER_FETCH,
+	"before_store":   pb.ProcessingStage_PROCESSING_STAGE_BEFORE_STORE,
+	"before_respond": pb.ProcessingStage_PROCESSING_STAGE_BEFORE_RESPOND,
+}
+
+// processor transforms page content at one or more pipeline stages.
+type processor interface {
+	Process(ctx context.Context, stage pb.ProcessingStage, rawURL string, content []byte) ([]byte, error)
+}
+
+// ProcessorConfig is one entry of the PROCESSORS_CONFIG file.  It names
+// either a Processor service or a built-in redaction.
+type ProcessorConfig struct {
+	Name    string        `json:"name"`
+	Address string        `json:"address"` // gRPC address of a Processor service
+	Redact  *RedactConfig `json:"redact"`  // Redact sensitive text instead of calling a service
+	Stages  []string      `json:"stages"`
+	Domains []string      `json:"domains"` // Hostnames, including their subdomains; empty means every domain
+}
+
+// loadProcessorConfig reads the list of processors from a JSON file.
+func loadProcessorConfig(path string) ([]ProcessorConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var configs []ProcessorConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("invalid processor config %s: %w", path, err)
+	}
+	for i, c := range configs {
+		if c.Name == "" {
+			if c.Name = c.Address; c.Redact != nil {
+				c.Name = "redact"
+			}
+			configs[i].Name = c.Name
+		}
+		switch {
+		case c.Address == "" && c.Redact == nil:
+			return nil, fmt.Errorf("processor %q has no address", c.Name)
+		case c.Address != "" && c.Redact != nil:
+			return nil, fmt.Errorf("processor %q has both an address and a redaction", c.Name)
+		case c.Redact != nil:
+			if _, err := newRedactor(*c.Redact); err != nil {
+				return nil, fmt.Errorf("processor %q: %w", c.Name, err)
+			}
+		}
+		if len(c.Stages) == 0 {
+			return nil, fmt.Errorf("processor %q has no stages", c.Name)
+		}
+		for _, stage := range c.Stages {
+			if _, ok := processingStages[stage]; !ok {
+				return nil, fmt.Errorf("processor %q has invalid stage %q", c.Name, stage)
+			}
+		}
+	}
+	return configs, nil
+}
+
+// processorHook is a processor registered for some stages and domains.
+type processorHook struct {
+	name      string
+	processor processor
+	stages    map[pb.ProcessingStage]bool
+	domains   []string
+}
+
+// matches reports whether the hook runs for a URL at a stage.
+func (h *processorHook) matches(stage pb.ProcessingStage, rawURL string) bool {
+	if !h.stages[stage] {
+		return false
+	}
+	return len(h.domains) == 0 || matchesDomain(hostOf(rawURL), h.domains)
+}
+
+// pipeline runs the configured processors, in configuration order, at each
+// stage of a request.  The zero value runs none.
+type pipeline struct {
+	hooks []*processorHook
+}
+
+// newPipeline connects to the configured processors.
+func newPipeline(configs []ProcessorConfig) (*pipeline, error) {
+	p := &pipeline{}
+	for _, c := range configs {
+		var proc processor
+		var err error
+		if c.Redact != nil {
+			proc, err = newRedactor(*c.Redact)
+		} else {
+			proc, err = newGRPCProcessor(c.Address)
+		}
+		if err != nil {
+			return nil, err
+		}
+		h := &processorHook{name: c.Name, processor: proc, stages: map[pb.ProcessingStage]bool{}, domains: c.Domains}
+		for _, stage := range c.Stages {
+			h.stages[processingStages[stage]] = true
+		}
+		p.hooks = append(p.hooks, h)
+	}
+	return p, nil
+}
+
+// run passes content through every processor registered for the stage and
+// the URL's domain.  Errors keep the status code the processor returned.
+func (p *pipeline) run(ctx context.Context, stage pb.ProcessingStage, rawURL string, content []byte) ([]byte, error) {
+	if p == nil {
+		return content, nil
+	}
+	defer timingFrom(ctx).since(phaseProcessors, time.Now())
+	for _, h := range p.hooks {
+		if !h.matches(stage, rawURL) {
+			continue
+		}
+		var err error
+		content, err = h.processor.Process(ctx, stage, rawURL, content)
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "processor %s failed for %s: %v", h.name, rawURL, err)
+		}
+	}
+	return content, nil
+}
+
+// grpcProcessor calls an out-of-process processor implementing the Processor
+// gRPC service (see pb/processor.proto).
+type grpcProcessor struct {
+	client pb.ProcessorClient
+}
+
+func newGRPCProcessor(addr string) (*grpcProcessor, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to processor %s: %w", addr, err)
+	}
+	return &grpcProcessor{client: pb.NewProcessorClient(conn)}, nil
+}
+
+func (g *grpcProcessor) Process(ctx context.Context, stage pb.ProcessingStage, rawURL string, content []byte) ([]byte, error) {
+	resp, err := g.client.Process(ctx, &pb.ProcessRequest{Url: rawURL, Stage: stage, PageContents: string(content)})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(resp.GetPageContents()), nil
+}
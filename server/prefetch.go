@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxPrefetchLinks        = 20
+	prefetchQueueSize       = 1000
+	defaultPrefetchInterval = time.Second
+	// prefetchBusyDelay is how long a prefetch waits while requests are
+	// waiting for a renderer.
+	prefetchBusyDelay = time.Second
+)
+
+// prefetcher fetches, in the background, pages linked from pages clients
+// asked for, so their next requests are hits.  It runs one fetch at a time,
+// at most one per interval, and only while no request is waiting for a
+// renderer.  Links are dropped when the queue is full.
+type prefetcher struct {
+	queue    chan prefetchItem
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending map[string]bool // Cache keys queued or being fetched
+}
+
+type prefetchItem struct {
+	url       string
+	cacheKey  string
+	tenant    string
+	fetchOpts *pb.FetchOptions
+	cacheOpts *pb.CacheOptions
+}
+
+func newPrefetcher(interval time.Duration) *prefetcher {
+	return &prefetcher{
+		queue:    make(chan prefetchItem, prefetchQueueSize),
+		interval: interval,
+		pending:  make(map[string]bool),
+	}
+}
+
+// queuePrefetches queues up to n of a fetched page's links to pages on the
+// same host that aren't cached, in the order they appear on the page.
+func (s *Server) queuePrefetches(ctx context.Context, req *pb.DownloadCacheRequest, resp *pb.DownloadCacheResponse, fetchOpts *pb.FetchOptions, cacheOpts *pb.CacheOptions) {
+	pageURL := req.GetUrl()
+	if chain := resp.GetRedirectChain(); len(chain) > 0 {
+		pageURL = chain[len(chain)-1].GetUrl()
+	}
+	host := hostOf(pageURL)
+	// Prefetches are stored like the page, but never replace cached copies.
+	cacheOpts = proto.Clone(cacheOpts).(*pb.CacheOptions)
+	cacheOpts.Invalidate, cacheOpts.Force = false, false
+
+	queued := 0
+	for _, link := range pageLinks(contents(resp), pageURL) {
+		if queued == int(req.GetPrefetchLinks()) {
+			break
+		}
+		if hostOf(link) != host || link == pageURL || link == req.GetUrl() {
+			continue
+		}
+		cacheKey := s.layout.tenantKey(req.GetTenant(), link)
+		if s.entryExists(s.resolveAlias(cacheKey)) {
+			continue
+		}
+		if !s.prefetch.add(prefetchItem{url: link, cacheKey: cacheKey, tenant: req.GetTenant(), fetchOpts: fetchOpts, cacheOpts: cacheOpts}) {
+			continue
+		}
+		queued++
+	}
+	if queued > 0 {
+		s.logger.Requestf(ctx, "Queued %d links of %s to prefetch", queued, req.GetUrl())
+	}
+}
+
+// add queues an item, reporting whether it was queued: it isn't if it is
+// already queued or the queue is full.
+func (p *prefetcher) add(item prefetchItem) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pending[item.cacheKey] {
+		return false
+	}
+	select {
+	case p.queue <- item:
+		p.pending[item.cacheKey] = true
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *prefetcher) done(item prefetchItem) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.pending, item.cacheKey)
+}
+
+// runPrefetches fetches queued links until ctx is done.
+func (s *Server) runPrefetches(ctx context.Context) {
+	for {
+		var item prefetchItem
+		select {
+		case <-ctx.Done():
+			return
+		case item = <-s.prefetch.queue:
+		}
+		s.prefetchPage(ctx, item)
+		s.prefetch.done(item)
+		sleepContext(ctx, s.prefetch.interval)
+	}
+}
+
+// prefetchPage fetches a queued link once nothing else needs the renderer.
+// Like a crawl, it waits out offline mode, maintenance and pauses of the
+// link's domain.
+func (s *Server) prefetchPage(ctx context.Context, item prefetchItem) {
+	for ctx.Err() == nil {
+		if wait := s.crawlWait(item.url); wait > 0 {
+			sleepContext(ctx, wait)
+			continue
+		}
+		if queued, _, _ := s.load.snapshot(); queued > 0 {
+			sleepContext(ctx, prefetchBusyDelay)
+			continue
+		}
+		done, err := s.maintenance.enter()
+		if err != nil {
+			sleepContext(ctx, crawlRetryDelay)
+			continue
+		}
+		if !s.entryExists(s.resolveAlias(item.cacheKey)) { // Unless requested since it was queued
+			if _, err := s.downloadAndCache(ctx, item.url, item.cacheKey, item.tenant, item.fetchOpts, item.cacheOpts); err != nil {
+				s.logger.Printf("Warning: failed to prefetch %s: %v", item.url, err)
+			}
+		}
+		done()
+		return
+	}
+}
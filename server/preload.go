@@ -0,0 +1,82 @@
+package server
+
+import (
+	"container/heap"
+	"context"
+	"path"
+	"strings"
+	"time"
+)
+
+// preloadCandidate is an entry that may be read ahead, by when it was last
+// used.
+type preloadCandidate struct {
+	name       string
+	lastAccess time.Time
+}
+
+// preloadHeap keeps the most recently used candidates, least recent on top.
+type preloadHeap []preloadCandidate
+
+func (h preloadHeap) Len() int           { return len(h) }
+func (h preloadHeap) Less(i, j int) bool { return h[i].lastAccess.Before(h[j].lastAccess) }
+func (h preloadHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *preloadHeap) Push(x any)        { *h = append(*h, x.(preloadCandidate)) }
+func (h *preloadHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+// preloadHotEntries reads the n most recently used entries' content files,
+// most recent first, so that after a restart the operating system's page
+// cache already holds the pages clients ask for most, and their hits are
+// served from memory rather than disk.
+func (s *Server) preloadHotEntries(ctx context.Context, n int) {
+	start := time.Now()
+	hot := make(preloadHeap, 0, n)
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil || !md.hasLocalContent() {
+			return nil
+		}
+		c := preloadCandidate{name: name, lastAccess: md.lastAccess()}
+		if len(hot) < n {
+			heap.Push(&hot, c)
+		} else if c.lastAccess.After(hot[0].lastAccess) {
+			hot[0] = c
+			heap.Fix(&hot, 0)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Printf("Warning: failed to find hot entries to preload: %v", err)
+		return
+	}
+
+	// Popping gives the least recent first, so read from the end.
+	names := make([]string, len(hot))
+	for i := len(names) - 1; i >= 0; i-- {
+		names[i] = heap.Pop(&hot).(preloadCandidate).name
+	}
+	var bytes int64
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return
+		}
+		data, err := s.storage.Read(name)
+		if err != nil {
+			continue // Removed since.
+		}
+		bytes += int64(len(data))
+	}
+	s.logger.Printf("Preloaded %d hot entries (%d bytes) in %v", len(names), bytes, time.Since(start).Round(time.Millisecond))
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// processTree returns the IDs of a process and all its descendants, and the
+// memory they use (resident set size) in bytes, from Linux's /proc.
+func processTree(pid int) ([]int, int64, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, 0, err
+	}
+	children := make(map[int][]int)
+	rss := make(map[int]int64)
+	for _, e := range entries {
+		child, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+		parent, pages, err := readProcStat(child)
+		if err != nil {
+			continue // Exited since the directory was read
+		}
+		children[parent] = append(children[parent], child)
+		rss[child] = pages * int64(os.Getpagesize())
+	}
+	if _, ok := rss[pid]; !ok {
+		return nil, 0, fmt.Errorf("no process %d", pid)
+	}
+
+	var tree []int
+	var total int64
+	for queue := []int{pid}; len(queue) > 0; queue = queue[1:] {
+		p := queue[0]
+		tree = append(tree, p)
+		total += rss[p]
+		queue = append(queue, children[p]...)
+	}
+	return tree, total, nil
+}
+
+// readProcStat returns a process's parent and resident set size in pages.
+func readProcStat(pid int) (ppid int, rssPages int64, err error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, 0, err
+	}
+	// The command name, the second field, is in parentheses and may contain
+	// spaces, so fields are counted from the last ')'.
+	i := strings.LastIndexByte(string(data), ')')
+	if i < 0 {
+		return 0, 0, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	fields := strings.Fields(string(data[i+1:]))
+	if len(fields) < 22 { // state (3) through rss (24)
+		return 0, 0, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	if ppid, err = strconv.Atoi(fields[1]); err != nil {
+		return 0, 0, err
+	}
+	if rssPages, err = strconv.ParseInt(fields[21], 10, 64); err != nil {
+		return 0, 0, err
+	}
+	return ppid, rssPages, nil
+}
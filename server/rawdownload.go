@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	pb "downloadcache/pb"
+)
+
+// rawFetcher downloads binary documents, such as PDFs, zips and images,
+// directly over HTTP instead of navigating the browser at them, which would
+// only capture Chrome's viewer.  A HEAD request tells them apart; everything
+// else is fetched by the inner fetcher.
+type rawFetcher struct {
+	inner  Fetcher
+	http   *httpFetcher
+	logger *levelLogger
+}
+
+func (f *rawFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	if !f.binary(ctx, rawURL) {
+		return f.inner.Fetch(ctx, rawURL, opts)
+	}
+	f.logger.Requestf(ctx, "Downloading binary document over HTTP: %s", rawURL)
+	return f.http.Fetch(ctx, rawURL, opts)
+}
+
+// binary reports whether a HEAD request says rawURL is a binary document.
+// URLs that fail or don't answer HEAD are left to the browser.
+func (f *rawFetcher) binary(ctx context.Context, rawURL string) bool {
+	httpReq, err := f.http.newRequest(ctx, http.MethodHead, rawURL)
+	if err != nil {
+		return false
+	}
+	client, _, _ := f.http.clientFor(ctx, rawURL)
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return false
+	}
+	httpResp.Body.Close()
+	return httpResp.StatusCode == http.StatusOK && !isText(httpResp.Header.Get("Content-Type"))
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Fetchers named in receipts.
+const (
+	fetcherBrowser = "browser"
+	fetcherHTTP    = "http"
+	fetcherPlugin  = "plugin"
+	fetcherReplay  = "replay"
+	fetcherArchive = "archive"
+)
+
+// FetchReceipt records how a page was fetched, so that datasets built from
+// the cache can document how each of their pages was obtained.  Fetchers
+// fill in what they know, and fetchPage the rest.
+type FetchReceipt struct {
+	Fetcher string `json:"fetcher,omitempty"` // One of the fetcher* names; empty if the fetcher doesn't say
+	// Escalation is the steps of the domain's escalation ladder tried, the
+	// last of them fetching the page.
+	Escalation     []string `json:"escalation,omitempty"`
+	BrowserName    string   `json:"browser_name,omitempty"` // e.g. "HeadlessChrome"
+	BrowserVersion string   `json:"browser_version,omitempty"`
+	EgressIP       string   `json:"egress_ip,omitempty"` // The local address the page was fetched from, if known
+	EgressPool     string   `json:"egress_pool,omitempty"`
+	EgressProxy    string   `json:"egress_proxy,omitempty"` // Without credentials
+	// Options are the fetch options in effect, as protojson.
+	Options   json.RawMessage `json:"options,omitempty"`
+	StartedAt time.Time       `json:"started_at"`
+	Duration  time.Duration   `json:"duration"`
+	Phases    FetchPhases     `json:"phases"`
+}
+
+// FetchPhases are where a fetch spent its time.  Zero phases didn't happen,
+// or weren't measured by the fetcher.
+type FetchPhases struct {
+	QueueWait     time.Duration `json:"queue_wait,omitempty"`
+	SessionCreate time.Duration `json:"session_create,omitempty"`
+	Navigation    time.Duration `json:"navigation,omitempty"`
+	RenderWait    time.Duration `json:"render_wait,omitempty"`
+	Capture       time.Duration `json:"capture,omitempty"`
+}
+
+// fetchPhases returns the time spent in each phase of fetching so far.
+func (t *requestTiming) fetchPhases() FetchPhases {
+	if t == nil {
+		return FetchPhases{}
+	}
+	return FetchPhases{
+		QueueWait:     t.phases[phaseQueueWait],
+		SessionCreate: t.phases[phaseSessionCreate],
+		Navigation:    t.phases[phaseNavigation],
+		RenderWait:    t.phases[phaseRenderWait],
+		Capture:       t.phases[phaseCapture],
+	}
+}
+
+// since returns the time spent in each phase after before was taken.
+func (p FetchPhases) since(before FetchPhases) FetchPhases {
+	return FetchPhases{
+		QueueWait:     p.QueueWait - before.QueueWait,
+		SessionCreate: p.SessionCreate - before.SessionCreate,
+		Navigation:    p.Navigation - before.Navigation,
+		RenderWait:    p.RenderWait - before.RenderWait,
+		Capture:       p.Capture - before.Capture,
+	}
+}
+
+// egressReceipt returns the receipt of a fetch through an egress identity of
+// pool, which is nil for direct fetches.
+func egressReceipt(fetcher string, pool *egressPool, id *egressIdentity) *FetchReceipt {
+	r := &FetchReceipt{Fetcher: fetcher}
+	if pool != nil {
+		r.EgressPool = pool.name
+	}
+	if id != nil && id.proxy != nil {
+		r.EgressProxy = id.String()
+	}
+	return r
+}
+
+// fetchReceipt completes the receipt of a fetch that started at started,
+// spending phases, with the options it was made with.
+func fetchReceipt(result *FetchResult, opts *pb.FetchOptions, started time.Time, phases FetchPhases) *FetchReceipt {
+	r := result.Receipt
+	if r == nil {
+		r = &FetchReceipt{}
+	}
+	if options, err := protojson.Marshal(opts); err == nil {
+		r.Options = options
+	}
+	r.StartedAt, r.Duration, r.Phases = started, time.Since(started), phases
+	return r
+}
+
+func (r *FetchReceipt) proto() *pb.FetchReceipt {
+	if r == nil {
+		return nil
+	}
+	d := func(d time.Duration) *durationpb.Duration {
+		if d == 0 {
+			return nil
+		}
+		return durationpb.New(d)
+	}
+	p := &pb.FetchReceipt{
+		Fetcher:        r.Fetcher,
+		Escalation:     r.Escalation,
+		BrowserName:    r.BrowserName,
+		BrowserVersion: r.BrowserVersion,
+		EgressIp:       r.EgressIP,
+		EgressPool:     r.EgressPool,
+		EgressProxy:    r.EgressProxy,
+		StartedAt:      timestamppb.New(r.StartedAt),
+		Timing: &pb.Timing{
+			Total:         durationpb.New(r.Duration),
+			QueueWait:     d(r.Phases.QueueWait),
+			SessionCreate: d(r.Phases.SessionCreate),
+			Navigation:    d(r.Phases.Navigation),
+			RenderWait:    d(r.Phases.RenderWait),
+			Capture:       d(r.Phases.Capture),
+		},
+	}
+	if len(r.Options) > 0 {
+		opts := &pb.FetchOptions{}
+		// Options this server no longer knows are left out.
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(r.Options, opts); err == nil {
+			p.Options = opts
+		}
+	}
+	return p
+}
+
+// browserVersion returns the name and version of the browser of a WebDriver
+// session, e.g. "HeadlessChrome" and "126.0.6478.126".
+func browserVersion(ctx context.Context, driverURL, sessionID string) (string, string, error) {
+	var result struct {
+		Product string `json:"product"` // e.g. "HeadlessChrome/126.0.6478.126"
+	}
+	if err := devtoolsCommand(ctx, driverURL, sessionID, "Browser.getVersion", struct{}{}, &result); err != nil {
+		return "", "", err
+	}
+	name, version, _ := strings.Cut(result.Product, "/")
+	return name, version, nil
+}
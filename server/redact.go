@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	pb "downloadcache/pb"
+)
+
+const defaultRedaction = "[REDACTED]"
+
+// builtinRedactions are the patterns that can be redacted by name.  With
+// keep, the pattern's first group (e.g. a parameter name) is left in place
+// before the replacement.
+var builtinRedactions = map[string]struct {
+	pattern string
+	keep    bool
+}{
+	"email": {pattern: `[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`},
+	"phone": {pattern: `(?:\+\d{1,3}[\s.-]?)?\(?\b\d{3}\)?[\s.-]?\d{3}[\s.-]\d{4}\b`},
+	// The values of query parameters that commonly carry credentials, in
+	// plain URLs and in HTML attributes, where & is escaped.
+	"query_tokens": {
+		pattern: `(?i)((?:[?&]|&amp;)(?:access_token|api_?key|auth|code|key|passw(?:or)?d|secret|session(?:_?id)?|sid|sig|signature|token)=)[^&#\s"'<>]+`,
+		keep:    true,
+	},
+}
+
+// RedactConfig configures a built-in processor that replaces sensitive text,
+// such as email addresses, with a placeholder.
+type RedactConfig struct {
+	Patterns    []string `json:"patterns"`    // Built-in patterns: email, phone, query_tokens
+	Regexps     []string `json:"regexps"`     // Extra patterns, in Go regexp syntax
+	Replacement string   `json:"replacement"` // Defaults to "[REDACTED]"
+}
+
+// redactor replaces text matching a set of patterns.
+type redactor struct {
+	rules []redactRule
+}
+
+type redactRule struct {
+	re       *regexp.Regexp
+	template string // Expanded for each match, as in regexp.ReplaceAll
+}
+
+// newRedactor compiles a redaction config.
+func newRedactor(c RedactConfig) (*redactor, error) {
+	replacement := c.Replacement
+	if replacement == "" {
+		replacement = defaultRedaction
+	}
+	replacement = strings.ReplaceAll(replacement, "$", "$$")
+
+	r := &redactor{}
+	for _, name := range c.Patterns {
+		builtin, ok := builtinRedactions[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown redaction pattern %q (want %s)", name, strings.Join(redactionNames(), ", "))
+		}
+		rule := redactRule{re: regexp.MustCompile(builtin.pattern), template: replacement}
+		if builtin.keep {
+			rule.template = "${1}" + replacement
+		}
+		r.rules = append(r.rules, rule)
+	}
+	for _, expr := range c.Regexps {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction regexp %q: %w", expr, err)
+		}
+		r.rules = append(r.rules, redactRule{re: re, template: replacement})
+	}
+	if len(r.rules) == 0 {
+		return nil, fmt.Errorf("redaction has no patterns")
+	}
+	return r, nil
+}
+
+func redactionNames() []string {
+	names := make([]string, 0, len(builtinRedactions))
+	for name := range builtinRedactions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// redact returns b with every match replaced.
+func (r *redactor) redact(b []byte) []byte {
+	for _, rule := range r.rules {
+		b = rule.re.ReplaceAll(b, []byte(rule.template))
+	}
+	return b
+}
+
+// Process redacts page content, as a pipeline processor.
+func (r *redactor) Process(_ context.Context, _ pb.ProcessingStage, _ string, content []byte) ([]byte, error) {
+	return r.redact(content), nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/status"
+)
+
+// staticMaxBytes caps pages fetched without rendering.
+const staticMaxBytes = 32 << 20
+
+// rendererDown is the error of a fetch that couldn't open a browser
+// session, because the Selenium grid or the local chromedriver workers are
+// down or have no room.
+type rendererDown struct {
+	err error
+}
+
+func (e *rendererDown) Error() string              { return e.err.Error() }
+func (e *rendererDown) Unwrap() error              { return e.err }
+func (e *rendererDown) GRPCStatus() *status.Status { return status.Convert(e.err) }
+
+// renderModeFetcher fetches pages by their render_mode: STATIC pages over
+// plain HTTP, and the rest with the browser.  In AUTO mode, pages are fetched
+// over plain HTTP while the browser is down, unless only the browser can
+// fetch them as asked.
+type renderModeFetcher struct {
+	browser Fetcher
+	static  *httpFetcher
+	logger  *levelLogger
+}
+
+func (f *renderModeFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	switch opts.GetRenderMode() {
+	case pb.RenderMode_RENDER_MODE_STATIC:
+		return f.static.Fetch(ctx, rawURL, opts)
+	case pb.RenderMode_RENDER_MODE_JS:
+		return f.browser.Fetch(ctx, rawURL, opts)
+	}
+	result, err := f.browser.Fetch(ctx, rawURL, opts)
+	var down *rendererDown
+	if err == nil || !errors.As(err, &down) || needsBrowser(opts) || opts.GetStealth() || ctx.Err() != nil {
+		return result, err
+	}
+	f.logger.Requestf(ctx, "Warning: fetching %s without rendering: %v", rawURL, err)
+	return f.static.Fetch(ctx, rawURL, opts)
+}
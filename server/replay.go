@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Replay modes that can be named in Config.ReplayMode.
+const (
+	replayModeRecord = "record"
+	replayModeReplay = "replay"
+)
+
+// replayLayout names bundle files after the SHA-256 of their URL, so any URL
+// makes a valid file name.
+var replayLayout = cacheLayout{keyScheme: keySchemeSHA256}
+
+// replayRecord is one fetch in a replay bundle.  Bundles are directories of
+// these, one JSON file per URL, so they can be inspected, edited and checked
+// into version control.
+type replayRecord struct {
+	URL           string        `json:"url"`
+	RecordedAt    time.Time     `json:"recorded_at"`
+	Content       string        `json:"content"`
+	RedirectChain []RedirectHop `json:"redirect_chain,omitempty"`
+	ContentType   string        `json:"content_type,omitempty"`
+	// Binary holds the content instead of Content for documents that
+	// aren't text, which JSON strings can't carry.
+	Binary []byte `json:"binary,omitempty"`
+}
+
+func replayName(rawURL string) string {
+	return replayLayout.key(rawURL) + ".json"
+}
+
+// recordingFetcher saves every successful fetch to a replay bundle, so a
+// pipeline run can later be repeated exactly with a replayFetcher.  Fetching
+// a URL again replaces its record.
+type recordingFetcher struct {
+	inner  Fetcher
+	bundle Storage
+}
+
+func (r *recordingFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	result, err := r.inner.Fetch(ctx, rawURL, opts)
+	if err != nil {
+		return nil, err
+	}
+	rec := replayRecord{
+		URL:           rawURL,
+		RecordedAt:    time.Now(),
+		RedirectChain: result.RedirectChain,
+		ContentType:   result.ContentType,
+	}
+	if isText(result.ContentType) {
+		rec.Content = string(result.Content)
+	} else {
+		rec.Binary = result.Content
+	}
+	data, err := json.Marshal(rec)
+	if err == nil {
+		err = r.bundle.Write(replayName(rawURL), data)
+	}
+	if err != nil {
+		// A page missing from the bundle would make the replay fail later, so
+		// fail now rather than hand out a page that can't be replayed.
+		return nil, status.Errorf(codes.Internal, "failed to record %s: %v", rawURL, err)
+	}
+	return result, nil
+}
+
+// replayFetcher serves pages from a replay bundle and never touches the
+// network.  URLs that weren't recorded fail with NotFound.
+type replayFetcher struct {
+	bundle Storage
+}
+
+func (r *replayFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (*FetchResult, error) {
+	data, err := r.bundle.Read(replayName(rawURL))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "%s is not in the replay bundle", rawURL)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read %s from the replay bundle: %v", rawURL, err)
+	}
+	var rec replayRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, status.Errorf(codes.Internal, "corrupt replay record for %s: %v", rawURL, err)
+	}
+	result := &FetchResult{Content: []byte(rec.Content), RedirectChain: rec.RedirectChain, ContentType: rec.ContentType, Receipt: &FetchReceipt{Fetcher: fetcherReplay}}
+	if !isText(rec.ContentType) {
+		result.Content = rec.Binary
+	}
+	return result, nil
+}
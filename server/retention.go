@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+const defaultRetentionInterval = time.Hour
+
+// RetentionConfig is the RETENTION_CONFIG file: how long entries may be kept,
+// by domain and tenant, and where deletions are recorded.
+type RetentionConfig struct {
+	Rules    []RetentionRule `json:"rules"`
+	AuditLog string          `json:"audit_log"` // JSON lines file each deletion is appended to; empty only logs them
+}
+
+// RetentionRule deletes matching entries a number of days after they were
+// fetched, however recently they were accessed.  Where several rules match
+// an entry, the shortest applies.
+type RetentionRule struct {
+	Name       string   `json:"name"`         // Recorded in the audit log
+	Domains    []string `json:"domains"`      // Hostnames, including their subdomains; empty means every domain
+	Tenants    []string `json:"tenants"`      // Tenants, or "" for shared entries; empty means every entry
+	MaxAgeDays int      `json:"max_age_days"` // Days after fetching an entry is deleted
+}
+
+// retentionAudit is one line of the retention audit log.
+type retentionAudit struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	URL       string    `json:"url"`
+	Tenant    string    `json:"tenant,omitempty"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Rule      string    `json:"rule"`
+}
+
+// loadRetentionConfig reads the retention rules from a JSON file.
+func loadRetentionConfig(path string) (*RetentionConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c RetentionConfig
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid retention config %s: %w", path, err)
+	}
+	for i, r := range c.Rules {
+		if r.Name == "" {
+			c.Rules[i].Name = fmt.Sprintf("rule %d", i+1)
+		}
+		if r.MaxAgeDays <= 0 {
+			return nil, fmt.Errorf("invalid retention config %s: %s: max_age_days must be positive", path, c.Rules[i].Name)
+		}
+	}
+	return &c, nil
+}
+
+// ruleFor returns the rule that deletes an entry soonest, or nil if none
+// applies.
+func (c *RetentionConfig) ruleFor(md *entryMetadata) *RetentionRule {
+	var rule *RetentionRule
+	host := hostOf(md.URL)
+	for i, r := range c.Rules {
+		if len(r.Domains) > 0 && !matchesDomain(host, r.Domains) {
+			continue
+		}
+		if len(r.Tenants) > 0 && !slices.Contains(r.Tenants, md.Tenant) {
+			continue
+		}
+		if rule == nil || r.MaxAgeDays < rule.MaxAgeDays {
+			rule = &c.Rules[i]
+		}
+	}
+	return rule
+}
+
+// expired reports whether an entry has outlived the rule.
+func (r *RetentionRule) expired(md *entryMetadata, now time.Time) bool {
+	return !md.FetchedAt.IsZero() && now.Sub(md.FetchedAt) > time.Duration(r.MaxAgeDays)*24*time.Hour
+}
+
+// runRetention deletes expired entries every interval until ctx is done.
+func (s *Server) runRetention(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			done, err := s.maintenance.enter()
+			if err != nil {
+				continue // Skip passes during maintenance.
+			}
+			if err := s.enforceRetention(); err != nil {
+				s.logger.Printf("Error: retention pass failed: %v", err)
+			}
+			done()
+		}
+	}
+}
+
+// enforceRetention deletes every entry, alias and cold entry past its
+// retention rule, recording each deletion in the audit log.
+func (s *Server) enforceRetention() error {
+	var audit *json.Encoder
+	if s.retention.AuditLog != "" {
+		f, err := os.OpenFile(s.retention.AuditLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to open retention audit log: %w", err)
+		}
+		defer f.Close()
+		audit = json.NewEncoder(f)
+	}
+
+	now := time.Now()
+	deleted := 0
+	expire := func(cacheKey string, md *entryMetadata) error {
+		rule := s.retention.ruleFor(md)
+		if rule == nil || md.LegalHold || !rule.expired(md, now) {
+			return nil
+		}
+		if ok, err := s.deleteExpired(cacheKey, rule, now); err != nil {
+			s.logger.Printf("Error: failed to delete %s past retention: %v", md.URL, err)
+			return nil
+		} else if !ok {
+			return nil
+		}
+		s.logger.Printf("Retention: deleted %s, fetched %v, under %s", md.URL, md.FetchedAt, rule.Name)
+		if audit != nil {
+			record := retentionAudit{DeletedAt: now, URL: md.URL, Tenant: md.Tenant, FetchedAt: md.FetchedAt, Rule: rule.Name}
+			if err := audit.Encode(record); err != nil {
+				return fmt.Errorf("failed to write retention audit log: %w", err)
+			}
+		}
+		deleted++
+		return nil
+	}
+
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil {
+			return nil
+		}
+		return expire(cacheKey, md)
+	})
+	if err == nil {
+		err = walkDetachedMetadata(s.storage, func(name string, md *entryMetadata) error {
+			return expire(md.key(s.layout), md)
+		})
+	}
+	s.logger.Printf("Retention pass finished: deleted %d entries", deleted)
+	return err
+}
+
+// deleteExpired deletes an entry's content, wherever it is, and metadata.
+// It reports false if, by the time it holds the entry lock, the entry is
+// gone or has been refetched.
+func (s *Server) deleteExpired(cacheKey string, rule *RetentionRule, now time.Time) (bool, error) {
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if md.LegalHold || !rule.expired(md, now) {
+		return false, nil
+	}
+	return true, s.removeEntry(cacheKey, md)
+}
+
+// removeEntry deletes an entry's content, wherever it is, and metadata.
+// The caller holds the entry lock.
+func (s *Server) removeEntry(cacheKey string, md *entryMetadata) error {
+	if md.Cold && s.coldStore != nil {
+		if err := s.coldStore.Delete(coldObjectName(md)); err != nil {
+			return err
+		}
+	}
+	if err := s.storage.Remove(s.contentName(cacheKey)); err != nil {
+		return err
+	}
+	if md.AXTree {
+		if err := s.storage.Remove(s.layout.axTreeName(cacheKey)); err != nil {
+			return err
+		}
+	}
+	if md.Thumbnail {
+		if err := s.storage.Remove(s.layout.thumbnailName(cacheKey)); err != nil {
+			return err
+		}
+	}
+	s.untrackEntry(cacheKey)
+	return s.storage.Remove(s.layout.metadataName(cacheKey))
+}
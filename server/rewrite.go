@@ -0,0 +1,124 @@
+package server
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// urlAttributes lists the attributes holding a single URL that rewriteURLs
+// makes absolute.  srcset is handled separately.
+var urlAttributes = map[string]bool{
+	"href":       true,
+	"src":        true,
+	"action":     true,
+	"formaction": true,
+	"poster":     true,
+	"data":       true, // <object data>
+	"background": true,
+}
+
+// rewriteURLs makes the links and asset URLs in a page absolute, resolving
+// them against pageURL (or the page's <base href>), so the page can be viewed
+// away from its origin.  If linkPrefix is set, <a> and <area> links are
+// instead pointed at linkPrefix followed by the escaped absolute URL, so
+// navigation stays within a cache viewer.  URLs inside inline CSS and scripts
+// are left alone.  Content that isn't parseable is returned unchanged.
+func rewriteURLs(content []byte, pageURL, linkPrefix string) []byte {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return content
+	}
+
+	var out bytes.Buffer
+	out.Grow(len(content))
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		// Copy everything we don't modify byte for byte: re-serializing text
+		// would escape the contents of <script> and <style> elements.
+		raw := z.Raw()
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			out.Write(raw)
+			continue
+		}
+
+		t := z.Token()
+		if t.DataAtom == atom.Base {
+			// Later URLs resolve against <base href>, which is then dropped
+			// since every URL it would affect is now absolute.
+			for _, a := range t.Attr {
+				if a.Key == "href" {
+					if ref, err := base.Parse(strings.TrimSpace(a.Val)); err == nil {
+						base = ref
+					}
+				}
+			}
+			continue
+		}
+
+		changed := false
+		for i, a := range t.Attr {
+			var val string
+			switch {
+			case a.Key == "srcset":
+				val = rewriteSrcset(base, a.Val)
+			case urlAttributes[a.Key]:
+				val = absoluteURL(base, a.Val)
+				if linkPrefix != "" && a.Key == "href" && (t.DataAtom == atom.A || t.DataAtom == atom.Area) && isHTTP(val) {
+					val = linkPrefix + url.QueryEscape(val)
+				}
+			default:
+				continue
+			}
+			if val != a.Val {
+				t.Attr[i].Val = val
+				changed = true
+			}
+		}
+		if changed {
+			out.WriteString(t.String())
+		} else {
+			out.Write(raw)
+		}
+	}
+	return out.Bytes()
+}
+
+// absoluteURL resolves ref against base.  Fragment-only references and
+// non-hierarchical URLs (javascript:, data:, mailto:) are returned unchanged.
+func absoluteURL(base *url.URL, ref string) string {
+	trimmed := strings.TrimSpace(ref)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return ref
+	}
+	u, err := base.Parse(trimmed)
+	if err != nil || u.Opaque != "" {
+		return ref
+	}
+	return u.String()
+}
+
+// rewriteSrcset resolves each candidate URL in a srcset attribute.
+func rewriteSrcset(base *url.URL, srcset string) string {
+	candidates := strings.Split(srcset, ",")
+	for i, c := range candidates {
+		fields := strings.Fields(c)
+		if len(fields) == 0 {
+			continue
+		}
+		fields[0] = absoluteURL(base, fields[0])
+		candidates[i] = strings.Join(fields, " ")
+	}
+	return strings.Join(candidates, ", ")
+}
+
+func isHTTP(rawURL string) bool {
+	return strings.HasPrefix(rawURL, "http://") || strings.HasPrefix(rawURL, "https://")
+}
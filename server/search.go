@@ -0,0 +1,395 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	pb "downloadcache/pb"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+	maxSearchTerms        = 16
+	minTermBytes          = 2
+	maxTermBytes          = 64
+	// snippetBefore and snippetAfter bound the text around the first match
+	// returned as a result's snippet.
+	snippetBefore = 80
+	snippetAfter  = 160
+)
+
+// searchIndex is an in-memory full-text index of the cached pages' text,
+// for Search.  It is built from the cache at startup and kept up to date as
+// pages are stored.  Entries removed since are found stale when they match
+// a search, and dropped then.
+type searchIndex struct {
+	mu       sync.RWMutex
+	next     uint32
+	docs     map[uint32]*searchDoc
+	byKey    map[string]uint32
+	postings map[string]map[uint32]uint16 // Term to the documents with it, and how often
+
+	ready atomic.Bool // Set once the cache has been indexed
+}
+
+// searchDoc is an indexed page.
+type searchDoc struct {
+	key       string
+	url       string
+	host      string
+	title     string
+	fetchedAt time.Time
+	terms     []string // Its distinct terms, to remove it with
+	length    int      // Its number of terms
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		docs:     make(map[uint32]*searchDoc),
+		byKey:    make(map[string]uint32),
+		postings: make(map[string]map[uint32]uint16),
+	}
+}
+
+// add indexes the text of the entry under a cache key, replacing what was
+// indexed for it before.
+func (x *searchIndex) add(cacheKey string, md *entryMetadata, title, text string) {
+	freqs := make(map[string]uint16)
+	length := 0
+	forEachTerm(text, func(term string, _ int) {
+		if freqs[term] < math.MaxUint16 {
+			freqs[term]++
+		}
+		length++
+	})
+	doc := &searchDoc{
+		key:       cacheKey,
+		url:       md.URL,
+		host:      hostOf(md.contentURL()),
+		title:     title,
+		fetchedAt: md.FetchedAt,
+		terms:     make([]string, 0, len(freqs)),
+		length:    length,
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if id, ok := x.byKey[cacheKey]; ok {
+		x.removeLocked(id)
+	}
+	x.next++
+	id := x.next
+	for term, n := range freqs {
+		docs := x.postings[term]
+		if docs == nil {
+			docs = make(map[uint32]uint16)
+			x.postings[term] = docs
+		}
+		docs[id] = n
+		doc.terms = append(doc.terms, term)
+	}
+	x.docs[id] = doc
+	x.byKey[cacheKey] = id
+}
+
+// remove drops a document if it is still the one indexed for its key.
+func (x *searchIndex) remove(id uint32) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.removeLocked(id)
+}
+
+func (x *searchIndex) removeLocked(id uint32) {
+	doc, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	for _, term := range doc.terms {
+		delete(x.postings[term], id)
+		if len(x.postings[term]) == 0 {
+			delete(x.postings, term)
+		}
+	}
+	delete(x.docs, id)
+	if x.byKey[doc.key] == id {
+		delete(x.byKey, doc.key)
+	}
+}
+
+// searchHit is a document matching a search.
+type searchHit struct {
+	id    uint32
+	doc   *searchDoc
+	score float64
+}
+
+// search returns the documents with every term that pass keep, best first,
+// scored by TF-IDF.
+func (x *searchIndex) search(terms []string, keep func(*searchDoc) bool) []searchHit {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	lists := make([]map[uint32]uint16, 0, len(terms))
+	for _, term := range terms {
+		docs := x.postings[term]
+		if len(docs) == 0 {
+			return nil
+		}
+		lists = append(lists, docs)
+	}
+	// Walk the rarest term's documents, checking the others.
+	sort.Slice(lists, func(i, j int) bool { return len(lists[i]) < len(lists[j]) })
+	total := float64(len(x.docs))
+	var hits []searchHit
+	for id := range lists[0] {
+		doc := x.docs[id]
+		if !keep(doc) {
+			continue
+		}
+		score := 0.0
+		for _, docs := range lists {
+			n, ok := docs[id]
+			if !ok {
+				score = -1
+				break
+			}
+			idf := math.Log(1 + total/float64(len(docs)))
+			score += float64(n) / float64(doc.length) * idf
+		}
+		if score >= 0 {
+			hits = append(hits, searchHit{id: id, doc: doc, score: score})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		return hits[i].id < hits[j].id
+	})
+	return hits
+}
+
+// forEachTerm calls fn with each word of text, lowercased, and its offset.
+// Words are runs of letters and digits; very short and very long ones are
+// skipped.
+func forEachTerm(text string, fn func(term string, start int)) {
+	start := -1
+	for i, r := range text {
+		word := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if word && start < 0 {
+			start = i
+		} else if !word && start >= 0 {
+			emitTerm(text[start:i], start, fn)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		emitTerm(text[start:], start, fn)
+	}
+}
+
+func emitTerm(word string, start int, fn func(string, int)) {
+	if len(word) >= minTermBytes && len(word) <= maxTermBytes {
+		fn(strings.ToLower(word), start)
+	}
+}
+
+// pageText returns the title and visible text of a page.  Documents that
+// aren't HTML are their own text.
+func pageText(contentType string, content []byte) (title, text string) {
+	if !isHTML(contentType) {
+		return "", string(content)
+	}
+	var b strings.Builder
+	skip := 0 // Depth inside elements whose text isn't shown
+	inTitle := false
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return strings.TrimSpace(title), b.String()
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Script, atom.Style, atom.Noscript, atom.Template:
+				skip++
+			case atom.Title:
+				inTitle = true
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Script, atom.Style, atom.Noscript, atom.Template:
+				skip = max(skip-1, 0)
+			case atom.Title:
+				inTitle = false
+			}
+		case html.TextToken:
+			if inTitle {
+				title += string(z.Text())
+			} else if skip == 0 {
+				b.Write(z.Text())
+				b.WriteByte(' ')
+			}
+		}
+	}
+}
+
+// snippet returns the text around the first occurrence of a term.
+func snippet(text string, terms []string) string {
+	at := -1
+	forEachTerm(text, func(term string, start int) {
+		if at < 0 && slices.Contains(terms, term) {
+			at = start
+		}
+	})
+	if at < 0 {
+		return ""
+	}
+	from, to := max(at-snippetBefore, 0), min(at+snippetAfter, len(text))
+	for from > 0 && !utf8.RuneStart(text[from]) {
+		from--
+	}
+	for to < len(text) && !utf8.RuneStart(text[to]) {
+		to++
+	}
+	return strings.Join(strings.Fields(text[from:to]), " ")
+}
+
+// indexText adds a stored page to the search index, if there is one.
+// Tenants' pages aren't indexed.
+func (s *Server) indexText(cacheKey string, md *entryMetadata, content []byte) {
+	if s.search == nil || md.Tenant != "" || md.AliasOf != "" || !isText(md.ContentType) {
+		return
+	}
+	title, text := pageText(md.ContentType, content)
+	s.search.add(cacheKey, md, title, text)
+}
+
+// buildSearchIndex indexes the pages in the cache.
+func (s *Server) buildSearchIndex(ctx context.Context) {
+	start := time.Now()
+	indexed := 0
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil || md.Tenant != "" || md.AliasOf != "" || !isText(md.ContentType) {
+			return nil
+		}
+		content, err := s.readContent(ctx, name, md)
+		if err != nil {
+			s.logger.Printf("Warning: failed to read %s for the search index: %v", md.URL, err)
+			return nil
+		}
+		s.indexText(cacheKey, md, content)
+		indexed++
+		return nil
+	})
+	if err != nil {
+		s.logger.Printf("Error: failed to build the search index: %v", err)
+		return
+	}
+	s.search.ready.Store(true)
+	s.logger.Printf("Search index built: %d pages in %v", indexed, time.Since(start).Round(time.Millisecond))
+}
+
+// Search handles the gRPC request.
+func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	if s.search == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "SEARCH_INDEX is not enabled")
+	}
+	var terms []string
+	forEachTerm(req.GetQuery(), func(term string, _ int) {
+		if !slices.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	})
+	if len(terms) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "query must contain a word of at least %d characters", minTermBytes)
+	}
+	if len(terms) > maxSearchTerms {
+		return nil, status.Errorf(codes.InvalidArgument, "query can have at most %d words", maxSearchTerms)
+	}
+	if req.GetPageSize() < 0 || req.GetPageSize() > maxSearchPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", maxSearchPageSize)
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+	offset := 0
+	if token := req.GetPageToken(); token != "" {
+		var err error
+		if offset, err = strconv.Atoi(token); err != nil || offset < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+	domain := strings.ToLower(strings.TrimSuffix(req.GetDomain(), "."))
+	var after, before time.Time
+	if req.GetFetchedAfter() != nil {
+		after = req.GetFetchedAfter().AsTime()
+	}
+	if req.GetFetchedBefore() != nil {
+		before = req.GetFetchedBefore().AsTime()
+	}
+	s.logger.Requestf(ctx, "Received search: %q", req.GetQuery())
+
+	hits := s.search.search(terms, func(doc *searchDoc) bool {
+		if domain != "" && doc.host != domain && !strings.HasSuffix(doc.host, "."+domain) {
+			return false
+		}
+		if !after.IsZero() && doc.fetchedAt.Before(after) {
+			return false
+		}
+		return before.IsZero() || doc.fetchedAt.Before(before)
+	})
+	resp := &pb.SearchResponse{Indexing: !s.search.ready.Load()}
+	for i := offset; i < len(hits) && len(resp.Results) < pageSize; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		doc := hits[i].doc
+		md, err := s.readMetadata(doc.key)
+		if err != nil || !md.FetchedAt.Equal(doc.fetchedAt) || !s.entryExists(doc.key) {
+			s.search.remove(hits[i].id) // Removed or replaced since.
+			continue
+		}
+		result := &pb.SearchResult{
+			Url:       doc.url,
+			Title:     doc.title,
+			FetchedAt: timestamppb.New(doc.fetchedAt),
+			Score:     hits[i].score,
+		}
+		if content, err := s.readContent(ctx, s.contentName(doc.key), md); err == nil {
+			_, text := pageText(md.ContentType, content)
+			result.Snippet = snippet(text, terms)
+		}
+		resp.Results = append(resp.Results, result)
+		if len(resp.Results) == pageSize && i+1 < len(hits) {
+			resp.NextPageToken = strconv.Itoa(i + 1)
+		}
+	}
+	return resp, nil
+}
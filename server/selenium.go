@@ -0,0 +1,437 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"downloadcache/driverpool"
+	pb "downloadcache/pb"
+
+	"github.com/tebeka/selenium"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// seleniumFetcher renders pages in Chrome over WebDriver, either on a remote
+// Selenium grid or on local chromedriver workers.
+type seleniumFetcher struct {
+	url    string            // The remote Selenium instance
+	chrome *chromeSupervisor // Local chromedriver workers, used instead of url if set
+	load   *renderLoad
+	record func(error) // Called with the outcome of WebDriver calls, for alerting
+	logger *levelLogger
+
+	resolver  *hostResolver     // Host overrides and DNS server; nil uses the browser's resolver
+	bandwidth *bandwidthLimiter // nil if downloads are unlimited
+	pool      *driverpool.Pool  // Sessions kept between fetches; nil opens one per fetch
+
+	thumbnailWidth int // Width of the thumbnails taken of pages; zero takes none
+}
+
+func (f *seleniumFetcher) Fetch(ctx context.Context, rawURL string, opts *pb.FetchOptions) (_ *FetchResult, err error) {
+	// --- Selenium Session Management ---
+	// Create a new WebDriver session for this specific request, or borrow
+	// one opened with the same capabilities from the pool.
+	caps := selenium.Capabilities{"browserName": "chrome"}
+	chromeCaps := map[string]interface{}{
+		"args": []string{
+			"--headless",
+			"--no-sandbox",
+			"--disable-dev-shm-usage",
+			"--disable-gpu",
+		},
+	}
+	if f.resolver != nil {
+		arg, err := f.resolver.chromeArg(ctx, rawURL)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to resolve %s: %v", rawURL, err)
+		}
+		if arg != "" {
+			chromeCaps["args"] = append(chromeCaps["args"].([]string), arg)
+		}
+	}
+	pool := egressFrom(ctx)
+	var identity *egressIdentity
+	if pool != nil {
+		if identity = pool.pick(hostOf(rawURL), true); identity == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "egress pool %q has no proxy the browser can use", pool.name)
+		}
+		chromeCaps["args"] = append(chromeCaps["args"].([]string), "--proxy-server="+identity.proxy.String())
+	}
+	// Options passed through from the request or the domain config were
+	// validated when the request came in.
+	chromeCaps["args"] = append(chromeCaps["args"].([]string), opts.GetChromeArgs()...)
+	if opts.GetStealth() {
+		stealthCapabilities(chromeCaps)
+	}
+	if err := mergeJSONInto(chromeCaps, opts.GetChromeOptions()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chrome option: %v", err)
+	}
+	if err := mergeJSONInto(caps, opts.GetCapabilities()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid capability: %v", err)
+	}
+	caps["goog:chromeOptions"] = chromeCaps
+	// Performance logging exposes the DevTools network events, which is how
+	// we see the redirect chain the browser followed.
+	caps["goog:loggingPrefs"] = map[string]string{"performance": "ALL"}
+
+	timing := timingFrom(ctx)
+	f.load.update(1, 0)
+	wd, driverURL, release, err := f.openSession(ctx, caps, opts.GetFreshSession())
+	if err != nil {
+		f.load.update(-1, 0)
+		return nil, err
+	}
+	f.load.update(-1, 1)
+	closeSession := func(reusable bool) {
+		release(reusable)
+		f.load.update(0, -1)
+	}
+	// Use defer to ensure the session is always closed when this function
+	// exits, unless a navigation that wouldn't stop has been left to close it.
+	// A pooled session that fetched its page is reset and kept, unless it
+	// was made stealthy, which can't be undone.
+	abandoned := false
+	var visited []string // Origins whose storage the page may have used
+	defer func() {
+		if !abandoned {
+			closeSession(f.pool != nil && err == nil && !opts.GetStealth() && !opts.GetFreshSession() && f.resetSession(driverURL, wd, visited))
+		}
+	}()
+	// --- End of Session Management ---
+
+	// Once the browser has started loading the page, a failure can be
+	// debugged from what it shows.  Deferred after closing the session, so
+	// it runs first.
+	navigating := false
+	if opts.GetCaptureFailureArtifact() {
+		defer func() {
+			if err != nil && navigating && !abandoned && !errors.Is(ctx.Err(), context.Canceled) {
+				err = f.captureFailure(wd, err)
+			}
+		}()
+	}
+
+	// Emulate the requested network, throttled further to the session's
+	// share of the bandwidth limits.
+	conditions, profiled := networkProfiles[opts.GetNetworkProfile()]
+	if !profiled {
+		conditions = unthrottled
+	}
+	throttled := false
+	if f.bandwidth != nil {
+		share, done := f.bandwidth.browserShare(hostOf(rawURL))
+		defer done()
+		conditions, throttled = conditions.capped(share), true
+	}
+	if profiled || throttled {
+		if err := emulateNetwork(ctx, driverURL, wd.SessionID(), conditions); err != nil {
+			if profiled {
+				return nil, status.Errorf(codes.Internal, "failed to emulate network profile %v: %v", opts.GetNetworkProfile(), err)
+			}
+			f.logger.Printf("Warning: failed to throttle the browser for %s: %v", rawURL, err)
+		}
+	}
+
+	if opts.GetStealth() {
+		if err := applyStealth(ctx, driverURL, wd); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hide browser automation: %v", err)
+		}
+	}
+
+	if opts.GetDisableJavascript() {
+		if err := disableScripts(ctx, driverURL, wd.SessionID()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to disable JavaScript: %v", err)
+		}
+	}
+
+	// Performance metrics are only collected once enabled.
+	measured := true
+	if err := devtoolsCommand(ctx, driverURL, wd.SessionID(), "Performance.enable", struct{}{}, nil); err != nil {
+		f.logger.Printf("Warning: failed to enable performance metrics for %s: %v", rawURL, err)
+		measured = false
+	}
+
+	f.logger.Requestf(ctx, "Fetching URL with Selenium: %s", rawURL)
+	start := time.Now()
+	// WebDriver navigation can't be interrupted, so it runs on its own and
+	// a canceled request stops the page loading rather than waiting for it.
+	navigated := make(chan error, 1)
+	navigating = true
+	go func() { navigated <- wd.Get(rawURL) }()
+	select {
+	case err := <-navigated:
+		if err != nil {
+			f.record(err)
+			return nil, status.Errorf(codes.Internal, "failed to navigate to URL with Selenium %s: %v", rawURL, err)
+		}
+	case <-ctx.Done():
+		f.logger.Requestf(ctx, "Stopping navigation to %s: %v", rawURL, ctx.Err())
+		if !f.stopNavigation(driverURL, wd.SessionID(), navigated) {
+			// The navigation is stuck: force-quit the session, and close it
+			// once the navigation returns.
+			abandoned = true
+			f.forceQuit(driverURL, wd.SessionID())
+			go func() {
+				<-navigated
+				closeSession(false)
+			}()
+		}
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	timing.since(phaseNavigation, start)
+
+	// Optional: Wait for JS to render.
+	start = time.Now()
+	var waited []perfLogEvent
+	if opts.GetWaitStrategy() == pb.WaitStrategy_WAIT_STRATEGY_NETWORK_IDLE {
+		var err error
+		if waited, err = waitForNetworkIdle(ctx, wd, networkIdle(opts), renderWait(opts)); err != nil {
+			f.logger.Printf("Warning: failed to watch the network on %s, waiting the full render wait: %v", rawURL, err)
+			sleepContext(ctx, renderWait(opts)-time.Since(start))
+		}
+	} else {
+		sleepContext(ctx, renderWait(opts))
+	}
+	if opts.GetDismissConsent() && ctx.Err() == nil {
+		if found, err := dismissConsent(ctx, wd); err != nil {
+			f.logger.Printf("Warning: failed to dismiss cookie consent banners on %s: %v", rawURL, err)
+		} else if len(found) > 0 {
+			f.logger.Requestf(ctx, "Dismissed cookie consent banners on %s: %s", rawURL, strings.Join(found, ", "))
+		}
+	}
+	timing.since(phaseRenderWait, start)
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	start = time.Now()
+	defer timing.since(phaseCapture, start)
+	result := &FetchResult{Usage: &ResourceUsage{}, Receipt: egressReceipt(fetcherBrowser, pool, identity)}
+	if events, err := readPerformanceLog(wd); err != nil {
+		f.logger.Printf("Warning: failed to read performance log for %s: %v", rawURL, err)
+	} else {
+		events = append(waited, events...)
+		result.RedirectChain = redirectChainFromLog(events)
+		result.ContentType = contentTypeFromLog(events)
+		result.Usage.BytesTransferred = bytesTransferredFromLog(events)
+		result.TLS = tlsInfoFromLog(events)
+		visited = originsFromLog(events)
+	}
+	if result.TLS != nil && len(result.RedirectChain) > 0 {
+		// The log only has the server's certificate.
+		pageURL := result.RedirectChain[len(result.RedirectChain)-1].URL
+		if chain, err := certificateChain(ctx, driverURL, wd.SessionID(), pageURL); err != nil {
+			f.logger.Printf("Warning: failed to read the certificate chain of %s: %v", pageURL, err)
+		} else if len(chain) > 0 {
+			result.TLS.Certificates = chain
+		}
+	}
+	if name, version, err := browserVersion(ctx, driverURL, wd.SessionID()); err != nil {
+		f.logger.Printf("Warning: failed to read the browser version: %v", err)
+	} else {
+		result.Receipt.BrowserName, result.Receipt.BrowserVersion = name, version
+	}
+	if opts.GetCollectWebVitals() {
+		if result.Vitals, err = webVitals(wd); err != nil {
+			f.logger.Printf("Warning: failed to measure web vitals for %s: %v", rawURL, err)
+		}
+	}
+	if opts.GetCaptureAccessibilityTree() {
+		if result.AXTree, err = accessibilityTree(ctx, driverURL, wd.SessionID()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to capture the accessibility tree: %v", err)
+		}
+	}
+	if f.thumbnailWidth > 0 {
+		if screenshot, err := wd.Screenshot(); err != nil {
+			f.logger.Printf("Warning: failed to take a screenshot of %s: %v", rawURL, err)
+		} else if result.Thumbnail, err = thumbnail(screenshot, f.thumbnailWidth); err != nil {
+			f.logger.Printf("Warning: failed to make a thumbnail of %s: %v", rawURL, err)
+		}
+	}
+	if measured {
+		if err := pageMetrics(ctx, driverURL, wd.SessionID(), result.Usage); err != nil {
+			f.logger.Printf("Warning: failed to read performance metrics for %s: %v", rawURL, err)
+		}
+	}
+
+	if !isHTML(result.ContentType) {
+		if text, ok := viewerText(wd); ok {
+			result.Content = []byte(text)
+			return result, nil
+		}
+	}
+	if opts.GetInlineIframes() || opts.GetSerializeShadowRoots() {
+		if page, ok, err := serializePage(wd, opts); err != nil {
+			f.logger.Printf("Warning: failed to serialize iframes and shadow roots on %s: %v", rawURL, err)
+		} else if ok {
+			result.Content = []byte(page)
+			return result, nil
+		}
+	}
+	pageSource, err := wd.PageSource()
+	f.record(err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get page source from Selenium: %v", err)
+	}
+	result.Content = []byte(pageSource)
+	return result, nil
+}
+
+// viewerText returns the document Chrome is showing in its text viewer.
+// Chrome displays text documents such as JSON or plain text inside its own
+// HTML, with the document itself in a <pre>.
+func viewerText(wd selenium.WebDriver) (string, bool) {
+	v, err := wd.ExecuteScript(`const pre = document.querySelector("body > pre"); return pre ? pre.textContent : null;`, nil)
+	if err != nil {
+		return "", false
+	}
+	text, ok := v.(string)
+	return text, ok
+}
+
+// mergeJSONInto decodes JSON values into dst.
+func mergeJSONInto(dst map[string]interface{}, values map[string]string) error {
+	for name, value := range values {
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		dst[name] = v
+	}
+	return nil
+}
+
+// acquireDriver returns the WebDriver URL to open a session against, and a
+// function to call with the number of pages rendered once the session has
+// been closed.
+func (f *seleniumFetcher) acquireDriver(ctx context.Context) (string, func(pages int), error) {
+	if f.chrome == nil {
+		return f.url, func(int) {}, nil
+	}
+	w, err := f.chrome.acquire(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return w.url, func(pages int) { f.chrome.release(w, pages) }, nil
+}
+
+// stopNavigationTimeout bounds how long a canceled fetch waits for the
+// browser to stop loading a page.
+const stopNavigationTimeout = 5 * time.Second
+
+// stopNavigation stops a session's page loading, as the browser's stop
+// button does, and waits for the navigation to return.  It reports whether
+// the navigation returned in time.
+func (f *seleniumFetcher) stopNavigation(driverURL, sessionID string, navigated <-chan error) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), stopNavigationTimeout)
+	defer cancel()
+	if err := devtoolsCommand(ctx, driverURL, sessionID, "Page.stopLoading", struct{}{}, nil); err != nil {
+		f.logger.Printf("Warning: failed to stop page loading: %v", err)
+	}
+	select {
+	case <-navigated:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// forceQuit ends a session whose browser has stopped responding.  A local
+// worker is recycled, killing its browser; a remote session is deleted.
+func (f *seleniumFetcher) forceQuit(driverURL, sessionID string) {
+	if f.chrome != nil {
+		f.chrome.kill(driverURL, "a stuck navigation")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), stopNavigationTimeout)
+	defer cancel()
+	endpoint := strings.TrimSuffix(driverURL, "/") + "/session/" + sessionID
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		f.logger.Printf("Warning: failed to force-quit WebDriver session: %v", err)
+		return
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		f.logger.Printf("Warning: failed to force-quit WebDriver session: %v", err)
+		return
+	}
+	resp.Body.Close()
+}
+
+// pageMetrics reads what rendering the page has cost the browser so far
+// from DevTools' performance metrics, which must have been enabled.
+func pageMetrics(ctx context.Context, driverURL, sessionID string, usage *ResourceUsage) error {
+	var result struct {
+		Metrics []struct {
+			Name  string  `json:"name"`
+			Value float64 `json:"value"`
+		} `json:"metrics"`
+	}
+	if err := devtoolsCommand(ctx, driverURL, sessionID, "Performance.getMetrics", struct{}{}, &result); err != nil {
+		return err
+	}
+	for _, m := range result.Metrics {
+		switch m.Name {
+		case "TaskDuration": // Seconds
+			usage.CPUTime = time.Duration(m.Value * float64(time.Second))
+		case "JSHeapUsedSize":
+			usage.HeapBytes = int64(m.Value)
+		}
+	}
+	return nil
+}
+
+// emulateNetwork applies network conditions to a WebDriver session with
+// DevTools' network emulation.
+func emulateNetwork(ctx context.Context, driverURL, sessionID string, conditions networkConditions) error {
+	return devtoolsCommand(ctx, driverURL, sessionID, "Network.emulateNetworkConditions", conditions, nil)
+}
+
+// disableScripts stops the pages of a WebDriver session from running their
+// scripts.  Scripts run through WebDriver still do.
+func disableScripts(ctx context.Context, driverURL, sessionID string) error {
+	return devtoolsCommand(ctx, driverURL, sessionID, "Emulation.setScriptExecutionDisabled", map[string]bool{"value": true}, nil)
+}
+
+// devtoolsCommand sends a DevTools command to a WebDriver session, through
+// chromedriver's endpoint for sending DevTools commands, decoding its result
+// into result unless it is nil.
+func devtoolsCommand(ctx context.Context, driverURL, sessionID, cmd string, params, result any) error {
+	body, err := json.Marshal(map[string]any{
+		"cmd":    cmd,
+		"params": params,
+	})
+	if err != nil {
+		return err
+	}
+	endpoint := strings.TrimSuffix(driverURL, "/") + "/session/" + sessionID + "/goog/cdp/execute"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, msg)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(&struct {
+		Value any `json:"value"`
+	}{result})
+}
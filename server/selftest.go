@@ -0,0 +1,116 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// selfTestMarker is what the built-in self-test page's script writes
+	// into it.  It isn't in the page's source, so finding it in the
+	// rendered page shows the browser ran the script.
+	selfTestMarker = "downloadcache-self-test-42"
+	// selfTestTimeout bounds a self-test run by SelfTestCommand.
+	selfTestTimeout = 2 * time.Minute
+)
+
+// selfTestURL is the built-in self-test page, a data: URL, so it renders
+// without network access.
+var selfTestURL = "data:text/html," + url.PathEscape(`<!DOCTYPE html><html><head><title>downloadcache self-test</title></head>`+
+	`<body><p id="out">JavaScript did not run</p>`+
+	`<script>document.getElementById("out").textContent = "downloadcache-self-test-" + (6 * 7);</script></body></html>`)
+
+// SelfTest handles the gRPC request.
+func (s *Server) SelfTest(ctx context.Context, req *pb.SelfTestRequest) (*pb.SelfTestResponse, error) {
+	rawURL := req.GetUrl()
+	if rawURL == "" {
+		rawURL = selfTestURL
+	}
+	s.logger.Printf("Running self-test with %.100s", rawURL)
+	resp := &pb.SelfTestResponse{}
+	step := func(name string, run func() (string, error)) error {
+		start := time.Now()
+		detail, err := run()
+		if err != nil {
+			s.logger.Printf("Error: self-test failed at %s: %v", name, err)
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return status.Errorf(codes.Unavailable, "self-test failed at %s: %v", name, err)
+		}
+		resp.Steps = append(resp.Steps, &pb.SelfTestStep{Name: name, Duration: durationpb.New(time.Since(start)), Detail: detail})
+		return nil
+	}
+
+	var page *fetchedPage
+	err := step("render", func() (string, error) {
+		fetchOpts, _ := s.mergeOptions(&pb.DownloadCacheRequest{Url: rawURL})
+		var err error
+		if page, err = s.fetchPage(ctx, s.fetcher, rawURL, "", fetchOpts); err != nil {
+			return "", err
+		}
+		if len(page.content) == 0 {
+			return "", fmt.Errorf("the page rendered empty")
+		}
+		if rawURL == selfTestURL && !bytes.Contains(page.content, []byte(selfTestMarker)) {
+			return "", fmt.Errorf("the page's script did not run")
+		}
+		return fmt.Sprintf("%d bytes rendered, %d after processing", page.fetchedBytes, len(page.content)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = step("store", func() (string, error) {
+		done, err := s.maintenance.enter()
+		if err != nil {
+			return "skipped: in maintenance mode", nil
+		}
+		defer done()
+		// The page is written among the cache's entries, as a partial file,
+		// which everything walking the cache skips.
+		name := s.contentName(fmt.Sprintf("selftest-%d", time.Now().UnixNano())) + partialSuffix
+		defer s.storage.Remove(name)
+		md := *page.md
+		if err := s.writeContent(ctx, name, &md, page.content); err != nil {
+			return "", fmt.Errorf("failed to write: %v", err)
+		}
+		content, err := s.readContent(ctx, name, &md)
+		if err != nil {
+			return "", fmt.Errorf("failed to read back: %v", err)
+		}
+		if !bytes.Equal(content, page.content) {
+			return "", fmt.Errorf("read back %d bytes that differ from the %d written", len(content), len(page.content))
+		}
+		return fmt.Sprintf("%d bytes written and read back with codec %s", len(content), md.codec()), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Printf("Self-test passed")
+	return resp, nil
+}
+
+// SelfTestCommand runs a self-test, for cmd/server's --self-test flag,
+// returning an error if it fails.
+func (s *Server) SelfTestCommand(rawURL string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), selfTestTimeout)
+	defer cancel()
+	resp, err := s.SelfTest(ctx, &pb.SelfTestRequest{Url: rawURL})
+	if err != nil {
+		return err
+	}
+	for _, step := range resp.GetSteps() {
+		fmt.Printf("%s: ok in %v (%s)\n", step.GetName(), step.GetDuration().AsDuration().Round(time.Millisecond), step.GetDetail())
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	pb "downloadcache/pb"
+
+	"github.com/tebeka/selenium"
+)
+
+// serializePageScript serializes the page with what its markup leaves out.
+// With shadowRoots, each open shadow root is saved as a declarative shadow
+// root: a <template shadowrootmode> at the start of its host, which
+// browsers attach as the host's shadow root when they parse the page.
+// With iframes, the rendered content of each same-origin iframe is set as
+// the frame's srcdoc, which browsers show instead of its src; cross-origin
+// frames can't be read, and keep only their src.  Frames and shadow roots
+// nested in one another are serialized too.
+//
+// The page is copied into an inert document, so the copies don't run the
+// page's custom element constructors, and the live page is left as it is.
+// It returns null if there was nothing to add to the markup.
+const serializePageScript = `
+const opts = arguments[0];
+const inert = document.implementation.createHTMLDocument("");
+let added = 0;
+// addHidden adds to copy, a copy of live, what live's markup leaves out.
+const addHidden = (live, copy) => {
+  const lives = live.querySelectorAll("*");
+  const copies = copy.querySelectorAll("*");
+  lives.forEach((e, i) => {
+    if (opts.shadowRoots && e.shadowRoot) {
+      const template = inert.createElement("template");
+      template.setAttribute("shadowrootmode", e.shadowRoot.mode);
+      template.innerHTML = serializeChildren(e.shadowRoot);
+      copies[i].prepend(template);
+      added++;
+    }
+    if (opts.iframes && e.tagName === "IFRAME") {
+      let inner = null;
+      try { inner = e.contentDocument; } catch (err) {}
+      if (!inner || !inner.documentElement) return;
+      copies[i].setAttribute("srcdoc", serializeDocument(inner));
+      added++;
+    }
+  });
+};
+const serializeChildren = root => {
+  const copy = inert.createElement("div");
+  root.childNodes.forEach(n => copy.appendChild(inert.importNode(n, true)));
+  addHidden(root, copy);
+  return copy.innerHTML;
+};
+const serializeDocument = doc => {
+  const copy = inert.importNode(doc.documentElement, true);
+  addHidden(doc.documentElement, copy);
+  const doctype = doc.doctype ? new XMLSerializer().serializeToString(doc.doctype) + "\n" : "";
+  return doctype + copy.outerHTML;
+};
+const page = serializeDocument(document);
+return added ? page : null;`
+
+// serializePage returns the page's HTML with its open shadow roots and
+// same-origin iframes included as the fetch options ask, reporting whether
+// any were.
+func serializePage(wd selenium.WebDriver, opts *pb.FetchOptions) (string, bool, error) {
+	args := map[string]bool{"shadowRoots": opts.GetSerializeShadowRoots(), "iframes": opts.GetInlineIframes()}
+	v, err := wd.ExecuteScript(serializePageScript, []interface{}{args})
+	if err != nil {
+		return "", false, err
+	}
+	page, ok := v.(string)
+	return page, ok, nil
+}
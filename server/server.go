@@ -0,0 +1,939 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"downloadcache/driverpool"
+	pb "downloadcache/pb" // Adjust to your actual go module path
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tdewolff/minify/v2"
+	"github.com/tdewolff/minify/v2/html"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+)
+
+const httpFetchTimeout = 30 * time.Second
+
+// Server implements the DownloadCache gRPC service.  Create one with
+// NewServer and register it with pb.RegisterDownloadCacheServer.
+type Server struct {
+	pb.UnimplementedDownloadCacheServer
+	storage    Storage
+	minifier   *minify.M
+	fetcher    Fetcher           // Fetches pages on a cache miss
+	pipeline   *pipeline         // Processors that transform content; nil runs none
+	httpClient *http.Client      // Used for documents that don't need a browser, e.g. sitemaps
+	bandwidth  *bandwidthLimiter // Limits fetches' download rate; nil if unlimited
+	archive    *archiveFetcher   // Fetches pages whose sites are down; nil if no archive is configured
+	search     *searchIndex      // Full-text index of cached pages; nil if disabled
+	lru        *lruIndex         // Sizes and last accesses of entries, for eviction; nil if the cache is unbounded
+	prefetch   *prefetcher       // Fetches links of requested pages in the background
+	writer     *cacheWriter      // Stores fetched pages in the background; nil stores them on the request path
+	urlLocks   *urlLockManager   // Used to prevent concurrent downloads of the same URL
+	offline    atomic.Bool       // Serve from the cache only; see SetOfflineMode
+
+	maintenance maintenanceGate // Turns away storage writes; see SetMaintenanceMode
+	logger      *levelLogger
+
+	maxRedirects     int           // Navigations that follow more redirects than this are rejected
+	maxRenderWait    time.Duration // Longest render wait a request may ask for
+	ttl              time.Duration // Entries older than this are refetched; zero keeps them forever
+	fetchHardTimeout time.Duration // Fetches running longer are canceled, then abandoned; zero never
+	adaptiveTTL      *adaptiveTTL  // Learns entries' TTLs from how often they change; nil uses ttl
+	aliasCanonical   bool          // Store pages under their rel=canonical URL and alias the requested URL to it
+	codec            codec         // Compression used for new cache entries
+	layout           cacheLayout   // How cache keys map to files
+	gcInterval       time.Duration
+
+	retention         *RetentionConfig // Rules for deleting old entries; nil keeps them
+	retentionInterval time.Duration
+
+	coldStore       coldStore // Where idle entries are moved; nil disables tiering
+	coldAfter       time.Duration
+	tieringInterval time.Duration
+
+	dicts             *zstdDicts    // Compression dictionaries trained for domains
+	dictTrainInterval time.Duration // How often dictionaries are trained; zero never trains them
+
+	exportDir string // Where ExportCrawl saves exports
+
+	retryingRuns sync.Map // IDs of batches and warms whose failures are being retried
+
+	preloadEntries int // Most recently used entries read at startup
+
+	trashRetention time.Duration // How long replaced copies can be restored; zero keeps none
+
+	stats   serverStats
+	usage   usageTracker
+	crawls  crawlRegistry
+	jobs    jobRegistry
+	pauses  domainPauser // Pauses fetches from failing domains
+	alerter *alerter     // nil if no alerts are configured
+
+	load              renderLoad
+	autoscaleHeadroom float64 // Multiplier applied to peak demand when reporting desired sessions
+	loadCapacity      int     // Browser sessions the server can run at once; zero if unknown
+	calls             callRate
+	rpcMetrics        *grpcprom.ServerMetrics
+	renderMetrics     *renderMetrics
+	metrics           *prometheus.Registry // Everything MetricsHandler serves
+	watchdog          watchdog
+	health            *health.Server
+
+	compressMinBytes int   // Responses at least this large are compressed; zero leaves it to the client
+	maxMessageBytes  int64 // Larger Get responses are spilled; zero never spills
+	streamChunkBytes int   // Default size of GetStream chunks
+	batchConcurrency int   // Most URLs a GetBatch call gets at once
+	spills           spillStore
+
+	keys          KeyProvider // Tenants' encryption keys; nil rejects requests with a tenant
+	tenantCiphers sync.Map    // Tenant name to cipher.AEAD, once its key has been fetched
+
+	browser  *BrowserConfig // Browser settings requests may pass through, and per-domain ones; nil allows none
+	resolver *hostResolver  // Host overrides and DNS server for fetches; nil uses the system resolver
+	egress   *egressPools   // Pools fetches leave through; nil fetches directly
+
+	userAgent string // Sent with plain HTTP fetches; empty sends Go's
+
+	signingKey     []byte // Key for gateway signed URLs; empty leaves the gateway open
+	gatewayBaseURL string // Prefix for signed URLs, e.g. "https://cache.example.com"
+	cacheControl   string // Cache-Control directives for gateway responses; empty sends none
+}
+
+// NewServer creates a server from a configuration and options, which take
+// precedence over the configuration.  Call Start to run its background tasks.
+func NewServer(cfg Config, opts ...Option) (*Server, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	logLevel, err := parseLogLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	c, err := parseCodec(cfg.Codec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid codec: %w", err)
+	}
+	layout, err := parseLayout(cfg.KeyScheme, cfg.ShardDepth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache layout: %w", err)
+	}
+
+	m := minify.New()
+	m.AddFunc("text/html", html.Minify)
+
+	s := &Server{
+		minifier:   m,
+		httpClient: &http.Client{Timeout: httpFetchTimeout},
+		bandwidth:  newBandwidthLimiter(int64(cfg.BandwidthLimit), int64(cfg.DomainBandwidthLimit)),
+		logger:     newLevelLogger(log.Default()),
+		urlLocks:   newURLLockManager(),
+
+		rpcMetrics:    newRPCMetrics(),
+		renderMetrics: newRenderMetrics(),
+
+		ttl:              cfg.CacheTTL,
+		maxRedirects:     cfg.MaxRedirects,
+		maxRenderWait:    defaultMaxRenderWait,
+		fetchHardTimeout: cfg.FetchHardTimeout,
+		aliasCanonical:   cfg.AliasCanonical,
+		codec:            c,
+		layout:           layout,
+		gcInterval:       cfg.GCInterval,
+
+		pauses: domainPauser{threshold: cfg.PauseAfterFailures, cooldown: cfg.PauseCooldown},
+
+		exportDir: cfg.ExportDir,
+
+		preloadEntries: cfg.PreloadEntries,
+
+		trashRetention: cfg.TrashRetention,
+
+		retention:         cfg.Retention,
+		retentionInterval: cfg.RetentionInterval,
+
+		coldAfter:       cfg.ColdAfter,
+		tieringInterval: cfg.TieringInterval,
+
+		dictTrainInterval: cfg.DictTrainInterval,
+
+		autoscaleHeadroom: cfg.AutoscaleHeadroom,
+		loadCapacity:      cfg.loadCapacity(),
+		health:            newHealthServer(),
+
+		compressMinBytes: cfg.CompressMinBytes,
+		maxMessageBytes:  int64(cfg.MaxMessageBytes),
+		streamChunkBytes: cfg.StreamChunkBytes,
+		batchConcurrency: cfg.BatchConcurrency,
+
+		browser:  cfg.Browser,
+		resolver: newHostResolver(cfg.HostOverrides, cfg.DNSServer),
+
+		userAgent: cfg.UserAgent,
+
+		signingKey:     []byte(cfg.GatewaySigningKey),
+		gatewayBaseURL: cfg.GatewayBaseURL,
+		cacheControl:   cfg.GatewayCacheControl,
+	}
+	if s.resolver != nil {
+		s.httpClient.Transport = s.resolver.transport()
+	}
+	if s.egress, err = newEgressPools(cfg.Egress, s.resolver); err != nil {
+		return nil, err
+	}
+	if cfg.ArchiveURL != "" {
+		s.archive = &archiveFetcher{base: cfg.ArchiveURL, http: s.newHTTPFetcher(archiveMaxBytes)}
+	}
+	if cfg.SearchIndex {
+		s.search = newSearchIndex()
+	}
+	if cfg.MaxCacheBytes > 0 {
+		s.lru = newLRUIndex(int64(cfg.MaxCacheBytes))
+	}
+	s.metrics = s.newMetricsRegistry()
+	s.prefetch = newPrefetcher(cfg.PrefetchInterval)
+	if cfg.WriteWorkers > 0 {
+		s.writer = newCacheWriter(s, cfg.WriteWorkers, cfg.WriteQueueSize)
+	}
+	if cfg.AdaptiveTTLMax > 0 {
+		s.adaptiveTTL = newAdaptiveTTL(cfg.AdaptiveTTLMin, cfg.AdaptiveTTLMax)
+	}
+	if len(cfg.TenantKeys) > 0 {
+		s.keys = staticKeys(cfg.TenantKeys)
+	}
+	if len(cfg.LogRedact) > 0 {
+		if s.logger.redact, err = newRedactor(RedactConfig{Patterns: cfg.LogRedact}); err != nil {
+			return nil, fmt.Errorf("invalid log redaction: %w", err)
+		}
+	}
+	s.offline.Store(cfg.Offline)
+	s.logger.level.Store(int32(logLevel))
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	switch {
+	case s.storage != nil:
+	case cfg.Storage == storageMemory:
+		s.storage = NewMemoryStorage(int64(cfg.MemoryMaxBytes))
+		s.logger.Printf("Keeping the cache in memory; it will be lost on exit")
+	default:
+		if s.storage, err = newDirStorage(cfg.CacheDir, cfg.Fsync); err != nil {
+			return nil, fmt.Errorf("failed to create cache directory: %w", err)
+		}
+		s.logger.Printf("Cache directory initialized at: %s", cfg.CacheDir)
+	}
+	if s.dicts, err = loadDicts(s.storage); err != nil {
+		return nil, fmt.Errorf("failed to load compression dictionaries: %w", err)
+	}
+	if err := s.usage.load(s.storage); err != nil {
+		s.logger.Printf("Warning: failed to load usage counters, starting from zero: %v", err)
+	}
+	if cfg.ColdStorageDir != "" {
+		s.coldStore = dirColdStore{dir: cfg.ColdStorageDir}
+	}
+	if cfg.Alerts.enabled() {
+		s.alerter = newAlerter(cfg.Alerts, s.logger)
+	}
+
+	if len(cfg.Processors) > 0 {
+		p, err := newPipeline(cfg.Processors)
+		if err != nil {
+			return nil, err
+		}
+		s.pipeline = p
+		s.logger.Printf("Loaded %d content processors", len(cfg.Processors))
+	}
+
+	if s.fetcher == nil && cfg.ReplayMode != replayModeReplay {
+		if s.fetcher, err = s.newFetcher(cfg); err != nil {
+			return nil, err
+		}
+	}
+	switch cfg.ReplayMode {
+	case replayModeRecord:
+		bundle, err := NewDirStorage(cfg.ReplayBundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create replay bundle: %w", err)
+		}
+		s.fetcher = &recordingFetcher{inner: s.fetcher, bundle: bundle}
+		s.logger.Printf("Recording fetches to replay bundle %s", cfg.ReplayBundle)
+	case replayModeReplay:
+		if _, err := os.Stat(cfg.ReplayBundle); err != nil {
+			return nil, fmt.Errorf("failed to open replay bundle: %w", err)
+		}
+		s.fetcher = &replayFetcher{bundle: dirStorage{root: cfg.ReplayBundle}}
+		s.logger.Printf("Serving fetches only from replay bundle %s", cfg.ReplayBundle)
+	}
+	s.injectFaults(cfg.Faults)
+	return s, nil
+}
+
+// newFetcher creates the fetcher named by the configuration.
+func (s *Server) newFetcher(cfg Config) (Fetcher, error) {
+	switch {
+	case cfg.FetcherPluginAddr != "":
+		f, err := newPluginFetcher(cfg.FetcherPluginAddr)
+		if err != nil {
+			return nil, err
+		}
+		s.logger.Printf("Fetching pages with plugin at %s", cfg.FetcherPluginAddr)
+		return &renderModeFetcher{browser: f, static: s.newHTTPFetcher(staticMaxBytes), logger: s.logger}, nil
+	case cfg.SeleniumURL != "" || cfg.ChromedriverPath != "":
+		f := &seleniumFetcher{url: cfg.SeleniumURL, load: &s.load, record: s.recordSeleniumResult, logger: s.logger, resolver: s.resolver, bandwidth: s.bandwidth, thumbnailWidth: cfg.ThumbnailWidth}
+		if cfg.ChromedriverPath != "" {
+			f.chrome = startChromeSupervisor(context.Background(), cfg.ChromedriverPath, cfg.LocalChromeWorkers, cfg.ChromedriverBasePort, cfg.ChromeRecycle, s.logger)
+		}
+		if size := cfg.SessionPoolSize; size > 0 {
+			// A pooled session holds on to its local worker while idle.
+			if f.chrome != nil {
+				size = min(size, cfg.LocalChromeWorkers)
+			}
+			f.pool = driverpool.New(driverpool.Options{Size: size, MaxUses: cfg.SessionMaxUses, IdleTimeout: cfg.SessionIdleTimeout})
+			s.logger.Printf("Keeping up to %d WebDriver sessions open between fetches", size)
+		}
+		var browser Fetcher = f
+		if cfg.RawDownloadMaxBytes > 0 {
+			browser = &rawFetcher{inner: f, http: s.newHTTPFetcher(int64(cfg.RawDownloadMaxBytes)), logger: s.logger}
+		}
+		if cfg.Browser.hasEscalation() {
+			browser = &escalatingFetcher{inner: browser, http: s.newHTTPFetcher(escalationMaxBytes), browser: cfg.Browser, logger: s.logger}
+		}
+		return &renderModeFetcher{browser: browser, static: s.newHTTPFetcher(staticMaxBytes), logger: s.logger}, nil
+	default:
+		return nil, fmt.Errorf("no fetcher configured: SeleniumURL, ChromedriverPath or FetcherPluginAddr must be set")
+	}
+}
+
+// Start runs the configured background tasks (garbage collection, tiering,
+// retention, alerting, search indexing, eviction, preloading, prefetching
+// and saved crawls) until ctx is done.
+// It returns immediately.
+func (s *Server) Start(ctx context.Context) {
+	if s.gcInterval > 0 {
+		go s.runGarbageCollector(ctx, s.gcInterval)
+	}
+	if s.coldStore != nil {
+		go s.runTiering(ctx, s.tieringInterval, s.coldAfter)
+	}
+	if s.dictTrainInterval > 0 {
+		go s.runDictTraining(ctx, s.dictTrainInterval)
+	}
+	if s.retention != nil {
+		go s.runRetention(ctx, s.retentionInterval)
+	}
+	if s.search != nil {
+		go s.buildSearchIndex(ctx)
+	}
+	if s.lru != nil {
+		go s.runEviction(ctx, evictionInterval)
+	}
+	if s.trashRetention > 0 {
+		go s.runTrashPurge(ctx, trashPurgeInterval)
+	}
+	if s.preloadEntries > 0 {
+		go s.preloadHotEntries(ctx, s.preloadEntries)
+	}
+	go s.runUsageFlush(ctx, usageFlushInterval)
+	go s.runPrefetches(ctx)
+	go s.resumeCrawls(ctx)
+	if s.alerter != nil {
+		go s.runAlerts(ctx)
+	}
+}
+
+// sanitizeURLForFilename creates a safe filename from a URL.
+func sanitizeURLForFilename(rawURL string) string {
+	return url.PathEscape(rawURL)
+}
+
+// contentName returns the Storage name of the content file for a key.
+func (s *Server) contentName(cacheKey string) string {
+	return s.layout.entryName(cacheKey)
+}
+
+// entryExists reports whether a cache key has content, first pulling it back
+// from cold storage if it was moved there.
+func (s *Server) entryExists(cacheKey string) bool {
+	if _, err := s.storage.Stat(s.contentName(cacheKey)); err == nil {
+		return true
+	}
+	if s.coldStore == nil {
+		return false
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return false // Thawing writes to storage.
+	}
+	defer done()
+	if err := s.thaw(cacheKey); err != nil {
+		if !errors.Is(err, errNotCold) {
+			s.logger.Printf("Error: failed to restore %s from cold storage: %v", cacheKey, err)
+		}
+		return false
+	}
+	return true
+}
+
+// Get handles the gRPC request.
+func (s *Server) Get(ctx context.Context, req *pb.DownloadCacheRequest) (*pb.DownloadCacheResponse, error) {
+	ctx = s.logger.sampleRequest(ctx)
+	if req.GetDebug() {
+		ctx = withTiming(ctx)
+	}
+	fetchOpts, cacheOpts := s.mergeOptions(req)
+	s.logger.Requestf(ctx, "Received request for URL: %s, Invalidate: %v", req.GetUrl(), cacheOpts.GetInvalidate())
+
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := s.validateFetchOptions(fetchOpts); err != nil {
+		return nil, err
+	}
+	if err := validateCacheOptions(cacheOpts); err != nil {
+		return nil, err
+	}
+	// Domain defaults are trusted, so only the request's own settings are checked.
+	if err := s.browser.validateBrowserOptions(req.GetFetchOptions()); err != nil {
+		return nil, err
+	}
+	if req.GetLinkPrefix() != "" && !req.GetAbsoluteUrls() {
+		return nil, status.Errorf(codes.InvalidArgument, "link_prefix requires absolute_urls")
+	}
+	if req.GetMaxResponseBytes() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_response_bytes must not be negative")
+	}
+	if req.GetMaxMessageBytes() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_message_bytes must not be negative")
+	}
+	if n := req.GetPrefetchLinks(); n < 0 || n > maxPrefetchLinks {
+		return nil, status.Errorf(codes.InvalidArgument, "prefetch_links must be between 0 and %d", maxPrefetchLinks)
+	}
+	if req.GetMaxAgeSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_age_seconds must not be negative")
+	}
+	if req.GetChunkBytes() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "chunk_bytes must not be negative")
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return nil, err
+	}
+
+	ctx = withMaxAge(ctx, time.Duration(req.GetMaxAgeSeconds())*time.Second)
+	cacheKey := s.layout.tenantKey(req.GetTenant(), req.GetUrl())
+	if cacheOpts.GetInvalidate() && !cacheOpts.GetForce() && s.held(s.resolveAlias(cacheKey)) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is under legal hold; set cache_options.force to replace it", req.GetUrl())
+	}
+
+	// --- Cache Check ---
+	if !cacheOpts.GetInvalidate() {
+		contentKey := s.resolveAlias(cacheKey)
+		if s.entryExists(contentKey) {
+			s.logger.Requestf(ctx, "Cache HIT for URL: %s", req.GetUrl())
+			resp, err := s.cachedResponse(ctx, contentKey, req.GetTenant())
+			if errors.Is(err, errExpired) {
+				s.logger.Requestf(ctx, "Cache entry for URL %s has expired, proceeding to download", req.GetUrl())
+			} else if err != nil {
+				s.logger.Requestf(ctx, "Warning: failed to read from cache, proceeding to download: %v", err)
+			} else {
+				s.stats.recordHit(req.GetUrl())
+				s.usage.record(ctx, req.GetTenant(), func(c *usageCounters) { c.Hits++ })
+				return s.respond(ctx, req, resp)
+			}
+		}
+	}
+
+	if cacheOpts.GetCacheOnly() {
+		s.logger.Requestf(ctx, "Cache MISS for URL: %s, not fetching: cache_only requested", req.GetUrl())
+		return nil, status.Errorf(codes.NotFound, "%s is not cached", req.GetUrl())
+	}
+
+	// --- Download & Process ---
+	s.logger.Requestf(ctx, "Cache MISS or invalidation for URL: %s", req.GetUrl())
+	resp, err := s.downloadAndCache(ctx, req.GetUrl(), cacheKey, req.GetTenant(), fetchOpts, cacheOpts)
+	if err != nil {
+		s.stats.recordError(req.GetUrl())
+		return nil, err
+	}
+	if req.GetPrefetchLinks() > 0 && !cacheOpts.GetNoStore() && isHTML(resp.GetContentType()) {
+		s.queuePrefetches(ctx, req, resp, fetchOpts, cacheOpts)
+	}
+	return s.respond(ctx, req, resp)
+}
+
+// respond applies the per-request transforms and the before_respond
+// processors to a response.
+func (s *Server) respond(ctx context.Context, req *pb.DownloadCacheRequest, resp *pb.DownloadCacheResponse) (*pb.DownloadCacheResponse, error) {
+	content := contents(resp)
+	if req.GetAbsoluteUrls() && isHTML(resp.GetContentType()) {
+		pageURL := req.GetUrl()
+		if chain := resp.GetRedirectChain(); len(chain) > 0 {
+			pageURL = chain[len(chain)-1].GetUrl()
+		}
+		content = rewriteURLs(content, pageURL, req.GetLinkPrefix())
+	}
+
+	// Processors work on text; binary documents are passed through as they are.
+	text := isText(resp.GetContentType())
+	if text {
+		var err error
+		if content, err = s.pipeline.run(ctx, pb.ProcessingStage_PROCESSING_STAGE_BEFORE_RESPOND, req.GetUrl(), content); err != nil {
+			return nil, err
+		}
+	}
+	resp.TotalBytes = int64(len(content))
+	if limit := req.GetMaxResponseBytes(); limit > 0 && int64(len(content)) > limit {
+		if text {
+			content = truncateUTF8(content, int(limit))
+		} else {
+			content = content[:limit]
+		}
+		resp.Truncated = true
+	}
+	setContents(resp, content)
+	s.usage.record(ctx, req.GetTenant(), func(c *usageCounters) {
+		c.Requests++
+		c.BytesServed += int64(len(content))
+	})
+	resp.Timing = timingFrom(ctx).proto()
+	if err := s.spillLargeResponse(ctx, req, resp); err != nil {
+		return nil, err
+	}
+	s.compressLargeResponse(ctx, len(contents(resp)))
+	return resp, nil
+}
+
+// truncateUTF8 cuts b to at most n bytes without splitting a character, since
+// proto strings must be valid UTF-8.
+func truncateUTF8(b []byte, n int) []byte {
+	b = b[:n]
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				b = b[:i]
+			}
+			break
+		}
+	}
+	return b
+}
+
+// downloadAndCache handles the logic for downloading, processing, and caching
+// a URL, encrypting it for tenant if one is given.
+func (s *Server) downloadAndCache(ctx context.Context, rawURL, cacheKey, tenant string, fetchOpts *pb.FetchOptions, cacheOpts *pb.CacheOptions) (*pb.DownloadCacheResponse, error) {
+	// Lock per URL to ensure only one goroutine downloads a specific URL at a time.
+	timing := timingFrom(ctx)
+	lockStart := time.Now()
+	unlock := s.urlLocks.lock(rawURL)
+	timing.since(phaseLockWait, lockStart)
+	storing := false // Set once a background write has taken over the lock
+	defer func() {
+		if !storing {
+			unlock()
+		}
+	}()
+
+	// Double-check cache: another request might have finished while we waited for the lock.
+	if !cacheOpts.GetInvalidate() {
+		contentKey := s.resolveAlias(cacheKey)
+		if s.entryExists(contentKey) {
+			s.logger.Requestf(ctx, "Cache HIT (after lock) for URL: %s", rawURL)
+			resp, err := s.cachedResponse(ctx, contentKey, tenant)
+			if err == nil {
+				s.stats.recordHit(rawURL)
+				s.usage.record(ctx, tenant, func(c *usageCounters) { c.Hits++ })
+				return resp, nil
+			}
+		}
+	}
+
+	if err := s.checkOnline(rawURL); err != nil {
+		return nil, err
+	}
+	if err := s.checkPaused(rawURL); err != nil {
+		return nil, err
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if !storing {
+			done()
+		}
+	}()
+	fetchStart := time.Now()
+
+	fetchCtx, finish := s.startJob(ctx, pb.JobKind_JOB_KIND_FETCH, rawURL, tenant, nil)
+	defer finish()
+	page, err := s.watchFetch(fetchCtx, rawURL, func(ctx context.Context) (*fetchedPage, error) {
+		return s.fetchPage(ctx, s.fetcher, rawURL, tenant, fetchOpts)
+	})
+	if err != nil {
+		if fetchCtx.Err() != nil && ctx.Err() == nil {
+			return nil, status.Errorf(codes.Canceled, "the fetch of %s was canceled", rawURL)
+		}
+		return nil, err
+	}
+	// The response says what later cache hits will, however the page is
+	// stored.
+	s.settle(page, cacheKey, cacheOpts)
+	if s.writer != nil {
+		// A background write gets its own copy of the metadata, which
+		// storing fills in.
+		md := *page.md
+		written := *page
+		written.md = &md
+		storing = s.storeLater(ctx, &written, cacheKey, cacheOpts, func() { done(); unlock() })
+	}
+	if !storing {
+		s.store(ctx, page, cacheKey, cacheOpts)
+	}
+
+	fetchTime := time.Since(fetchStart)
+	s.stats.recordMiss(rawURL, fetchTime)
+	s.usage.record(ctx, tenant, func(c *usageCounters) {
+		c.Misses++
+		c.BytesFetched += int64(page.fetchedBytes)
+		c.FetchTime += fetchTime
+	})
+	return s.response(page.md, page.content), nil
+}
+
+// fetchedPage is a page that has been fetched and processed, ready to store.
+type fetchedPage struct {
+	md           *entryMetadata
+	content      []byte // As it will be stored
+	fetchedBytes int    // The size of the page as fetched
+	axTree       []byte // The page's accessibility tree, if captured
+	thumbnail    []byte // A JPEG thumbnail of the page, if taken
+	settled      bool   // Whether settle has filled in md
+}
+
+// fetchPage fetches a page with f and processes it for storage, for tenant
+// if one is given.
+func (s *Server) fetchPage(ctx context.Context, f Fetcher, rawURL, tenant string, fetchOpts *pb.FetchOptions) (*fetchedPage, error) {
+	timing := timingFrom(ctx)
+	if timing == nil {
+		// Receipts record the phases of every fetch, not only debug ones.
+		ctx = withTiming(ctx)
+		timing = timingFrom(ctx)
+	}
+	ctx = s.egress.withEgress(ctx, fetchOpts.GetEgressPool())
+	ctx = withFetchTenant(ctx, tenant)
+	started, before := time.Now(), timing.fetchPhases()
+	result, err := f.Fetch(ctx, rawURL, fetchOpts)
+	s.recordFetch(rawURL, err)
+	var archived *archivedPage
+	if fetchOpts.GetArchiveFallback() && originFailed(result, err) {
+		// If the site answered with an error page and the archive has no
+		// copy either, the error page is cached as usual.
+		var archiveErr error
+		if archived, archiveErr = s.fetchArchived(ctx, rawURL, fetchOpts, err); archiveErr == nil {
+			result, err = archived.result, nil
+		} else if err != nil {
+			err = archiveErr
+		}
+	}
+	if err != nil {
+		return nil, s.keepFailureArtifact(ctx, rawURL, tenant, err)
+	}
+	if result.Usage != nil {
+		timing.recordUsage(result.Usage)
+		s.renderMetrics.record(result.Usage)
+	}
+
+	md := &entryMetadata{URL: rawURL, FetchedAt: time.Now(), RedirectChain: result.RedirectChain, ContentType: result.ContentType, Tenant: tenant, WebVitals: result.Vitals, TLS: result.TLS}
+	md.Receipt = fetchReceipt(result, fetchOpts, started, timing.fetchPhases().since(before))
+	if archived != nil {
+		md.ArchivedAt, md.ArchiveURL = archived.archivedAt, archived.url
+	}
+	if redirects := len(md.RedirectChain) - 1; redirects > int(fetchOpts.GetMaxRedirects()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s followed %d redirects, exceeding the limit of %d", rawURL, redirects, fetchOpts.GetMaxRedirects())
+	}
+	bodyBytes := result.Content
+	if isText(md.ContentType) {
+		if bodyBytes, err = s.pipeline.run(ctx, pb.ProcessingStage_PROCESSING_STAGE_AFTER_FETCH, rawURL, bodyBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	// Minify the content.  Other documents, such as JSON, are kept as fetched.
+	minifiedBytes := bodyBytes
+	if isHTML(md.ContentType) {
+		minifyStart := time.Now()
+		minifiedBytes, err = s.minifier.Bytes("text/html", bodyBytes)
+		if err != nil {
+			s.logger.Printf("Warning: failed to minify content for %s, using original. Error: %v", rawURL, err)
+			minifiedBytes = bodyBytes // Fallback to original content
+		}
+		timing.since(phaseMinify, minifyStart)
+	}
+	if isText(md.ContentType) {
+		if minifiedBytes, err = s.pipeline.run(ctx, pb.ProcessingStage_PROCESSING_STAGE_BEFORE_STORE, rawURL, minifiedBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	// If the page names a different canonical URL on its own origin, it is
+	// stored under that URL and leaves an alias behind, so both URLs share
+	// one entry.  See settle for when the canonical URL is cached already.
+	if s.aliasCanonical && isHTML(md.ContentType) {
+		canonical := canonicalURL(md.finalURL(), minifiedBytes)
+		switch {
+		case canonical == "" || canonical == rawURL:
+		case !sameOrigin(md.finalURL(), canonical):
+			s.logger.Requestf(ctx, "Warning: not aliasing %s to canonical URL %s on another origin", rawURL, canonical)
+		default:
+			s.logger.Requestf(ctx, "Aliasing %s to canonical URL %s", rawURL, canonical)
+			md.CanonicalURL = canonical
+		}
+	}
+	md.Codec = s.codec
+	md.ContentHash = contentHash(minifiedBytes)
+	return &fetchedPage{md: md, content: minifiedBytes, fetchedBytes: len(result.Content), axTree: result.AXTree, thumbnail: result.Thumbnail}, nil
+}
+
+// storeKey returns the cache key a page fetched for cacheKey is stored
+// under: its canonical URL's, if it names one.
+func (s *Server) storeKey(md *entryMetadata, cacheKey string) string {
+	if md.CanonicalURL != "" {
+		return s.layout.tenantKey(md.Tenant, md.CanonicalURL)
+	}
+	return cacheKey
+}
+
+// settle fills in the metadata of a fetched page that depends on the copy
+// it replaces, its legal hold and learned TTL, and its annotations.  It is
+// done once per page, before it is answered or stored, so the response to
+// the fetch and later cache hits agree.  A page whose canonical URL is
+// cached from another page is kept under its own URL.
+func (s *Server) settle(page *fetchedPage, cacheKey string, cacheOpts *pb.CacheOptions) {
+	if page.settled {
+		return
+	}
+	md := page.md
+	storeKey := s.storeKey(md, cacheKey)
+	unlock := s.readLockEntry(storeKey)
+	if storeKey != cacheKey && s.canonicalTaken(storeKey, md) {
+		unlock()
+		s.logger.Printf("Not aliasing %s to canonical URL %s: it is cached from another page", md.URL, md.CanonicalURL)
+		md.CanonicalURL = ""
+		storeKey = cacheKey
+		unlock = s.readLockEntry(storeKey)
+	}
+	defer unlock()
+	prev, _ := s.readMetadata(storeKey)
+	md.LegalHold = prev != nil && prev.LegalHold
+	md.Annotations = cacheOpts.GetAnnotations()
+	if s.adaptiveTTL != nil {
+		md.TTL = s.adaptiveTTL.learn(prev, md, s.ttl)
+	}
+	page.settled = true
+}
+
+// storePage writes a fetched page to the cache under cacheKey, or under its
+// canonical URL with an alias at cacheKey.  Failures are logged; the page
+// can still be served.  A held entry is only replaced when forced, and the
+// replacement stays held.
+func (s *Server) storePage(ctx context.Context, page *fetchedPage, cacheKey string, cacheOpts *pb.CacheOptions) {
+	timing := timingFrom(ctx)
+	s.settle(page, cacheKey, cacheOpts)
+	md := page.md
+	storeKey := s.storeKey(md, cacheKey)
+
+	unlock := s.lockEntry(storeKey) // Keeps a concurrent SetLegalHold from being lost
+	defer unlock()
+	md.LegalHold = s.held(storeKey)
+	cacheFileName := s.contentName(storeKey)
+	if cacheOpts.GetNoStore() {
+		s.logger.Requestf(ctx, "Not caching content for %s: no_store requested", md.URL)
+	} else if storeKey != cacheKey && s.canonicalTaken(storeKey, md) {
+		s.logger.Requestf(ctx, "Not caching content for %s: its canonical URL %s has since been cached from another page", md.URL, md.CanonicalURL)
+	} else if md.LegalHold && !cacheOpts.GetForce() {
+		s.logger.Requestf(ctx, "Not caching content for %s: the cached copy is under legal hold", md.URL)
+	} else if err := s.trashReplaced(storeKey, cacheOpts); err != nil {
+		s.logger.Printf("Error: not replacing the cached copy of %s: failed to move it to the trash: %v", md.URL, err)
+	} else if err := s.writeContent(ctx, cacheFileName, md, page.content); err != nil {
+		s.logger.Printf("Error: failed to write to cache file %s: %v", cacheFileName, err)
+	} else {
+		s.logger.Requestf(ctx, "Successfully cached content for %s", md.URL)
+		storeStart := time.Now()
+		if page.axTree != nil {
+			if err := s.writeAXTree(ctx, storeKey, md, page.axTree); err != nil {
+				s.logger.Printf("Warning: failed to write the accessibility tree of %s: %v", md.URL, err)
+			} else {
+				md.AXTree = true
+			}
+		}
+		if page.thumbnail != nil {
+			if err := s.writeThumbnail(ctx, storeKey, md, page.thumbnail); err != nil {
+				s.logger.Printf("Warning: failed to write the thumbnail of %s: %v", md.URL, err)
+			} else {
+				md.Thumbnail = true
+			}
+		}
+		if err := s.writeMetadata(storeKey, md); err != nil {
+			s.logger.Printf("Error: failed to write metadata for %s: %v", md.URL, err)
+		} else {
+			s.indexContentHash(storeKey, md)
+			s.indexText(storeKey, md, page.content)
+			s.trackEntry(storeKey, md)
+		}
+		if storeKey != cacheKey {
+			if err := s.writeAlias(md.URL, cacheKey, md.CanonicalURL, md.Tenant); err != nil {
+				s.logger.Printf("Error: failed to write alias for %s: %v", md.URL, err)
+			}
+		}
+		timing.since(phaseStore, storeStart)
+	}
+}
+
+// errExpired reports a cache entry that is older than the server's TTL.
+var errExpired = errors.New("cache entry expired")
+
+// errOtherTenant reports a cache entry stored for another tenant than the
+// one asking for it.
+var errOtherTenant = errors.New("cache entry belongs to another tenant")
+
+// cachedResponse builds a response from a cache entry and its metadata, if
+// any, for a tenant.  Entries without metadata have no known age, so they
+// never expire; they were stored before tenants, so only requests without
+// one get them.
+func (s *Server) cachedResponse(ctx context.Context, cacheKey, tenant string) (*pb.DownloadCacheResponse, error) {
+	md, content, err := s.readEntry(ctx, cacheKey)
+	if err != nil {
+		return nil, err
+	}
+	if md.Tenant != tenant {
+		return nil, errOtherTenant
+	}
+	s.touch(cacheKey, md)
+	return s.response(md, content), nil
+}
+
+// readEntry reads an entry's metadata and content under its read lock, so a
+// concurrent store, invalidation or deletion is seen whole or not at all.
+func (s *Server) readEntry(ctx context.Context, cacheKey string) (*entryMetadata, []byte, error) {
+	unlock := s.readLockEntry(cacheKey)
+	defer unlock()
+	md, err := s.readMetadata(cacheKey)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			s.logger.Printf("Warning: failed to read metadata for %s: %v", cacheKey, err)
+		}
+		md = &entryMetadata{}
+	}
+	if ttl := s.freshFor(ctx, md); ttl > 0 && !md.FetchedAt.IsZero() && time.Since(md.FetchedAt) > ttl && !s.serveStale(md) {
+		return nil, nil, errExpired
+	}
+
+	readStart := time.Now()
+	content, err := s.readContent(ctx, s.contentName(cacheKey), md)
+	if err != nil {
+		return nil, nil, err
+	}
+	timingFrom(ctx).since(phaseCacheRead, readStart)
+	return md, content, nil
+}
+
+// serveStale reports whether an entry past the TTL is served anyway.  Held
+// entries never expire, and when the page couldn't be fetched (offline, or
+// its domain is paused) a stale page is better than none.
+func (s *Server) serveStale(md *entryMetadata) bool {
+	return md.LegalHold || s.offline.Load() || !s.pauses.pausedUntil(hostOf(md.URL)).IsZero()
+}
+
+// readContent reads, decrypts and decompresses an entry's content file.
+func (s *Server) readContent(ctx context.Context, name string, md *entryMetadata) ([]byte, error) {
+	data, err := s.storage.Read(name)
+	if err != nil {
+		return nil, err
+	}
+	if md.encrypted() {
+		c, err := s.tenantCipher(ctx, md.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = openContent(c, md.Tenant, data); err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s: %w", name, err)
+		}
+	}
+	if md.Dict != 0 {
+		return s.dicts.decode(md.Dict, data)
+	}
+	return md.codec().decode(data)
+}
+
+// encode compresses an entry's content with the server's codec, and the
+// dictionary for its domain if there is one, returning the dictionary's ID.
+func (s *Server) encode(md *entryMetadata, content []byte) ([]byte, uint32, error) {
+	if s.codec == codecZstd {
+		if data, dict, ok := s.dicts.encode(hostOf(md.contentURL()), content); ok {
+			return data, dict, nil
+		}
+	}
+	data, err := s.codec.encode(content)
+	return data, 0, err
+}
+
+// readFromCache reads and decompresses content from a cache file.
+func readFromCache(st Storage, name string, c codec) (string, error) {
+	data, err := st.Read(name)
+	if err != nil {
+		return "", err
+	}
+	content, err := c.decode(data)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// writeContent compresses and writes new content to a cache file with the
+// server's codec, encrypting it if it belongs to a tenant, and timing each
+// step for debug requests.
+func (s *Server) writeContent(ctx context.Context, name string, md *entryMetadata, content []byte) error {
+	timing := timingFrom(ctx)
+	compressStart := time.Now()
+	data, dict, err := s.encode(md, content)
+	if err != nil {
+		return err
+	}
+	md.Dict = dict
+	timing.since(phaseCompress, compressStart)
+	if tenant := md.Tenant; tenant != "" {
+		c, err := s.tenantCipher(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if data, err = sealContent(c, tenant, data); err != nil {
+			return err
+		}
+	}
+	defer timing.since(phaseStore, time.Now())
+	return s.storage.Write(name, data)
+}
+
+// writeToCache compresses and writes content to a cache file.
+func writeToCache(st Storage, name string, content []byte, c codec) error {
+	data, err := c.encode(content)
+	if err != nil {
+		return err
+	}
+	return st.Write(name, data)
+}
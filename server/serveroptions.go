@@ -0,0 +1,60 @@
+package server
+
+import (
+	"log"
+	"time"
+)
+
+// An Option customizes a Server built by NewServer.  Options take precedence
+// over the Config, so a program embedding the cache (or a test) can swap in
+// its own components while keeping the rest of the configuration.
+type Option func(*Server)
+
+// WithStorage stores cache entries in st instead of Config.CacheDir.
+func WithStorage(st Storage) Option {
+	return func(s *Server) { s.storage = st }
+}
+
+// WithFetcher fetches pages with f instead of the fetcher named by the
+// Config (Selenium, local chromedriver or a plugin).
+func WithFetcher(f Fetcher) Option {
+	return func(s *Server) { s.fetcher = f }
+}
+
+// WithKeyProvider encrypts tenants' cache entries with keys from p, e.g. a
+// KMS, instead of Config.TenantKeys.
+func WithKeyProvider(p KeyProvider) Option {
+	return func(s *Server) { s.keys = p }
+}
+
+// WithTTL treats cache entries fetched more than ttl ago as misses, so they
+// are fetched again, in place of Config.CacheTTL.  Zero keeps entries until
+// they are invalidated.
+func WithTTL(ttl time.Duration) Option {
+	return func(s *Server) { s.ttl = ttl }
+}
+
+// Limits bounds what a single request may ask of the server.  Zero fields
+// keep the default.
+type Limits struct {
+	MaxRedirects  int           // Most redirects a navigation may follow; defaults to Config.MaxRedirects
+	MaxRenderWait time.Duration // Longest render_wait_ms a request may set; defaults to 30s
+}
+
+// WithLimits overrides the per-request limits.
+func WithLimits(l Limits) Option {
+	return func(s *Server) {
+		if l.MaxRedirects > 0 {
+			s.maxRedirects = l.MaxRedirects
+		}
+		if l.MaxRenderWait > 0 {
+			s.maxRenderWait = l.MaxRenderWait
+		}
+	}
+}
+
+// WithLogger sends the server's log output to l instead of the standard
+// logger.
+func WithLogger(l *log.Logger) Option {
+	return func(s *Server) { s.logger.out = l }
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/tebeka/selenium"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sessionResetTimeout bounds how long a pooled session has to be reset
+// before it is closed instead of being kept.
+const sessionResetTimeout = 10 * time.Second
+
+// openSession opens a WebDriver session with caps, or unless fresh is set,
+// borrows one opened with the same capabilities for the same tenant from
+// the pool.  It returns the session, the WebDriver URL it is open on, and a
+// function to call once the fetch is over, reporting whether the session
+// may be used again.
+func (f *seleniumFetcher) openSession(ctx context.Context, caps selenium.Capabilities, fresh bool) (selenium.WebDriver, string, func(reusable bool), error) {
+	timing := timingFrom(ctx)
+	// The grid queues session requests while it is at capacity, so time
+	// spent in NewRemote (or waiting for a local worker or a pooled
+	// session) is time spent waiting for a renderer.
+	open := func(ctx context.Context) (selenium.WebDriver, string, func(uses int), error) {
+		start := time.Now()
+		driverURL, releaseDriver, err := f.acquireDriver(ctx)
+		if err != nil {
+			return nil, "", nil, &rendererDown{status.Errorf(codes.Unavailable, "no renderer available: %v", err)}
+		}
+		timing.since(phaseQueueWait, start)
+		start = time.Now()
+		wd, err := selenium.NewRemote(caps, driverURL)
+		timing.since(phaseSessionCreate, start)
+		if err != nil {
+			releaseDriver(0)
+			f.record(err)
+			return nil, "", nil, &rendererDown{status.Errorf(codes.Internal, "failed to open session with WebDriver: %v", err)}
+		}
+		quit := func(uses int) {
+			if err := wd.Quit(); err != nil {
+				f.logger.Printf("Warning: failed to quit WebDriver session: %v", err)
+			}
+			releaseDriver(uses)
+		}
+		return wd, driverURL, quit, nil
+	}
+
+	if f.pool == nil || fresh {
+		wd, driverURL, quit, err := open(ctx)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		return wd, driverURL, func(bool) { quit(1) }, nil
+	}
+
+	// Sessions opened with the same capabilities are interchangeable, but
+	// only within a tenant: resetting a session clears what the browser
+	// keeps, but tenants are kept apart in case anything is missed.
+	key, err := json.Marshal(struct {
+		Tenant string                `json:"tenant"`
+		Caps   selenium.Capabilities `json:"caps"`
+	}{fetchTenant(ctx), caps})
+	if err != nil {
+		return nil, "", nil, status.Errorf(codes.InvalidArgument, "invalid capabilities: %v", err)
+	}
+	start := time.Now()
+	s, err := f.pool.Get(ctx, string(key), open)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, "", nil, err
+		}
+		return nil, "", nil, &rendererDown{status.Errorf(codes.Unavailable, "no renderer available: %v", err)}
+	}
+	if s.Uses() > 1 {
+		timing.since(phaseQueueWait, start)
+		// Drop what the performance log kept from the session's last page.
+		if _, err := readPerformanceLog(s); err != nil {
+			f.logger.Printf("Warning: failed to clear the performance log of a pooled session: %v", err)
+		}
+	}
+	return s, s.URL, func(reusable bool) { f.pool.Put(s, reusable) }, nil
+}
+
+// resetSession puts a pooled session back the way a new one starts, so that
+// nothing the last page left behind leaks into the next fetch.  visited are
+// the origins the page made requests to.  It reports whether the session
+// was reset and may be used again.
+func (f *seleniumFetcher) resetSession(driverURL string, wd selenium.WebDriver, visited []string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), sessionResetTimeout)
+	defer cancel()
+	type command struct {
+		cmd    string
+		params any
+	}
+	commands := []command{
+		{"Network.emulateNetworkConditions", unthrottled},
+		{"Emulation.setScriptExecutionDisabled", map[string]bool{"value": false}},
+		{"Network.clearBrowserCookies", struct{}{}},
+		{"Network.clearBrowserCache", struct{}{}},
+	}
+	// Local and session storage, IndexedDB and service workers are kept by
+	// origin: those of every origin the page or its frames and scripts made
+	// requests to, and of the page itself, in case the log missed it.
+	origins := slices.Clone(visited)
+	if current, err := wd.CurrentURL(); err == nil {
+		if origin := originOf(current); origin != "" && !slices.Contains(origins, origin) {
+			origins = append(origins, origin)
+		}
+	}
+	for _, origin := range origins {
+		commands = append(commands, command{"Storage.clearDataForOrigin", map[string]string{"origin": origin, "storageTypes": "all"}})
+	}
+	for _, c := range commands {
+		if err := devtoolsCommand(ctx, driverURL, wd.SessionID(), c.cmd, c.params, nil); err != nil {
+			f.logger.Printf("Warning: failed to reset pooled WebDriver session (%s): %v", c.cmd, err)
+			return false
+		}
+	}
+	if err := wd.Get("about:blank"); err != nil {
+		f.logger.Printf("Warning: failed to reset pooled WebDriver session: %v", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSignedURLTTL = time.Hour
+	maxSignedURLTTL     = 7 * 24 * time.Hour
+)
+
+// CreateSignedURL mints a time-limited gateway URL for a cached page, for
+// sharing with systems that can't call the gRPC API.
+func (s *Server) CreateSignedURL(ctx context.Context, req *pb.CreateSignedURLRequest) (*pb.CreateSignedURLResponse, error) {
+	if len(s.signingKey) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "GATEWAY_SIGNING_KEY is not configured")
+	}
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+	if ttl == 0 {
+		ttl = defaultSignedURLTTL
+	}
+	if ttl < 0 || ttl > maxSignedURLTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be between 0 and %d", int(maxSignedURLTTL.Seconds()))
+	}
+	if !s.entryExists(s.resolveAlias(s.layout.key(req.GetUrl()))) {
+		return nil, status.Errorf(codes.NotFound, "%s is not cached", req.GetUrl())
+	}
+
+	expires := time.Now().Add(ttl).Truncate(time.Second)
+	q := url.Values{}
+	q.Set("url", req.GetUrl())
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("sig", s.sign(req.GetUrl(), expires.Unix()))
+	return &pb.CreateSignedURLResponse{
+		SignedUrl: strings.TrimSuffix(s.gatewayBaseURL, "/") + "/page?" + q.Encode(),
+		ExpiresAt: timestamppb.New(expires),
+	}, nil
+}
+
+// sign returns the signature authorizing access to rawURL until expires.
+func (s *Server) sign(rawURL string, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(rawURL + "\n" + strconv.FormatInt(expires, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// checkSignature verifies the signature on a gateway request.  With no
+// signing key configured the gateway is open and every request passes.
+func (s *Server) checkSignature(q url.Values) (int, string) {
+	if len(s.signingKey) == 0 {
+		return http.StatusOK, ""
+	}
+	if q.Get("sig") == "" {
+		return http.StatusUnauthorized, "missing signature"
+	}
+	expires, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, "invalid expires"
+	}
+	if !hmac.Equal([]byte(q.Get("sig")), []byte(s.sign(q.Get("url"), expires))) {
+		return http.StatusForbidden, "invalid signature"
+	}
+	if time.Now().Unix() > expires {
+		return http.StatusForbidden, "signature expired"
+	}
+	return http.StatusOK, ""
+}
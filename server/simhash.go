@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+
+	pb "downloadcache/pb"
+)
+
+const (
+	// shingleWords is how many words make up a shingle, the unit of text
+	// fingerprints are built from.
+	shingleWords = 4
+	// nearDuplicateBits is the most bits two fingerprints may differ by for
+	// their pages to count as near-duplicates.
+	nearDuplicateBits = 3
+)
+
+// simhash returns a 64-bit fingerprint of text, such that texts sharing
+// most of their shingles have fingerprints differing in few bits.  Texts
+// too short to fingerprint return zero.
+func simhash(text string) uint64 {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) < shingleWords {
+		return 0
+	}
+	var weights [64]int
+	h := fnv.New64a()
+	for i := 0; i+shingleWords <= len(words); i++ {
+		h.Reset()
+		h.Write([]byte(strings.Join(words[i:i+shingleWords], " ")))
+		sum := h.Sum64()
+		for b := range weights {
+			if sum&(1<<b) != 0 {
+				weights[b]++
+			} else {
+				weights[b]--
+			}
+		}
+	}
+	var fp uint64
+	for b, w := range weights {
+		if w > 0 {
+			fp |= 1 << b
+		}
+	}
+	if fp == 0 {
+		fp = 1 // Zero means no fingerprint.
+	}
+	return fp
+}
+
+// nearDuplicate reports whether two fingerprints are of near-duplicate
+// pages.
+func nearDuplicate(a, b uint64) bool {
+	return a != 0 && b != 0 && bits.OnesCount64(a^b) <= nearDuplicateBits
+}
+
+// pageFingerprint returns the fingerprint of a page's visible text, or zero
+// for pages that aren't HTML.
+func pageFingerprint(contentType string, content []byte) uint64 {
+	if !isHTML(contentType) {
+		return 0
+	}
+	return simhash(htmlText(content))
+}
+
+type dedupKey struct{}
+
+// withDedup has pages fetched with ctx stored as aliases of near-duplicate
+// pages that job crawled before them.
+func withDedup(ctx context.Context, job *crawlJob) context.Context {
+	return context.WithValue(ctx, dedupKey{}, job)
+}
+
+// dedupFrom returns the crawl deduplicating ctx's pages, or nil.
+func dedupFrom(ctx context.Context) *crawlJob {
+	job, _ := ctx.Value(dedupKey{}).(*crawlJob)
+	return job
+}
+
+// storeDuplicate stores a page fetched by a crawl as an alias of an earlier
+// page of the crawl with nearly the same text, if there is one, so crawls
+// don't fill the cache with copies of the same boilerplate.  It returns
+// false if the page should be stored as usual.
+func (s *Server) storeDuplicate(ctx context.Context, page *fetchedPage, cacheKey string, cacheOpts *pb.CacheOptions) bool {
+	job := dedupFrom(ctx)
+	if job == nil || cacheOpts.GetNoStore() {
+		return false
+	}
+	md := page.md
+	original := job.duplicateOf(md.URL, pageFingerprint(md.ContentType, page.content))
+	if original == "" {
+		return false
+	}
+	originalKey := s.resolveAlias(s.layout.tenantKey(md.Tenant, original))
+	originalMD, err := s.readMetadata(originalKey)
+	if err != nil || originalKey == cacheKey {
+		return false // The original is gone, so this page takes its place.
+	}
+
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+	if s.held(cacheKey) {
+		return false
+	}
+	if err := s.writeAlias(md.URL, cacheKey, originalMD.contentURL(), md.Tenant); err != nil {
+		s.logger.Printf("Error: failed to write alias for %s: %v", md.URL, err)
+		return false
+	}
+	s.logger.Requestf(ctx, "Stored %s as an alias of near-duplicate page %s", md.URL, original)
+	return true
+}
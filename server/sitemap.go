@@ -0,0 +1,217 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	sitemapCacheSubdir      = "sitemaps"
+	defaultSitemapPageSize  = 1000
+	maxSitemapPageSize      = 50000    // The sitemap protocol limit on URLs per file.
+	maxSitemapDownloadBytes = 50 << 20 // The sitemap protocol limit on uncompressed size.
+	defaultSitemapPriority  = 0.5
+)
+
+// sitemapDocument covers both <urlset> and <sitemapindex> documents; only one
+// of the two slices is populated for a given sitemap.
+type sitemapDocument struct {
+	URLs     []sitemapURL `xml:"url"`
+	Sitemaps []sitemapURL `xml:"sitemap"`
+}
+
+type sitemapURL struct {
+	Loc        string `xml:"loc"`
+	LastMod    string `xml:"lastmod"`
+	ChangeFreq string `xml:"changefreq"`
+	Priority   string `xml:"priority"`
+}
+
+// ParseSitemap handles the gRPC request.
+func (s *Server) ParseSitemap(ctx context.Context, req *pb.ParseSitemapRequest) (*pb.ParseSitemapResponse, error) {
+	ctx = s.logger.sampleRequest(ctx)
+	s.logger.Requestf(ctx, "Received sitemap request for URL: %s, Invalidate: %v", req.GetUrl(), req.GetInvalidate())
+
+	if err := validateURL(req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if req.GetPageSize() < 0 || req.GetPageSize() > maxSitemapPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 0 and %d", maxSitemapPageSize)
+	}
+	offset := 0
+	if req.GetPageToken() != "" {
+		var err error
+		offset, err = strconv.Atoi(req.GetPageToken())
+		if err != nil || offset < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.GetPageToken())
+		}
+	}
+
+	content, err := s.getSitemap(ctx, req.GetUrl(), req.GetInvalidate())
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := parseSitemap(content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse sitemap %s: %v", req.GetUrl(), err)
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultSitemapPageSize
+	}
+	resp := &pb.ParseSitemapResponse{TotalEntries: int32(len(entries))}
+	if offset < len(entries) {
+		end := min(offset+pageSize, len(entries))
+		resp.Entries = entries[offset:end]
+		if end < len(entries) {
+			resp.NextPageToken = strconv.Itoa(end)
+		}
+	}
+	return resp, nil
+}
+
+// getSitemap returns the raw sitemap XML, from the cache if possible.  Sitemaps
+// are fetched with a plain HTTP client rather than Selenium, since the browser
+// would wrap the XML in its own viewer markup.  ParseSitemap requests have no
+// tenant, and sitemaps are public documents fetched without credentials, so
+// they are cached under the shared key for everyone.
+func (s *Server) getSitemap(ctx context.Context, rawURL string, invalidate bool) ([]byte, error) {
+	cacheFileName := path.Join(sitemapCacheSubdir, s.layout.key(rawURL))
+
+	if !invalidate {
+		if content, err := readFromCache(s.storage, cacheFileName, codecGzip); err == nil {
+			s.logger.Requestf(ctx, "Sitemap cache HIT for URL: %s", rawURL)
+			return []byte(content), nil
+		}
+	}
+
+	unlock := s.urlLocks.lock(cacheFileName)
+	defer unlock()
+
+	// Double-check cache: another request might have fetched the sitemap while
+	// we waited for the lock.
+	if !invalidate {
+		if content, err := readFromCache(s.storage, cacheFileName, codecGzip); err == nil {
+			s.logger.Requestf(ctx, "Sitemap cache HIT (after lock) for URL: %s", rawURL)
+			return []byte(content), nil
+		}
+	}
+
+	if err := s.checkOnline(rawURL); err != nil {
+		return nil, err
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	s.logger.Requestf(ctx, "Fetching sitemap over HTTP: %s", rawURL)
+	content, err := s.fetchSitemap(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeToCache(s.storage, cacheFileName, content, codecGzip); err != nil {
+		s.logger.Printf("Error: failed to write to cache file %s: %v", cacheFileName, err)
+	}
+	return content, nil
+}
+
+// fetchSitemap downloads a sitemap, transparently decompressing .xml.gz files.
+func (s *Server) fetchSitemap(ctx context.Context, rawURL string) ([]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sitemap URL %s: %v", rawURL, err)
+	}
+	httpResp, err := s.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch sitemap %s: %v", rawURL, err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch sitemap %s: HTTP %d", rawURL, httpResp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, maxSitemapDownloadBytes+1))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read sitemap %s: %v", rawURL, err)
+	}
+
+	// Gzipped sitemaps are usually served as application/gzip or
+	// application/octet-stream, so sniff the magic bytes instead of trusting headers.
+	if bytes.HasPrefix(body, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decompress sitemap %s: %v", rawURL, err)
+		}
+		defer gzipReader.Close()
+		body, err = io.ReadAll(io.LimitReader(gzipReader, maxSitemapDownloadBytes+1))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decompress sitemap %s: %v", rawURL, err)
+		}
+	}
+
+	if len(body) > maxSitemapDownloadBytes {
+		return nil, status.Errorf(codes.ResourceExhausted, "sitemap %s exceeds %d bytes", rawURL, maxSitemapDownloadBytes)
+	}
+	return body, nil
+}
+
+// parseSitemap converts a <urlset> or <sitemapindex> document to entries.
+func parseSitemap(content []byte) ([]*pb.SitemapEntry, error) {
+	var doc sitemapDocument
+	if err := xml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*pb.SitemapEntry, 0, len(doc.URLs)+len(doc.Sitemaps))
+	for _, u := range doc.URLs {
+		entry, err := u.toEntry(false)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	for _, u := range doc.Sitemaps {
+		entry, err := u.toEntry(true)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (u sitemapURL) toEntry(isSitemap bool) (*pb.SitemapEntry, error) {
+	priority := defaultSitemapPriority
+	if p := strings.TrimSpace(u.Priority); p != "" {
+		var err error
+		priority, err = strconv.ParseFloat(p, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid priority %q for %s", p, u.Loc)
+		}
+	}
+	return &pb.SitemapEntry{
+		Loc:        strings.TrimSpace(u.Loc),
+		Lastmod:    strings.TrimSpace(u.LastMod),
+		Changefreq: strings.TrimSpace(u.ChangeFreq),
+		Priority:   priority,
+		IsSitemap:  isSitemap,
+	}, nil
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultMaxMessageBytes is gRPC's default limit on the messages a
+	// client receives.
+	defaultMaxMessageBytes = 4 << 20
+	// spillTTL is how long spilled contents can be read.
+	spillTTL = 15 * time.Minute
+	// maxSpillBytes bounds the spilled contents held at once; the oldest
+	// are dropped to make room.
+	maxSpillBytes = 512 << 20
+	// spillChunkSize is the largest piece of spilled contents sent in one
+	// message.
+	spillChunkSize = 64 << 10
+	// defaultStreamChunkBytes is the largest piece of contents GetStream
+	// sends in one message, unless configured otherwise.
+	defaultStreamChunkBytes = 64 << 10
+)
+
+// spillStore holds the contents of responses too large to send, until they
+// are read with ReadSpilled or expire.  They are held in memory, since
+// tenants' pages must not be written out unencrypted.
+type spillStore struct {
+	mu    sync.Mutex
+	items map[string]*spilled
+	order []string // IDs, oldest first
+	bytes int64
+}
+
+type spilled struct {
+	tenant  string
+	content []byte
+	expires time.Time
+}
+
+// errSpillTooLarge is the error of adding contents larger than the whole
+// spill store.
+var errSpillTooLarge = errors.New("contents are larger than the spill store")
+
+// add holds content and returns its ID.  Contents larger than maxSpillBytes
+// are refused rather than dropping everything else for them.
+func (st *spillStore) add(tenant string, content []byte) (string, time.Time, error) {
+	if len(content) > maxSpillBytes {
+		return "", time.Time{}, errSpillTooLarge
+	}
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", time.Time{}, err
+	}
+	id := hex.EncodeToString(idBytes)
+	now := time.Now()
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.expireLocked(now)
+	for len(st.order) > 0 && st.bytes+int64(len(content)) > maxSpillBytes {
+		st.removeLocked(st.order[0])
+	}
+	if st.items == nil {
+		st.items = make(map[string]*spilled)
+	}
+	item := &spilled{tenant: tenant, content: content, expires: now.Add(spillTTL)}
+	st.items[id] = item
+	st.order = append(st.order, id)
+	st.bytes += int64(len(content))
+	return id, item.expires, nil
+}
+
+// get returns the contents held under an ID, or nil if there are none.
+func (st *spillStore) get(id string) *spilled {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.expireLocked(time.Now())
+	return st.items[id]
+}
+
+// expireLocked drops the contents that have expired.  They expire in the
+// order they were added.
+func (st *spillStore) expireLocked(now time.Time) {
+	for len(st.order) > 0 && now.After(st.items[st.order[0]].expires) {
+		st.removeLocked(st.order[0])
+	}
+}
+
+func (st *spillStore) removeLocked(id string) {
+	item, ok := st.items[id]
+	if !ok {
+		return
+	}
+	delete(st.items, id)
+	for i, other := range st.order {
+		if other == id {
+			st.order = append(st.order[:i], st.order[i+1:]...)
+			break
+		}
+	}
+	st.bytes -= int64(len(item.content))
+}
+
+// spillLargeResponse moves a response's contents to the spill store if the
+// response is larger than the client accepts, so the client gets a handle
+// to read them with instead of an error.  Outside a Get or GetBatch call
+// (e.g. from the HTTP gateway, or a warm) it does nothing.
+func (s *Server) spillLargeResponse(ctx context.Context, req *pb.DownloadCacheRequest, resp *pb.DownloadCacheResponse) error {
+	limit := s.maxMessageBytes
+	if req.GetMaxMessageBytes() > 0 {
+		limit = req.GetMaxMessageBytes()
+	}
+	if method, _ := grpc.Method(ctx); limit <= 0 || (method != pb.DownloadCache_Get_FullMethodName && method != pb.DownloadCache_GetBatch_FullMethodName) || int64(proto.Size(resp)) <= limit {
+		return nil
+	}
+	content := contents(resp)
+	id, expires, err := s.spills.add(req.GetTenant(), content)
+	if errors.Is(err, errSpillTooLarge) {
+		return status.Errorf(codes.ResourceExhausted, "response for %s is %d bytes, more than the %d that can be spilled; use GetStream", req.GetUrl(), len(content), maxSpillBytes)
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "failed to spill response: %v", err)
+	}
+	s.logger.Requestf(ctx, "Response for %s is larger than %d bytes; spilled %d bytes as %s", req.GetUrl(), limit, len(content), id)
+	setContents(resp, nil)
+	resp.Spilled = &pb.SpilledContents{
+		SpillId:   id,
+		Size:      int64(len(content)),
+		ExpiresAt: timestamppb.New(expires),
+	}
+	return nil
+}
+
+// ReadSpilled handles the gRPC request.
+func (s *Server) ReadSpilled(req *pb.ReadSpilledRequest, stream pb.DownloadCache_ReadSpilledServer) error {
+	ctx := stream.Context()
+	item := s.spills.get(req.GetSpillId())
+	if item == nil || item.tenant != req.GetTenant() {
+		return status.Errorf(codes.NotFound, "no spilled contents %q; they may have expired", req.GetSpillId())
+	}
+	if err := s.checkTenant(ctx, req.GetTenant()); err != nil {
+		return err
+	}
+	for sent := 0; sent < len(item.content); {
+		n := min(len(item.content)-sent, spillChunkSize)
+		if err := stream.Send(&pb.SpilledChunk{Data: item.content[sent : sent+n]}); err != nil {
+			return err
+		}
+		sent += n
+	}
+	return nil
+}
+
+// GetStream handles the gRPC request: the response of Get, without its
+// contents, then the contents in chunks.  It never spills, so it serves pages
+// of any size to clients with the default message size limit.
+func (s *Server) GetStream(req *pb.DownloadCacheRequest, stream pb.DownloadCache_GetStreamServer) error {
+	resp, err := s.Get(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	content := contents(resp)
+	setContents(resp, nil)
+	if err := stream.Send(&pb.DownloadCacheChunk{Response: resp}); err != nil {
+		return err
+	}
+	chunkBytes := s.streamChunkBytes
+	if req.GetChunkBytes() > 0 {
+		chunkBytes = int(req.GetChunkBytes())
+	}
+	for sent := 0; sent < len(content); {
+		n := min(len(content)-sent, chunkBytes)
+		if err := stream.Send(&pb.DownloadCacheChunk{Data: content[sent : sent+n]}); err != nil {
+			return err
+		}
+		sent += n
+	}
+	return nil
+}
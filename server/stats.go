@@ -0,0 +1,112 @@
+package server
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// serverStats counts request outcomes since startup, overall and per hostname.
+type serverStats struct {
+	hits   atomic.Int64 // Requests served from the cache
+	misses atomic.Int64 // Requests that downloaded the page
+	errors atomic.Int64 // Requests that failed
+
+	seleniumFailuresInARow atomic.Int64
+
+	mu      sync.Mutex
+	domains map[string]*domainCounters
+}
+
+// domainCounters are the traffic counters for one hostname.
+type domainCounters struct {
+	hits, misses, errors int64
+	fetchTime            time.Duration // Total time spent on successful downloads
+}
+
+func (st *serverStats) recordHit(rawURL string) {
+	st.hits.Add(1)
+	st.updateDomain(rawURL, func(d *domainCounters) { d.hits++ })
+}
+
+func (st *serverStats) recordMiss(rawURL string, fetchTime time.Duration) {
+	st.misses.Add(1)
+	st.updateDomain(rawURL, func(d *domainCounters) {
+		d.misses++
+		d.fetchTime += fetchTime
+	})
+}
+
+func (st *serverStats) recordError(rawURL string) {
+	st.errors.Add(1)
+	st.updateDomain(rawURL, func(d *domainCounters) { d.errors++ })
+}
+
+func (st *serverStats) updateDomain(rawURL string, update func(*domainCounters)) {
+	host := hostOf(rawURL)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.domains == nil {
+		st.domains = make(map[string]*domainCounters)
+	}
+	d, ok := st.domains[host]
+	if !ok {
+		d = &domainCounters{}
+		st.domains[host] = d
+	}
+	update(d)
+}
+
+// domainSnapshot returns a copy of the per-hostname counters.
+func (st *serverStats) domainSnapshot() map[string]domainCounters {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	out := make(map[string]domainCounters, len(st.domains))
+	for host, d := range st.domains {
+		out[host] = *d
+	}
+	return out
+}
+
+// hostOf returns the hostname of a URL, or "" if it has none.
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// matchesDomain reports whether host is one of domains or a subdomain of one.
+func matchesDomain(host string, domains []string) bool {
+	for _, d := range domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// statsSnapshot is a point-in-time copy of the counters.
+type statsSnapshot struct {
+	hits, misses, errors int64
+}
+
+func (st *serverStats) snapshot() statsSnapshot {
+	return statsSnapshot{
+		hits:   st.hits.Load(),
+		misses: st.misses.Load(),
+		errors: st.errors.Load(),
+	}
+}
+
+// sub returns the counts accumulated between an earlier snapshot and this one.
+func (a statsSnapshot) sub(b statsSnapshot) statsSnapshot {
+	return statsSnapshot{hits: a.hits - b.hits, misses: a.misses - b.misses, errors: a.errors - b.errors}
+}
+
+func (a statsSnapshot) requests() int64 {
+	return a.hits + a.misses + a.errors
+}
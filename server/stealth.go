@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tebeka/selenium"
+)
+
+// stealthArgs are the Chrome switches of a stealth session: Blink stops
+// reporting itself as automated.
+var stealthArgs = []string{"--disable-blink-features=AutomationControlled"}
+
+// stealthScript runs in every document of a stealth session before the
+// page's own scripts, covering what bot detection checks in JavaScript.
+const stealthScript = `
+Object.defineProperty(Navigator.prototype, "webdriver", { get: () => undefined, configurable: true });
+if (!navigator.languages || navigator.languages.length === 0) {
+  Object.defineProperty(Navigator.prototype, "languages", { get: () => ["en-US", "en"], configurable: true });
+}
+if (navigator.plugins.length === 0) {
+  Object.defineProperty(Navigator.prototype, "plugins", { get: () => [1, 2, 3, 4, 5], configurable: true });
+}
+if (!window.chrome) {
+  window.chrome = { runtime: {} };
+}
+const query = navigator.permissions && navigator.permissions.query;
+if (query) {
+  navigator.permissions.query = p => p && p.name === "notifications"
+    ? Promise.resolve({ state: Notification.permission })
+    : query.call(navigator.permissions, p);
+}`
+
+// stealthCapabilities adds the settings of a stealth session to its
+// goog:chromeOptions: Chrome's automation switch and extension are left out.
+func stealthCapabilities(chromeCaps map[string]interface{}) {
+	chromeCaps["args"] = append(chromeCaps["args"].([]string), stealthArgs...)
+	chromeCaps["excludeSwitches"] = []string{"enable-automation"}
+	chromeCaps["useAutomationExtension"] = false
+}
+
+// applyStealth hides a WebDriver session's automation from the pages it
+// loads next: the user agent no longer says HeadlessChrome, and
+// stealthScript runs before every page's scripts.
+func applyStealth(ctx context.Context, driverURL string, wd selenium.WebDriver) error {
+	v, err := wd.ExecuteScript("return navigator.userAgent;", nil)
+	if err != nil {
+		return err
+	}
+	if ua, ok := v.(string); ok && strings.Contains(ua, "HeadlessChrome") {
+		override := map[string]string{"userAgent": strings.ReplaceAll(ua, "HeadlessChrome", "Chrome")}
+		if err := devtoolsCommand(ctx, driverURL, wd.SessionID(), "Network.setUserAgentOverride", override, nil); err != nil {
+			return err
+		}
+	}
+	return devtoolsCommand(ctx, driverURL, wd.SessionID(), "Page.addScriptToEvaluateOnNewDocument", map[string]string{"source": stealthScript}, nil)
+}
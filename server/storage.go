@@ -0,0 +1,126 @@
+package server
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Storage holds the files that make up a cache: page content, metadata
+// sidecars and cached sitemaps.  Names are slash-separated paths relative to
+// the root of the cache, e.g. ".meta/ab/<key>.json".  Implementations must be
+// safe for concurrent use.
+type Storage interface {
+	// Read returns the contents of a file.  Missing files return an error
+	// satisfying errors.Is(err, fs.ErrNotExist).
+	Read(name string) ([]byte, error)
+	// Write creates or replaces a file.  Concurrent readers see either the
+	// old or the new contents, never a partial write.
+	Write(name string, data []byte) error
+	// Remove deletes a file.  Removing a missing file is not an error.
+	Remove(name string) error
+	// Stat describes a file.  Missing files return an error satisfying
+	// errors.Is(err, fs.ErrNotExist).
+	Stat(name string) (FileInfo, error)
+	// Walk calls fn for dir ("" for the root) and every directory and file
+	// below it, like filepath.WalkDir: in lexical order, component by
+	// component, with each directory visited before its contents.  fn may
+	// return fs.SkipDir to skip a directory, or fs.SkipAll to stop.  A
+	// missing dir is walked as if it were empty.
+	Walk(dir string, fn func(name string, info FileInfo) error) error
+}
+
+// Storage backends that can be named in Config.Storage.
+const (
+	storageDir    = "dir"
+	storageMemory = "memory"
+)
+
+// FileInfo describes a file in Storage.
+type FileInfo struct {
+	Size    int64
+	ModTime time.Time
+	IsDir   bool
+}
+
+// dirStorage keeps the cache in a directory on the local filesystem.
+type dirStorage struct {
+	root  string
+	fsync bool // Flush writes to disk before they return
+}
+
+// NewDirStorage returns Storage backed by a directory, creating it if needed.
+func NewDirStorage(dir string) (Storage, error) {
+	return newDirStorage(dir, false)
+}
+
+func newDirStorage(dir string, fsync bool) (Storage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return dirStorage{root: dir, fsync: fsync}, nil
+}
+
+func (d dirStorage) path(name string) string {
+	return filepath.Join(d.root, filepath.FromSlash(name))
+}
+
+func (d dirStorage) Read(name string) ([]byte, error) {
+	return os.ReadFile(d.path(name))
+}
+
+func (d dirStorage) Write(name string, data []byte) error {
+	return writeFileAtomic(d.path(name), data, d.fsync)
+}
+
+func (d dirStorage) Remove(name string) error {
+	err := os.Remove(d.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (d dirStorage) Stat(name string) (FileInfo, error) {
+	info, err := os.Stat(d.path(name))
+	if err != nil {
+		return FileInfo{}, err
+	}
+	return fileInfo(info), nil
+}
+
+func (d dirStorage) Walk(dir string, fn func(name string, info FileInfo) error) error {
+	if _, err := os.Stat(d.path(dir)); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return filepath.WalkDir(d.path(dir), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(d.root, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if name == "." {
+			name = ""
+		}
+		info := FileInfo{IsDir: entry.IsDir()}
+		if !info.IsDir {
+			fi, err := entry.Info()
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil // Removed since the directory was listed.
+			} else if err != nil {
+				return err
+			}
+			info = fileInfo(fi)
+		}
+		return fn(name, info)
+	})
+}
+
+func fileInfo(info fs.FileInfo) FileInfo {
+	return FileInfo{Size: info.Size(), ModTime: info.ModTime(), IsDir: info.IsDir()}
+}
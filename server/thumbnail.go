@@ -0,0 +1,96 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"path"
+)
+
+const (
+	// thumbnailSubdir holds the thumbnails of cached pages, one JPEG per
+	// cache key, encrypted like the page.
+	thumbnailSubdir = ".thumbnail"
+	// thumbnailQuality is the JPEG quality of thumbnails; they are for
+	// telling pages apart at a glance, not for reading.
+	thumbnailQuality = 75
+)
+
+// thumbnailName returns the Storage name of the thumbnail for a key.
+func (l cacheLayout) thumbnailName(cacheKey string) string {
+	return path.Join(thumbnailSubdir, l.relPath(cacheKey))
+}
+
+// thumbnail scales a PNG screenshot down to width pixels wide, keeping its
+// aspect ratio, and returns it as a JPEG.  Screenshots narrower than width
+// are kept at their size.
+func thumbnail(screenshot []byte, width int) ([]byte, error) {
+	src, err := png.Decode(bytes.NewReader(screenshot))
+	if err != nil {
+		return nil, err
+	}
+	b := src.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 {
+		return nil, image.ErrFormat
+	}
+	width = min(width, b.Dx())
+	height := max(1, b.Dy()*width/b.Dx())
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	// Each thumbnail pixel averages the block of screenshot pixels it
+	// covers, so text shrinks to grey rather than to noise.
+	for y := range height {
+		y0, y1 := b.Min.Y+y*b.Dy()/height, b.Min.Y+(y+1)*b.Dy()/height
+		for x := range width {
+			x0, x1 := b.Min.X+x*b.Dx()/width, b.Min.X+(x+1)*b.Dx()/width
+			var r, g, bl, n uint64
+			for sy := y0; sy < max(y1, y0+1); sy++ {
+				for sx := x0; sx < max(x1, x0+1); sx++ {
+					cr, cg, cb, _ := src.At(sx, sy).RGBA()
+					r, g, bl, n = r+uint64(cr), g+uint64(cg), bl+uint64(cb), n+1
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{uint8(r / n >> 8), uint8(g / n >> 8), uint8(bl / n >> 8), 0xff})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeThumbnail stores the thumbnail taken with an entry.  The caller
+// holds the entry lock, and records the thumbnail in the entry's metadata
+// once it is written.
+func (s *Server) writeThumbnail(ctx context.Context, cacheKey string, md *entryMetadata, jpg []byte) error {
+	data := jpg
+	if md.encrypted() {
+		c, err := s.tenantCipher(ctx, md.Tenant)
+		if err != nil {
+			return err
+		}
+		if data, err = sealContent(c, md.Tenant, data); err != nil {
+			return err
+		}
+	}
+	return s.storage.Write(s.layout.thumbnailName(cacheKey), data)
+}
+
+// readThumbnail reads the thumbnail taken with an entry.
+func (s *Server) readThumbnail(ctx context.Context, cacheKey string, md *entryMetadata) ([]byte, error) {
+	data, err := s.storage.Read(s.layout.thumbnailName(cacheKey))
+	if err != nil {
+		return nil, err
+	}
+	if md.encrypted() {
+		c, err := s.tenantCipher(ctx, md.Tenant)
+		if err != nil {
+			return nil, err
+		}
+		return openContent(c, md.Tenant, data)
+	}
+	return data, nil
+}
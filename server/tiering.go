@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// coldStore is a cheaper, slower place to keep entries nobody has asked for
+// in a while.  Objects are named by coldObjectName, which does not depend on
+// the cache layout, so migrations don't need to touch cold storage.
+type coldStore interface {
+	Put(name string, data []byte) error
+	Get(name string) ([]byte, error)
+	Delete(name string) error
+}
+
+// dirColdStore keeps cold entries in a directory, typically a mounted network
+// filesystem or object storage bucket (e.g. S3 via mountpoint-s3 or s3fs).
+type dirColdStore struct {
+	dir string
+}
+
+func (d dirColdStore) Put(name string, data []byte) error {
+	return writeFileAtomic(filepath.Join(d.dir, filepath.FromSlash(name)), data, false)
+}
+
+func (d dirColdStore) Get(name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(d.dir, filepath.FromSlash(name)))
+}
+
+func (d dirColdStore) Delete(name string) error {
+	err := os.Remove(filepath.Join(d.dir, filepath.FromSlash(name)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// coldLayout names cold objects after the SHA-256 of the entry's URL (and
+// tenant).
+var coldLayout = cacheLayout{keyScheme: keySchemeSHA256, shardDepth: 2}
+
+// coldObjectName returns the name of an entry's content in cold storage.
+func coldObjectName(md *entryMetadata) string {
+	return coldLayout.relPath(coldLayout.tenantKey(md.Tenant, md.contentURL()))
+}
+
+// errNotCold reports a thaw of an entry that isn't in cold storage.
+var errNotCold = errors.New("entry is not in cold storage")
+
+// runTiering moves entries that haven't been accessed for coldAfter to cold
+// storage, every interval until ctx is done.
+func (s *Server) runTiering(ctx context.Context, interval, coldAfter time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			done, err := s.maintenance.enter()
+			if err != nil {
+				continue // Skip passes during maintenance.
+			}
+			if err := s.tierColdEntries(coldAfter); err != nil {
+				s.logger.Printf("Error: tiering pass failed: %v", err)
+			}
+			done()
+		}
+	}
+}
+
+// tierColdEntries moves every entry idle for longer than coldAfter to cold storage.
+func (s *Server) tierColdEntries(coldAfter time.Duration) error {
+	cutoff := time.Now().Add(-coldAfter)
+	moved := 0
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil || md.AliasOf != "" || md.lastAccess().After(cutoff) {
+			return nil
+		}
+		frozen, err := s.freeze(cacheKey, cutoff)
+		if err != nil {
+			s.logger.Printf("Error: failed to move %s to cold storage: %v", md.URL, err)
+			return nil
+		}
+		if frozen {
+			moved++
+		}
+		return nil
+	})
+	s.logger.Printf("Tiering pass finished: moved %d entries to cold storage", moved)
+	return err
+}
+
+// freeze moves an entry's content to cold storage, leaving its metadata
+// behind, unless it was refetched, aliased or accessed after cutoff since
+// the tiering pass read it.  It reports whether the entry was moved.
+func (s *Server) freeze(cacheKey string, cutoff time.Time) (bool, error) {
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if md.AliasOf != "" || !md.hasLocalContent() || md.lastAccess().After(cutoff) {
+		return false, nil
+	}
+	name := s.contentName(cacheKey)
+	content, err := s.storage.Read(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if err := s.coldStore.Put(coldObjectName(md), content); err != nil {
+		return false, err
+	}
+	md.Codec = md.codec()
+	md.Cold = true
+	if err := s.writeMetadata(cacheKey, md); err != nil {
+		return false, err
+	}
+	s.untrackEntry(cacheKey)
+	return true, s.storage.Remove(name)
+}
+
+// thaw moves an entry's content back from cold storage.
+func (s *Server) thaw(cacheKey string) error {
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+
+	md, err := s.readMetadata(cacheKey)
+	if err != nil || !md.Cold {
+		return errNotCold
+	}
+	if _, err := s.storage.Stat(s.contentName(cacheKey)); err == nil {
+		return nil // Thawed by another request while we waited for the lock.
+	}
+
+	s.logger.Printf("Restoring %s from cold storage", md.URL)
+	name := coldObjectName(md)
+	content, err := s.coldStore.Get(name)
+	if err != nil {
+		return err
+	}
+	if err := s.storage.Write(s.contentName(cacheKey), content); err != nil {
+		return err
+	}
+	md.Cold = false
+	md.LastAccessedAt = time.Now()
+	if err := s.writeMetadata(cacheKey, md); err != nil {
+		return err
+	}
+	s.trackEntry(cacheKey, md)
+	if err := s.coldStore.Delete(name); err != nil {
+		s.logger.Printf("Warning: failed to delete cold copy of %s: %v", md.URL, err)
+	}
+	return nil
+}
+
+// lockEntry serializes changes to the entry under a cache key: tiering,
+// legal holds, retention and metadata rewrites.
+func (s *Server) lockEntry(cacheKey string) func() {
+	unlock := s.urlLocks.lock("entry:" + cacheKey)
+	return unlock
+}
+
+// readLockEntry keeps the entry under a cache key from changing while its
+// metadata and content are read, so the two match: content is only written
+// with its metadata under lockEntry, and a reader could otherwise decode new
+// content with old metadata's codec, dictionary or tenant.  It must not be
+// held while taking lockEntry for the same key.
+func (s *Server) readLockEntry(cacheKey string) func() {
+	return s.urlLocks.rlock("entry:" + cacheKey)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// timingPhase is a part of a request measured for debug output; see
+// pb.Timing.
+type timingPhase int
+
+const (
+	phaseLockWait timingPhase = iota
+	phaseQueueWait
+	phaseSessionCreate
+	phaseNavigation
+	phaseRenderWait
+	phaseCapture
+	phaseMinify
+	phaseCompress
+	phaseStore
+	phaseCacheRead
+	phaseProcessors
+	numTimingPhases
+)
+
+// requestTiming accumulates where a debug request spends its time.  It
+// travels in the request context so fetchers can record their phases too.
+// A nil *requestTiming records nothing, so callers needn't check whether the
+// request asked for debug output.
+type requestTiming struct {
+	start  time.Time
+	phases [numTimingPhases]time.Duration
+	seen   [numTimingPhases]bool
+	usage  *ResourceUsage // What rendering cost the browser, if measured
+}
+
+type timingKey struct{}
+
+// withTiming starts timing a request.
+func withTiming(ctx context.Context) context.Context {
+	return context.WithValue(ctx, timingKey{}, &requestTiming{start: time.Now()})
+}
+
+// timingFrom returns the request's timing, or nil if it isn't being timed.
+func timingFrom(ctx context.Context) *requestTiming {
+	t, _ := ctx.Value(timingKey{}).(*requestTiming)
+	return t
+}
+
+// since adds the time elapsed since start to a phase.
+func (t *requestTiming) since(phase timingPhase, start time.Time) {
+	if t == nil {
+		return
+	}
+	t.phases[phase] += time.Since(start)
+	t.seen[phase] = true
+}
+
+// recordUsage records what rendering the page cost the browser.
+func (t *requestTiming) recordUsage(u *ResourceUsage) {
+	if t == nil {
+		return
+	}
+	t.usage = u
+}
+
+// proto returns the breakdown so far, or nil if the request isn't being timed.
+func (t *requestTiming) proto() *pb.Timing {
+	if t == nil {
+		return nil
+	}
+	d := func(phase timingPhase) *durationpb.Duration {
+		if !t.seen[phase] {
+			return nil
+		}
+		return durationpb.New(t.phases[phase])
+	}
+	p := &pb.Timing{
+		Total:         durationpb.New(time.Since(t.start)),
+		LockWait:      d(phaseLockWait),
+		QueueWait:     d(phaseQueueWait),
+		SessionCreate: d(phaseSessionCreate),
+		Navigation:    d(phaseNavigation),
+		RenderWait:    d(phaseRenderWait),
+		Capture:       d(phaseCapture),
+		Minify:        d(phaseMinify),
+		Compress:      d(phaseCompress),
+		Store:         d(phaseStore),
+		CacheRead:     d(phaseCacheRead),
+		Processors:    d(phaseProcessors),
+	}
+	if u := t.usage; u != nil {
+		p.BrowserCpuTime = durationpb.New(u.CPUTime)
+		p.BrowserHeapBytes = u.HeapBytes
+		p.BytesTransferred = u.BytesTransferred
+	}
+	return p
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TLSInfo describes the TLS connection a page was served over.
+type TLSInfo struct {
+	Protocol string `json:"protocol,omitempty"` // e.g. "TLS 1.3"
+	Cipher   string `json:"cipher,omitempty"`
+	// Certificates are the server's certificate, then the chain it was
+	// verified with, as far as it is known.
+	Certificates []CertificateInfo `json:"certificates,omitempty"`
+}
+
+// CertificateInfo describes an X.509 certificate.
+type CertificateInfo struct {
+	Subject   string    `json:"subject"` // Common name, or the full name if it has none
+	Issuer    string    `json:"issuer"`
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
+	DNSNames  []string  `json:"dns_names,omitempty"`
+}
+
+// expiresBefore reports whether any of the certificates expires before t.
+func (t *TLSInfo) expiresBefore(before time.Time) bool {
+	if t == nil {
+		return false
+	}
+	for _, c := range t.Certificates {
+		if c.NotAfter.Before(before) {
+			return true
+		}
+	}
+	return false
+}
+
+// tlsInfoFromConn describes an HTTP client's TLS connection.  Plain HTTP
+// connections have none.
+func tlsInfoFromConn(state *tls.ConnectionState) *TLSInfo {
+	if state == nil {
+		return nil
+	}
+	t := &TLSInfo{Protocol: tls.VersionName(state.Version), Cipher: tls.CipherSuiteName(state.CipherSuite)}
+	chain := state.PeerCertificates
+	if len(state.VerifiedChains) > 0 {
+		chain = state.VerifiedChains[0]
+	}
+	for _, c := range chain {
+		t.Certificates = append(t.Certificates, certificateInfo(c))
+	}
+	return t
+}
+
+func certificateInfo(c *x509.Certificate) CertificateInfo {
+	name := func(n string, full fmt.Stringer) string {
+		if n == "" {
+			return full.String()
+		}
+		return n
+	}
+	return CertificateInfo{
+		Subject:   name(c.Subject.CommonName, c.Subject),
+		Issuer:    name(c.Issuer.CommonName, c.Issuer),
+		NotBefore: c.NotBefore,
+		NotAfter:  c.NotAfter,
+		DNSNames:  c.DNSNames,
+	}
+}
+
+// securityDetails is the TLS connection of a response in DevTools' network
+// events.
+type securityDetails struct {
+	Protocol    string   `json:"protocol"`
+	Cipher      string   `json:"cipher"`
+	SubjectName string   `json:"subjectName"`
+	Issuer      string   `json:"issuer"`
+	SANList     []string `json:"sanList"`
+	ValidFrom   float64  `json:"validFrom"` // Seconds since the epoch
+	ValidTo     float64  `json:"validTo"`
+}
+
+// tlsInfoFromLog describes the TLS connection of the last document loaded
+// into the main frame, which is the page that was captured, with only the
+// server's certificate.  Pages served over plain HTTP have none.
+func tlsInfoFromLog(events []perfLogEvent) *TLSInfo {
+	var t *TLSInfo
+	for _, ev := range mainFrameEvents(events) {
+		if ev.method != "Network.responseReceived" || ev.Response == nil {
+			continue
+		}
+		d := ev.Response.SecurityDetails
+		if d == nil {
+			t = nil
+			continue
+		}
+		seconds := func(s float64) time.Time { return time.Unix(0, int64(s*float64(time.Second))).UTC() }
+		t = &TLSInfo{Protocol: d.Protocol, Cipher: d.Cipher, Certificates: []CertificateInfo{{
+			Subject:   d.SubjectName,
+			Issuer:    d.Issuer,
+			NotBefore: seconds(d.ValidFrom),
+			NotAfter:  seconds(d.ValidTo),
+			DNSNames:  d.SANList,
+		}}}
+	}
+	return t
+}
+
+// certificateChain returns the certificate chain the browser verified for
+// a page's origin.
+func certificateChain(ctx context.Context, driverURL, sessionID, pageURL string) ([]CertificateInfo, error) {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		TableNames []string `json:"tableNames"` // Base64 DER, the server's first
+	}
+	origin := map[string]string{"origin": u.Scheme + "://" + u.Host}
+	if err := devtoolsCommand(ctx, driverURL, sessionID, "Network.getCertificate", origin, &result); err != nil {
+		return nil, err
+	}
+	var chain []CertificateInfo
+	for _, encoded := range result.TableNames {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, err
+		}
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, certificateInfo(c))
+	}
+	return chain, nil
+}
+
+// proto converts TLS details for responses.  Nil converts to nil.
+func (t *TLSInfo) proto() *pb.TLSInfo {
+	if t == nil {
+		return nil
+	}
+	p := &pb.TLSInfo{Protocol: t.Protocol, Cipher: t.Cipher}
+	for _, c := range t.Certificates {
+		p.Certificates = append(p.Certificates, &pb.Certificate{
+			Subject:   c.Subject,
+			Issuer:    c.Issuer,
+			NotBefore: timestamppb.New(c.NotBefore),
+			NotAfter:  timestamppb.New(c.NotAfter),
+			DnsNames:  c.DNSNames,
+		})
+	}
+	return p
+}
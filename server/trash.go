@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// trashSubdir holds cached copies replaced by invalidating requests or
+	// purged, one file per cache key, until the restore window passes.
+	trashSubdir        = ".trash"
+	trashPurgeInterval = time.Hour
+)
+
+// trashedEntry is a cached copy replaced by an invalidating request, or
+// purged.  Its content is as it was stored: compressed, and encrypted for
+// tenants.
+type trashedEntry struct {
+	TrashedAt time.Time      `json:"trashed_at"`
+	Metadata  *entryMetadata `json:"metadata"`
+	Content   []byte         `json:"content"`
+}
+
+// trashName returns the Storage name of the trashed copy for a key.
+func (l cacheLayout) trashName(cacheKey string) string {
+	return path.Join(trashSubdir, l.relPath(cacheKey)+".json")
+}
+
+// errNotTrashed reports a key with no copy to restore.
+var errNotTrashed = errors.New("no invalidated copy to restore")
+
+// trashReplaced moves the entry under a cache key to the trash if an
+// invalidating request is about to replace it.  The caller holds the entry
+// lock.
+func (s *Server) trashReplaced(cacheKey string, cacheOpts *pb.CacheOptions) error {
+	if s.trashRetention <= 0 || !cacheOpts.GetInvalidate() {
+		return nil
+	}
+	return s.trashEntry(cacheKey)
+}
+
+// trashEntry copies the entry under a cache key to the trash, replacing any
+// copy trashed before.  Aliases and cold entries have no local content and
+// aren't kept.  The caller holds the entry lock.
+func (s *Server) trashEntry(cacheKey string) error {
+	md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil || !md.hasLocalContent() {
+		return err
+	}
+	content, err := s.storage.Read(s.contentName(cacheKey))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	data, err := json.Marshal(trashedEntry{TrashedAt: time.Now(), Metadata: md, Content: content})
+	if err != nil {
+		return err
+	}
+	return s.storage.Write(s.layout.trashName(cacheKey), data)
+}
+
+// readTrashed returns the trashed copy for a key if it is still within the
+// restore window.
+func (s *Server) readTrashed(cacheKey string) (*trashedEntry, error) {
+	data, err := s.storage.Read(s.layout.trashName(cacheKey))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errNotTrashed
+	} else if err != nil {
+		return nil, err
+	}
+	var t trashedEntry
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	if t.Metadata == nil || time.Since(t.TrashedAt) > s.trashRetention {
+		return nil, errNotTrashed
+	}
+	return &t, nil
+}
+
+// restoreTrashed puts the trashed copy for a key back in place of the
+// current one, if it was trashed at or after a time.  Held entries are not
+// replaced.  It returns the URL restored.
+func (s *Server) restoreTrashed(ctx context.Context, cacheKey string, after time.Time) (string, error) {
+	unlock := s.lockEntry(cacheKey)
+	defer unlock()
+
+	t, err := s.readTrashed(cacheKey)
+	if err != nil {
+		return "", err
+	}
+	if t.TrashedAt.Before(after) {
+		return "", errNotTrashed
+	}
+	md := t.Metadata
+	md.AXTree = false    // The trash doesn't keep accessibility trees,
+	md.Thumbnail = false // or thumbnails.
+	if s.held(cacheKey) {
+		return "", status.Errorf(codes.FailedPrecondition, "the cached copy of %s is under legal hold", md.URL)
+	}
+	if err := s.storage.Write(s.contentName(cacheKey), t.Content); err != nil {
+		return "", err
+	}
+	if err := s.writeMetadata(cacheKey, md); err != nil {
+		return "", err
+	}
+	s.indexContentHash(cacheKey, md)
+	s.trackEntry(cacheKey, md)
+	if content, err := s.readContent(ctx, s.contentName(cacheKey), md); err == nil {
+		s.indexText(cacheKey, md, content)
+	}
+	if err := s.storage.Remove(s.layout.trashName(cacheKey)); err != nil {
+		s.logger.Printf("Warning: failed to remove restored copy of %s from the trash: %v", md.URL, err)
+	}
+	s.logger.Printf("Restored invalidated copy of %s, fetched %v", md.URL, md.FetchedAt)
+	return md.URL, nil
+}
+
+// RestoreInvalidated handles the gRPC request.
+func (s *Server) RestoreInvalidated(ctx context.Context, req *pb.RestoreInvalidatedRequest) (*pb.RestoreInvalidatedResponse, error) {
+	if s.trashRetention <= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalidated copies are not kept; set TRASH_RETENTION")
+	}
+	if err := validateTenant(req.GetTenant()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	done, err := s.maintenance.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	after := req.GetInvalidatedAfter().AsTime() // The epoch if unset
+	resp := &pb.RestoreInvalidatedResponse{}
+	if req.GetUrl() != "" {
+		cacheKey := s.resolveAlias(s.layout.tenantKey(req.GetTenant(), req.GetUrl()))
+		restored, err := s.restoreTrashed(ctx, cacheKey, after)
+		if errors.Is(err, errNotTrashed) {
+			return nil, status.Errorf(codes.NotFound, "no invalidated copy of %s to restore", req.GetUrl())
+		} else if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.Internal, "failed to restore %s: %v", req.GetUrl(), err)
+		}
+		resp.Urls = append(resp.Urls, restored)
+		return resp, nil
+	}
+
+	s.logger.Printf("Restoring invalidated copies for tenant %q since %v", req.GetTenant(), after)
+	err = s.storage.Walk(trashSubdir, func(name string, info FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cacheKey := strings.TrimSuffix(path.Base(name), ".json")
+		if info.IsDir || name != s.layout.trashName(cacheKey) {
+			return nil
+		}
+		if t, err := s.readTrashed(cacheKey); err != nil || t.Metadata.Tenant != req.GetTenant() {
+			return nil
+		}
+		restored, err := s.restoreTrashed(ctx, cacheKey, after)
+		if err != nil {
+			if !errors.Is(err, errNotTrashed) {
+				s.logger.Printf("Warning: failed to restore %s: %v", name, err)
+			}
+			return nil
+		}
+		resp.Urls = append(resp.Urls, restored)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore invalidated copies: %v", err)
+	}
+	s.logger.Printf("Restored %d invalidated copies", len(resp.Urls))
+	return resp, nil
+}
+
+// runTrashPurge deletes trashed copies past the restore window every
+// interval until ctx is done.
+func (s *Server) runTrashPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			done, err := s.maintenance.enter()
+			if err != nil {
+				continue // Skip passes during maintenance.
+			}
+			if err := s.purgeTrash(); err != nil {
+				s.logger.Printf("Error: trash purge failed: %v", err)
+			}
+			done()
+		}
+	}
+}
+
+// purgeTrash deletes trashed copies past the restore window.
+func (s *Server) purgeTrash() error {
+	cutoff := time.Now().Add(-s.trashRetention)
+	purged := 0
+	err := s.storage.Walk(trashSubdir, func(name string, info FileInfo) error {
+		if info.IsDir || info.ModTime.After(cutoff) {
+			return nil
+		}
+		if err := s.storage.Remove(name); err != nil {
+			return err
+		}
+		purged++
+		return nil
+	})
+	if purged > 0 {
+		s.logger.Printf("Trash purge finished: deleted %d copies past the restore window", purged)
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"hash/maphash"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const urlLockShards = 64
+
+// urlLockManager hands out a mutex per key (a URL, or a prefixed cache key
+// or file name), so only one goroutine downloads or changes it at a time.
+// Entry keys can also be locked for reading, so readers see an entry
+// either before or after a change, never halfway through.
+// A key's mutex exists only while it is held or waited for, so the number
+// kept is bounded by the work in progress, however many one-off URLs go
+// through.  Keys are spread over shards, so unrelated keys don't contend.
+type urlLockManager struct {
+	seed   maphash.Seed
+	shards [urlLockShards]urlLockShard
+
+	waiting     atomic.Int64 // Goroutines waiting for a lock now
+	longestWait atomic.Int64 // Nanoseconds; the longest any lock was waited for
+}
+
+type urlLockShard struct {
+	mu    sync.Mutex
+	locks map[string]*urlLock
+}
+
+// urlLock is a key's mutex, with how many goroutines hold or wait for it.
+type urlLock struct {
+	mu   sync.RWMutex
+	refs int
+}
+
+func newURLLockManager() *urlLockManager {
+	m := &urlLockManager{seed: maphash.MakeSeed()}
+	for i := range m.shards {
+		m.shards[i].locks = make(map[string]*urlLock)
+	}
+	return m
+}
+
+// lock waits for the lock of a key, returning a function to release it.
+func (m *urlLockManager) lock(key string) func() {
+	return m.acquire(key, false)
+}
+
+// rlock waits for the lock of a key to be shared with other readers,
+// returning a function to release it.
+func (m *urlLockManager) rlock(key string) func() {
+	return m.acquire(key, true)
+}
+
+func (m *urlLockManager) acquire(key string, shared bool) func() {
+	shard := &m.shards[maphash.String(m.seed, key)%urlLockShards]
+	shard.mu.Lock()
+	l, ok := shard.locks[key]
+	if !ok {
+		l = &urlLock{}
+		shard.locks[key] = l
+	}
+	l.refs++
+	shard.mu.Unlock()
+
+	tryLock, lock, unlock := l.mu.TryLock, l.mu.Lock, l.mu.Unlock
+	if shared {
+		tryLock, lock, unlock = l.mu.TryRLock, l.mu.RLock, l.mu.RUnlock
+	}
+	start := time.Now()
+	if !tryLock() {
+		m.waiting.Add(1)
+		lock()
+		m.waiting.Add(-1)
+		waited := time.Since(start)
+		for {
+			longest := m.longestWait.Load()
+			if int64(waited) <= longest || m.longestWait.CompareAndSwap(longest, int64(waited)) {
+				break
+			}
+		}
+	}
+	return func() {
+		unlock()
+		shard.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(shard.locks, key)
+		}
+		shard.mu.Unlock()
+	}
+}
+
+// count returns how many keys are locked or waited for.
+func (m *urlLockManager) count() int {
+	n := 0
+	for i := range m.shards {
+		shard := &m.shards[i]
+		shard.mu.Lock()
+		n += len(shard.locks)
+		shard.mu.Unlock()
+	}
+	return n
+}
+
+// collectors returns the lock metrics, read when scraped.
+func (m *urlLockManager) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "downloadcache_url_locks",
+			Help: "URLs and entries locked or waited for.",
+		}, func() float64 { return float64(m.count()) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "downloadcache_url_lock_waiters",
+			Help: "Requests waiting for another to finish with a URL or entry.",
+		}, func() float64 { return float64(m.waiting.Load()) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "downloadcache_url_lock_longest_wait_seconds",
+			Help: "The longest wait for a URL or entry lock since startup.",
+		}, func() float64 { return time.Duration(m.longestWait.Load()).Seconds() }),
+	}
+}
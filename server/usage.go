@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// callerHeader is the request header callers identify themselves with
+	// for usage accounting.
+	callerHeader = "x-caller"
+
+	// usageSubdir holds the usage counters, so they survive restarts.
+	usageSubdir        = ".usage"
+	usageFlushInterval = time.Minute
+)
+
+var usageFileName = path.Join(usageSubdir, "usage.json")
+
+// usageKey identifies who usage is charged to.
+type usageKey struct {
+	Caller string `json:"caller"`
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// usageCounters are the usage of one caller for one tenant.
+type usageCounters struct {
+	Requests     int64         `json:"requests"`
+	Hits         int64         `json:"hits"`
+	Misses       int64         `json:"misses"`
+	BytesServed  int64         `json:"bytes_served"`
+	BytesFetched int64         `json:"bytes_fetched"`
+	FetchTime    time.Duration `json:"fetch_time"`
+}
+
+// usageFile is the stored form of the counters.
+type usageFile struct {
+	Since time.Time    `json:"since"`
+	Usage []usageEntry `json:"usage"`
+}
+
+type usageEntry struct {
+	usageKey
+	usageCounters
+}
+
+// usageTracker accumulates usage per caller and tenant.
+type usageTracker struct {
+	mu       sync.Mutex
+	since    time.Time
+	counters map[usageKey]*usageCounters
+	dirty    bool       // Changed since last saved
+	saving   sync.Mutex // Keeps saves in order, so an older snapshot never overwrites a newer one
+}
+
+// callerOf returns the caller named by a request's x-caller header, or ""
+// if there is none.
+func callerOf(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, callerHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// record updates the counters a request is charged to.
+func (u *usageTracker) record(ctx context.Context, tenant string, update func(*usageCounters)) {
+	key := usageKey{Caller: callerOf(ctx), Tenant: tenant}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.counters == nil {
+		u.counters = make(map[usageKey]*usageCounters)
+		u.since = time.Now()
+	}
+	c, ok := u.counters[key]
+	if !ok {
+		c = &usageCounters{}
+		u.counters[key] = c
+	}
+	update(c)
+	u.dirty = true
+}
+
+// load restores counters saved by save.
+func (u *usageTracker) load(st Storage) error {
+	data, err := st.Read(usageFileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var f usageFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.since = f.Since
+	u.counters = make(map[usageKey]*usageCounters, len(f.Usage))
+	for _, e := range f.Usage {
+		c := e.usageCounters
+		u.counters[e.usageKey] = &c
+	}
+	return nil
+}
+
+// save stores the counters if they have changed.
+func (u *usageTracker) save(st Storage) error {
+	u.saving.Lock()
+	defer u.saving.Unlock()
+	u.mu.Lock()
+	if !u.dirty {
+		u.mu.Unlock()
+		return nil
+	}
+	f := usageFile{Since: u.since, Usage: u.snapshotLocked()}
+	u.dirty = false
+	u.mu.Unlock()
+
+	data, err := json.Marshal(f)
+	if err == nil {
+		err = st.Write(usageFileName, data)
+	}
+	if err != nil {
+		u.mu.Lock()
+		u.dirty = true
+		u.mu.Unlock()
+	}
+	return err
+}
+
+func (u *usageTracker) snapshotLocked() []usageEntry {
+	entries := make([]usageEntry, 0, len(u.counters))
+	for key, c := range u.counters {
+		entries = append(entries, usageEntry{key, *c})
+	}
+	return entries
+}
+
+// runUsageFlush saves the usage counters every interval until ctx is done.
+func (s *Server) runUsageFlush(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			done, err := s.maintenance.enter()
+			if err != nil {
+				continue // Counters are kept in memory until maintenance ends.
+			}
+			if err := s.usage.save(s.storage); err != nil {
+				s.logger.Printf("Error: failed to save usage counters: %v", err)
+			}
+			done()
+		}
+	}
+}
+
+// GetUsageReport handles the gRPC request.
+func (s *Server) GetUsageReport(ctx context.Context, req *pb.GetUsageReportRequest) (*pb.GetUsageReportResponse, error) {
+	if req.GetResetCounters() {
+		done, err := s.maintenance.enter()
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+	}
+
+	s.usage.mu.Lock()
+	entries := s.usage.snapshotLocked()
+	since := s.usage.since
+	if req.GetResetCounters() {
+		for _, e := range entries {
+			if (req.GetCaller() == "" || e.Caller == req.GetCaller()) && (req.GetTenant() == "" || e.Tenant == req.GetTenant()) {
+				delete(s.usage.counters, e.usageKey)
+			}
+		}
+		if req.GetCaller() == "" && req.GetTenant() == "" {
+			s.usage.since = time.Now()
+		}
+		s.usage.dirty = true
+	}
+	s.usage.mu.Unlock()
+	if req.GetResetCounters() {
+		// Save now, so a restart can't bring back what was just billed.
+		if err := s.usage.save(s.storage); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save usage counters: %v", err)
+		}
+		s.logger.Printf("Usage counters reset, Caller: %q, Tenant: %q", req.GetCaller(), req.GetTenant())
+	}
+
+	resp := &pb.GetUsageReportResponse{}
+	if !since.IsZero() {
+		resp.Since = timestamppb.New(since)
+	}
+	for _, e := range entries {
+		if req.GetCaller() != "" && e.Caller != req.GetCaller() {
+			continue
+		}
+		if req.GetTenant() != "" && e.Tenant != req.GetTenant() {
+			continue
+		}
+		resp.Usage = append(resp.Usage, &pb.CallerUsage{
+			Caller:       e.Caller,
+			Tenant:       e.Tenant,
+			Requests:     e.Requests,
+			Hits:         e.Hits,
+			Misses:       e.Misses,
+			BytesServed:  e.BytesServed,
+			BytesFetched: e.BytesFetched,
+			FetchTime:    durationpb.New(e.FetchTime),
+		})
+	}
+	sort.Slice(resp.Usage, func(i, j int) bool {
+		return resp.Usage[i].GetBytesServed() > resp.Usage[j].GetBytesServed()
+	})
+	return resp, nil
+}
@@ -0,0 +1,403 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	defaultWarmConcurrency = 4
+	maxWarmConcurrency     = 64
+	defaultWarmPerDomain   = 2
+	warmProgressInterval   = time.Second
+	maxWarmLineBytes       = 1 << 20
+)
+
+// warmProgress counts a warm's URLs as they are read and fetched.
+type warmProgress struct {
+	mu                   sync.Mutex
+	warmID               string // The ID RetryFailed takes for the warm
+	queued, done, failed int64
+	rowErrors            int64            // Failures of malformed rows, which were never queued
+	failures             []*pb.ItemResult // Since the last message
+}
+
+// counts reports the URLs queued but not done yet, done, and failed.
+func (p *warmProgress) counts() (queued, done, failed int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.queued - p.done - (p.failed - p.rowErrors), p.done, p.failed
+}
+
+// fail records a URL, or (with no URL) a row of the file, that couldn't be
+// cached.
+func (p *warmProgress) fail(rawURL string, err error, elapsed time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failed++
+	if rawURL == "" {
+		p.rowErrors++
+	}
+	p.failures = append(p.failures, &pb.ItemResult{
+		Url:       rawURL,
+		Code:      int32(status.Code(err)),
+		Retryable: retryableCode(status.Code(err)),
+		Message:   status.Convert(err).Message(),
+		Duration:  durationpb.New(elapsed),
+	})
+}
+
+// message reports the progress since the last message.
+func (p *warmProgress) message(finished bool) *pb.WarmProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	msg := &pb.WarmProgress{WarmId: p.warmID, Queued: p.queued, Done: p.done, Failed: p.failed, Failures: p.failures, Finished: finished}
+	p.failures = nil
+	return msg
+}
+
+// domainSlots limits how many fetches from each hostname run at once.
+type domainSlots struct {
+	limit int
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+// acquire waits for a free slot for host, returning a function that frees
+// it, or ctx's error.
+func (d *domainSlots) acquire(ctx context.Context, host string) (func(), error) {
+	d.mu.Lock()
+	ch, ok := d.slots[host]
+	if !ok {
+		ch = make(chan struct{}, d.limit)
+		d.slots[host] = ch
+	}
+	d.mu.Unlock()
+	select {
+	case ch <- struct{}{}:
+		return func() { <-ch }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// WarmFromFile handles the gRPC request.
+func (s *Server) WarmFromFile(stream pb.DownloadCache_WarmFromFileServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "no file sent")
+	} else if err != nil {
+		return err
+	}
+	if _, ok := pb.WarmFormat_name[int32(first.GetFormat())]; !ok || first.GetFormat() == pb.WarmFormat_WARM_FORMAT_UNSPECIFIED {
+		return status.Errorf(codes.InvalidArgument, "format must be CSV or JSONL")
+	}
+	if c := first.GetConcurrency(); c < 0 || c > maxWarmConcurrency {
+		return status.Errorf(codes.InvalidArgument, "concurrency must be between 0 and %d", maxWarmConcurrency)
+	}
+	if first.GetPerDomainConcurrency() < 0 {
+		return status.Errorf(codes.InvalidArgument, "per_domain_concurrency must not be negative")
+	}
+	defaults := &pb.DownloadCacheRequest{Tenant: first.GetTenant(), FetchOptions: first.GetFetchOptions(), CacheOptions: first.GetCacheOptions()}
+	fetchOpts, cacheOpts := s.mergeOptions(defaults)
+	if err := s.validateFetchOptions(fetchOpts); err != nil {
+		return err
+	}
+	if err := validateCacheOptions(cacheOpts); err != nil {
+		return err
+	}
+	if err := s.browser.validateBrowserOptions(first.GetFetchOptions()); err != nil {
+		return err
+	}
+	if err := s.checkTenant(stream.Context(), first.GetTenant()); err != nil {
+		return err
+	}
+	concurrency := int(first.GetConcurrency())
+	if concurrency == 0 {
+		concurrency = defaultWarmConcurrency
+	}
+	perDomain := int(first.GetPerDomainConcurrency())
+	if perDomain == 0 {
+		perDomain = defaultWarmPerDomain
+	}
+	run, err := newFailedRun(runWarm, first.GetTenant())
+	if err != nil {
+		return err
+	}
+	// The URLs that failed are kept, even if the warm is cut short, so
+	// RetryFailed can warm them again by the warm's ID.
+	defer s.saveFailedRun(run)
+	s.logger.Printf("Received warm request %s: %v file, %d at a time", run.ID, first.GetFormat(), concurrency)
+
+	progress := &warmProgress{warmID: run.ID}
+	format := strings.TrimPrefix(first.GetFormat().String(), "WARM_FORMAT_")
+	ctx, finish := s.startJob(stream.Context(), pb.JobKind_JOB_KIND_WARM, format+" file", first.GetTenant(), progress.counts)
+	defer finish()
+
+	// The file is piped from the stream to the parser, which hands out the
+	// URLs to the workers.  Reading stops while the workers are busy, so
+	// the client can't send faster than URLs are fetched.
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		for msg := first; ; {
+			if _, err := pw.Write(msg.GetData()); err != nil {
+				return
+			}
+			var err error
+			if msg, err = stream.Recv(); err == io.EOF {
+				pw.Close()
+				return
+			} else if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	requests := make(chan *pb.DownloadCacheRequest, concurrency)
+	var parseErr error
+	go func() {
+		defer close(requests)
+		parseErr = parseWarmFile(first.GetFormat(), pr, defaults, func(req *pb.DownloadCacheRequest, err error) bool {
+			if err != nil {
+				progress.fail("", status.Errorf(codes.InvalidArgument, "%v", err), 0)
+				return true
+			}
+			progress.mu.Lock()
+			progress.queued++
+			progress.mu.Unlock()
+			select {
+			case requests <- req:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	slots := &domainSlots{limit: perDomain, slots: make(map[string]chan struct{})}
+	var workers sync.WaitGroup
+	for range concurrency {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for req := range requests {
+				start := time.Now()
+				if err := s.warm(ctx, slots, req); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					progress.fail(req.GetUrl(), err, time.Since(start))
+					run.fail(req, err, time.Since(start))
+					continue
+				}
+				progress.mu.Lock()
+				progress.done++
+				progress.mu.Unlock()
+			}
+		}()
+	}
+	finished := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(finished)
+	}()
+
+	ticker := time.NewTicker(warmProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := stream.Send(progress.message(false)); err != nil {
+				return err
+			}
+		case <-finished:
+			if err := ctx.Err(); err != nil {
+				return status.FromContextError(err).Err()
+			}
+			if _, ok := status.FromError(parseErr); !ok {
+				return status.Errorf(codes.InvalidArgument, "failed to read the file: %v", parseErr)
+			}
+			if parseErr != nil {
+				return parseErr // The stream failed.
+			}
+			msg := progress.message(true)
+			s.logger.Printf("Warm %s finished: %d URLs done, %d failed", run.ID, msg.GetDone(), msg.GetFailed())
+			return stream.Send(msg)
+		}
+	}
+}
+
+// warm caches one URL of a warm.  Like a crawl, it waits out offline mode,
+// maintenance and pauses of the URL's domain rather than failing.
+func (s *Server) warm(ctx context.Context, slots *domainSlots, req *pb.DownloadCacheRequest) error {
+	if !isHTTP(req.GetUrl()) {
+		return status.Errorf(codes.InvalidArgument, "URL must be http or https")
+	}
+	release, err := slots.acquire(ctx, hostOf(req.GetUrl()))
+	if err != nil {
+		return err
+	}
+	defer release()
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if wait := s.crawlWait(req.GetUrl()); wait > 0 {
+			sleepContext(ctx, wait)
+			continue
+		}
+		done, err := s.maintenance.enter()
+		if err != nil {
+			sleepContext(ctx, crawlRetryDelay)
+			continue
+		}
+		_, err = s.Get(ctx, req)
+		done()
+		return err
+	}
+}
+
+// parseWarmFile reads the requests in a warm file, each with the stream's
+// defaults under the row's own options, and calls fn with each, or with
+// the error for a malformed row, until it returns false.  It returns an
+// error if the file can't be read.
+func parseWarmFile(format pb.WarmFormat, r io.Reader, defaults *pb.DownloadCacheRequest, fn func(*pb.DownloadCacheRequest, error) bool) error {
+	if format == pb.WarmFormat_WARM_FORMAT_JSONL {
+		return parseWarmJSONL(r, defaults, fn)
+	}
+	return parseWarmCSV(r, defaults, fn)
+}
+
+func parseWarmJSONL(r io.Reader, defaults *pb.DownloadCacheRequest, fn func(*pb.DownloadCacheRequest, error) bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxWarmLineBytes)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		row := &pb.DownloadCacheRequest{}
+		if err := protojson.Unmarshal([]byte(text), row); err != nil {
+			if !fn(nil, fmt.Errorf("line %d: %v", line, err)) {
+				return nil
+			}
+			continue
+		}
+		if row.GetTenant() != "" && row.GetTenant() != defaults.GetTenant() {
+			if !fn(nil, fmt.Errorf("line %d: tenant must be set for the whole file", line)) {
+				return nil
+			}
+			continue
+		}
+		req := proto.Clone(defaults).(*pb.DownloadCacheRequest)
+		proto.Merge(req, row)
+		if !fn(req, nil) {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+func parseWarmCSV(r io.Reader, defaults *pb.DownloadCacheRequest, fn func(*pb.DownloadCacheRequest, error) bool) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	columns := []string{"url"} // Without a header, the rest are ignored.
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			if !fn(nil, parseErr) {
+				return nil
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+		if row == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "url") {
+			columns = nil
+			for _, name := range record {
+				name = strings.ToLower(strings.TrimSpace(name))
+				switch name {
+				case "url", "invalidate", "render_wait_ms", "max_redirects":
+				default:
+					return fmt.Errorf("unknown column %q (want url, invalidate, render_wait_ms or max_redirects)", name)
+				}
+				columns = append(columns, name)
+			}
+			continue
+		}
+		req, err := csvWarmRequest(columns, record, defaults)
+		if err != nil {
+			err = fmt.Errorf("row %d: %v", row, err)
+		}
+		if !fn(req, err) {
+			return nil
+		}
+	}
+}
+
+// csvWarmRequest builds the request for a CSV row.
+func csvWarmRequest(columns, record []string, defaults *pb.DownloadCacheRequest) (*pb.DownloadCacheRequest, error) {
+	req := proto.Clone(defaults).(*pb.DownloadCacheRequest)
+	if req.FetchOptions == nil {
+		req.FetchOptions = &pb.FetchOptions{}
+	}
+	if req.CacheOptions == nil {
+		req.CacheOptions = &pb.CacheOptions{}
+	}
+	for i, name := range columns {
+		if i >= len(record) {
+			break
+		}
+		value := strings.TrimSpace(record[i])
+		if value == "" && name != "url" {
+			continue
+		}
+		switch name {
+		case "url":
+			req.Url = value
+		case "invalidate":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid invalidate %q", value)
+			}
+			req.CacheOptions.Invalidate = b
+		case "render_wait_ms", "max_redirects":
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q", name, value)
+			}
+			if name == "render_wait_ms" {
+				req.FetchOptions.RenderWaitMs = proto.Int32(int32(n))
+			} else {
+				req.FetchOptions.MaxRedirects = proto.Int32(int32(n))
+			}
+		}
+	}
+	if req.GetUrl() == "" {
+		return nil, errors.New("no URL")
+	}
+	return req, nil
+}
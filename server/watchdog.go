@@ -0,0 +1,106 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultFetchHardTimeout = 10 * time.Minute
+	// fetchAbandonGrace is how long a canceled fetch has to return before
+	// it is abandoned.  It covers stopping the browser's navigation and
+	// force-quitting its session.
+	fetchAbandonGrace = 2 * stopNavigationTimeout
+	// goroutineDumpInterval limits how often stuck fetches log goroutine
+	// dumps, which can be large.
+	goroutineDumpInterval = time.Minute
+)
+
+// errFetchStuck is the cause of a fetch canceled for running past the hard
+// timeout.
+var errFetchStuck = errors.New("fetch exceeded the hard timeout")
+
+// fetchResult is what a fetch returned.
+type fetchResult struct {
+	page *fetchedPage
+	err  error
+}
+
+// watchdog tracks when goroutine dumps were last logged.
+type watchdog struct {
+	mu       sync.Mutex
+	lastDump time.Time
+}
+
+// watchFetch runs fetch, which holds rawURL's lock, with the server's hard
+// timeout for fetches.  A fetch still running when it is reached is
+// canceled, which has the Selenium fetcher force-quit its session.  A fetch
+// that doesn't return soon after being canceled, for whatever reason, is
+// abandoned: a goroutine dump is logged and watchFetch returns, so the
+// caller releases the URL's lock; the fetch's result is dropped whenever it
+// returns.
+func (s *Server) watchFetch(ctx context.Context, rawURL string, fetch func(context.Context) (*fetchedPage, error)) (*fetchedPage, error) {
+	if s.fetchHardTimeout <= 0 {
+		return fetch(ctx)
+	}
+	ctx, cancel := context.WithTimeoutCause(ctx, s.fetchHardTimeout, errFetchStuck)
+	defer cancel()
+	done := make(chan fetchResult, 1)
+	go func() {
+		page, err := fetch(ctx)
+		done <- fetchResult{page, err}
+	}()
+	select {
+	case r := <-done:
+		return r.page, r.err
+	case <-ctx.Done():
+	}
+
+	stuck := errors.Is(context.Cause(ctx), errFetchStuck)
+	if stuck {
+		s.logger.Printf("Error: fetch of %s still running after %v; canceling it", rawURL, s.fetchHardTimeout)
+	}
+	select {
+	case r := <-done:
+		if r.err != nil && stuck {
+			return nil, status.Errorf(codes.DeadlineExceeded, "the fetch of %s took longer than %v", rawURL, s.fetchHardTimeout)
+		}
+		return r.page, r.err
+	case <-time.After(fetchAbandonGrace):
+	}
+	s.logger.Printf("Error: fetch of %s did not return within %v of being canceled; abandoning it", rawURL, fetchAbandonGrace)
+	if s.watchdog.dumpDue() {
+		s.DumpGoroutines("stuck fetch of " + rawURL)
+	}
+	if stuck {
+		return nil, status.Errorf(codes.DeadlineExceeded, "the fetch of %s took longer than %v", rawURL, s.fetchHardTimeout)
+	}
+	return nil, status.FromContextError(ctx.Err()).Err()
+}
+
+// dumpDue reports whether a goroutine dump may be logged now, and if so
+// records that one was.
+func (w *watchdog) dumpDue() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if time.Since(w.lastDump) < goroutineDumpInterval {
+		return false
+	}
+	w.lastDump = time.Now()
+	return true
+}
+
+// DumpGoroutines logs the stacks of all goroutines, to see where a server
+// that seems stuck is waiting.  cmd/server calls it on SIGQUIT.
+func (s *Server) DumpGoroutines(reason string) {
+	var b bytes.Buffer
+	pprof.Lookup("goroutine").WriteTo(&b, 2)
+	s.logger.Printf("Warning: goroutine dump (%s):\n%s", reason, b.String())
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/json"
+	"time"
+
+	pb "downloadcache/pb"
+
+	"github.com/tebeka/selenium"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// webVitalsScript reads the page's load timings, in milliseconds from the
+// start of its navigation.  Largest contentful paint and layout shifts are
+// only reported to performance observers, which are given the entries
+// buffered so far once the current task ends.
+const webVitalsScript = `
+const done = arguments[arguments.length - 1];
+const vitals = {};
+const nav = performance.getEntriesByType("navigation")[0];
+if (nav) {
+  vitals.ttfb = nav.responseStart;
+  vitals.dom_content_loaded = nav.domContentLoadedEventEnd;
+  vitals.load = nav.loadEventEnd;
+}
+const fcp = performance.getEntriesByName("first-contentful-paint")[0];
+if (fcp) vitals.fcp = fcp.startTime;
+vitals.cls = 0;
+const observe = (type, fn) => {
+  try {
+    new PerformanceObserver(list => list.getEntries().forEach(fn)).observe({type, buffered: true});
+  } catch (e) {}
+};
+observe("largest-contentful-paint", e => { vitals.lcp = e.startTime; });
+observe("layout-shift", e => { if (!e.hadRecentInput) vitals.cls += e.value; });
+setTimeout(() => done(vitals), 100);`
+
+// webVitals measures how fast a session's page loaded.
+func webVitals(wd selenium.WebDriver) (*WebVitals, error) {
+	data, err := wd.ExecuteScriptAsyncRaw(webVitalsScript, nil)
+	if err != nil {
+		return nil, err
+	}
+	var reply struct {
+		Value struct {
+			TTFB             float64 `json:"ttfb"`
+			FCP              float64 `json:"fcp"`
+			LCP              float64 `json:"lcp"`
+			DOMContentLoaded float64 `json:"dom_content_loaded"`
+			Load             float64 `json:"load"`
+			CLS              float64 `json:"cls"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return nil, err
+	}
+	ms := func(v float64) time.Duration { return time.Duration(v * float64(time.Millisecond)) }
+	v := reply.Value
+	return &WebVitals{
+		TTFB:                   ms(v.TTFB),
+		FirstContentfulPaint:   ms(v.FCP),
+		LargestContentfulPaint: ms(v.LCP),
+		DOMContentLoaded:       ms(v.DOMContentLoaded),
+		Load:                   ms(v.Load),
+		CumulativeLayoutShift:  v.CLS,
+	}, nil
+}
+
+// proto converts web vitals for responses.  Nil converts to nil.
+func (v *WebVitals) proto() *pb.WebVitals {
+	if v == nil {
+		return nil
+	}
+	duration := func(d time.Duration) *durationpb.Duration {
+		if d <= 0 {
+			return nil
+		}
+		return durationpb.New(d)
+	}
+	return &pb.WebVitals{
+		Ttfb:                   duration(v.TTFB),
+		FirstContentfulPaint:   duration(v.FirstContentfulPaint),
+		LargestContentfulPaint: duration(v.LargestContentfulPaint),
+		DomContentLoaded:       duration(v.DOMContentLoaded),
+		Load:                   duration(v.Load),
+		CumulativeLayoutShift:  v.CumulativeLayoutShift,
+	}
+}
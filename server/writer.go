@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+
+	pb "downloadcache/pb"
+)
+
+const defaultWriteQueueSize = 256
+
+// cacheWriter stores fetched pages in the background, so requests are
+// answered as soon as their page is ready rather than once it is on disk.
+// A fixed pool of workers takes writes from a bounded queue; when it is
+// full, requests store their pages themselves, as they do without one.
+type cacheWriter struct {
+	queue chan cacheWrite
+}
+
+// cacheWrite is a fetched page to store, with what to release once it is
+// stored: the URL's lock, so requests waiting for it find the page cached,
+// and the maintenance gate, so draining waits for the write.
+type cacheWrite struct {
+	ctx       context.Context
+	page      *fetchedPage
+	cacheKey  string
+	cacheOpts *pb.CacheOptions
+	release   func()
+}
+
+// newCacheWriter starts workers storing pages for s.
+func newCacheWriter(s *Server, workers, queueSize int) *cacheWriter {
+	w := &cacheWriter{queue: make(chan cacheWrite, queueSize)}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for write := range w.queue {
+				s.store(write.ctx, write.page, write.cacheKey, write.cacheOpts)
+				write.release()
+			}
+		}()
+	}
+	return w
+}
+
+// add queues a write, reporting whether it was queued: it isn't if the
+// queue is full.
+func (w *cacheWriter) add(write cacheWrite) bool {
+	select {
+	case w.queue <- write:
+		return true
+	default:
+		return false
+	}
+}
+
+// store writes a fetched page to the cache, as a copy of a cached
+// duplicate if there is one.
+func (s *Server) store(ctx context.Context, page *fetchedPage, cacheKey string, cacheOpts *pb.CacheOptions) {
+	if !s.storeDuplicate(ctx, page, cacheKey, cacheOpts) {
+		s.storePage(ctx, page, cacheKey, cacheOpts)
+	}
+}
+
+// storeLater queues a fetched page to be stored in the background, taking
+// over release, which is called once it has been stored.  It reports
+// whether the page was queued; if not, the caller stores it and keeps
+// release.
+func (s *Server) storeLater(ctx context.Context, page *fetchedPage, cacheKey string, cacheOpts *pb.CacheOptions, release func()) bool {
+	if s.writer == nil {
+		return false
+	}
+	// The write outlives the request, and isn't part of its debug timing.
+	ctx = context.WithValue(context.WithoutCancel(ctx), timingKey{}, (*requestTiming)(nil))
+	return s.writer.add(cacheWrite{ctx: ctx, page: page, cacheKey: cacheKey, cacheOpts: cacheOpts, release: release})
+}
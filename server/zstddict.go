@@ -0,0 +1,400 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand/v2"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// dictSubdir holds the zstd dictionaries pages are compressed with, one
+	// file per dictionary named after its ID, and dictDomainsFile, which
+	// maps each domain to its dictionary.  Dictionaries are never removed,
+	// since entries compressed with them can't be read without them.
+	dictSubdir = ".dicts"
+
+	dictSize        = 64 << 10  // Bytes of shared content in a dictionary
+	dictSegment     = 256       // Bytes picked from the samples at a time
+	dictDmer        = 8         // Bytes compared between samples at a time
+	dictMaxSamples  = 100       // Pages sampled per domain
+	dictMinSamples  = 16        // Fewer pages than this can't train a useful dictionary
+	dictSampleBytes = 128 << 10 // Longer pages are cut to this for training
+	dictMinSavings  = 0.1       // Dictionaries saving less than this are discarded
+
+	// Dictionary IDs below 32768 and from 2^31 are reserved by the zstd
+	// format for registered dictionaries.
+	dictMinID = 1 << 15
+	dictMaxID = 1 << 31
+)
+
+var dictDomainsFile = path.Join(dictSubdir, "domains.json")
+
+// zstdDicts holds the dictionaries trained for domains whose pages share a
+// template, so most of each page compresses to references into the
+// dictionary.  New zstd writes from a domain with a dictionary use it;
+// entries record its ID, and are read with whichever dictionary they name.
+type zstdDicts struct {
+	mu       sync.RWMutex
+	byDomain map[string]*zstdDict
+	byID     map[uint32][]byte
+	decoder  *zstd.Decoder // Knows every dictionary in byID
+}
+
+// zstdDict is a domain's dictionary.
+type zstdDict struct {
+	id      uint32
+	encoder *zstd.Encoder
+}
+
+// loadDicts reads the dictionaries saved in st.
+func loadDicts(st Storage) (*zstdDicts, error) {
+	d := &zstdDicts{byDomain: make(map[string]*zstdDict), byID: make(map[uint32][]byte)}
+	err := st.Walk(dictSubdir, func(name string, info FileInfo) error {
+		if info.IsDir || !strings.HasSuffix(name, ".dict") {
+			return nil
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(path.Base(name), ".dict"), 10, 32)
+		if err != nil {
+			return nil
+		}
+		dict, err := st.Read(name)
+		if err != nil {
+			return err
+		}
+		d.byID[uint32(id)] = dict
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	data, err := st.Read(dictDomainsFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return d, d.rebuildDecoder()
+	} else if err != nil {
+		return nil, err
+	}
+	var domains map[string]uint32
+	if err := json.Unmarshal(data, &domains); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", dictDomainsFile, err)
+	}
+	for domain, id := range domains {
+		dict, ok := d.byID[id]
+		if !ok {
+			return nil, fmt.Errorf("dictionary %d for %s is missing", id, domain)
+		}
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dict))
+		if err != nil {
+			return nil, fmt.Errorf("invalid dictionary %d: %w", id, err)
+		}
+		d.byDomain[domain] = &zstdDict{id: id, encoder: enc}
+	}
+	return d, d.rebuildDecoder()
+}
+
+// rebuildDecoder replaces the decoder with one knowing every dictionary.
+// The caller must hold mu for writing, or be the only user of d.
+func (d *zstdDicts) rebuildDecoder() error {
+	dicts := make([][]byte, 0, len(d.byID))
+	for _, dict := range d.byID {
+		dicts = append(dicts, dict)
+	}
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dicts...))
+	if err != nil {
+		return err
+	}
+	d.decoder = dec
+	return nil
+}
+
+// encode compresses content from a domain with its dictionary, returning
+// the dictionary's ID, or false if the domain has none.
+func (d *zstdDicts) encode(domain string, content []byte) ([]byte, uint32, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	dict, ok := d.byDomain[domain]
+	if !ok {
+		return nil, 0, false
+	}
+	return dict.encoder.EncodeAll(content, nil), dict.id, true
+}
+
+// decode decompresses content compressed with a dictionary.
+func (d *zstdDicts) decode(id uint32, data []byte) ([]byte, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if _, ok := d.byID[id]; !ok {
+		return nil, fmt.Errorf("unknown compression dictionary %d", id)
+	}
+	return d.decoder.DecodeAll(data, nil)
+}
+
+// has reports whether a domain has a dictionary.
+func (d *zstdDicts) has(domain string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	_, ok := d.byDomain[domain]
+	return ok
+}
+
+// add saves a new dictionary for a domain and starts using it.
+func (d *zstdDicts) add(st Storage, domain string, id uint32, dict []byte) error {
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dict))
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := st.Write(path.Join(dictSubdir, fmt.Sprintf("%d.dict", id)), dict); err != nil {
+		return err
+	}
+	domains := map[string]uint32{domain: id}
+	for other, dict := range d.byDomain {
+		domains[other] = dict.id
+	}
+	data, err := json.Marshal(domains)
+	if err != nil {
+		return err
+	}
+	if err := st.Write(dictDomainsFile, data); err != nil {
+		return err
+	}
+	d.byID[id] = dict
+	d.byDomain[domain] = &zstdDict{id: id, encoder: enc}
+	return d.rebuildDecoder()
+}
+
+// newID returns an unused dictionary ID.
+func (d *zstdDicts) newID() uint32 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	for {
+		if id := dictMinID + rand.Uint32N(dictMaxID-dictMinID); d.byID[id] == nil {
+			return id
+		}
+	}
+}
+
+// runDictTraining trains dictionaries for domains that have enough pages
+// cached, every interval until ctx is done.
+func (s *Server) runDictTraining(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			done, err := s.maintenance.enter()
+			if err != nil {
+				continue // Skip passes during maintenance.
+			}
+			if err := s.trainDicts(ctx); err != nil {
+				s.logger.Printf("Error: dictionary training pass failed: %v", err)
+			}
+			done()
+		}
+	}
+}
+
+// trainDicts trains a dictionary for each domain without one that has at
+// least dictMinSamples HTML pages cached, from a random sample of them.
+// Tenants' pages aren't sampled.
+func (s *Server) trainDicts(ctx context.Context) error {
+	type reservoir struct {
+		seen int
+		keys []string
+	}
+	candidates := make(map[string]*reservoir)
+	err := walkContentFiles(s.storage, func(name string, info FileInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cacheKey := path.Base(name)
+		if strings.HasSuffix(name, partialSuffix) || name != s.contentName(cacheKey) {
+			return nil
+		}
+		md, err := loadEntryMetadata(s.storage, s.layout, cacheKey)
+		if err != nil || md.AliasOf != "" || md.Tenant != "" || !isHTML(md.ContentType) {
+			return nil
+		}
+		domain := hostOf(md.contentURL())
+		if domain == "" || s.dicts.has(domain) {
+			return nil
+		}
+		r := candidates[domain]
+		if r == nil {
+			r = &reservoir{}
+			candidates[domain] = r
+		}
+		r.seen++
+		if len(r.keys) < dictMaxSamples {
+			r.keys = append(r.keys, cacheKey)
+		} else if i := rand.IntN(r.seen); i < dictMaxSamples {
+			r.keys[i] = cacheKey
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	trained := 0
+	for domain, r := range candidates {
+		if len(r.keys) < dictMinSamples {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var samples [][]byte
+		for _, cacheKey := range r.keys {
+			md, err := s.readMetadata(cacheKey)
+			if err != nil {
+				continue
+			}
+			content, err := s.readContent(ctx, s.contentName(cacheKey), md)
+			if err != nil {
+				continue
+			}
+			samples = append(samples, content[:min(len(content), dictSampleBytes)])
+		}
+		if len(samples) < dictMinSamples {
+			continue
+		}
+		if err := s.trainDict(domain, samples); err != nil {
+			s.logger.Printf("Warning: no compression dictionary for %s: %v", domain, err)
+			continue
+		}
+		trained++
+	}
+	s.logger.Printf("Dictionary training pass finished: trained %d dictionaries", trained)
+	return nil
+}
+
+// trainDict trains a dictionary for a domain from sample pages and starts
+// using it, if it makes the samples enough smaller.
+func (s *Server) trainDict(domain string, samples [][]byte) error {
+	id := s.dicts.newID()
+	dict, err := trainDictionary(id, samples)
+	if err != nil {
+		return err
+	}
+
+	plain, err := zstd.NewWriter(nil)
+	if err != nil {
+		return err
+	}
+	defer plain.Close()
+	withDict, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dict))
+	if err != nil {
+		return err
+	}
+	defer withDict.Close()
+	var before, after int
+	for _, sample := range samples {
+		before += len(plain.EncodeAll(sample, nil))
+		after += len(withDict.EncodeAll(sample, nil))
+	}
+	savings := 1 - float64(after)/float64(before)
+	if savings < dictMinSavings {
+		return fmt.Errorf("it would only save %.0f%%", savings*100)
+	}
+
+	if err := s.dicts.add(s.storage, domain, id, dict); err != nil {
+		return err
+	}
+	s.logger.Printf("Trained compression dictionary %d for %s from %d pages, making them %.0f%% smaller", id, domain, len(samples), savings*100)
+	return nil
+}
+
+// trainDictionary builds a zstd dictionary from samples.  Its content is
+// made of the segments most samples have in common: the samples are split
+// into one stretch per segment the dictionary has room for, and from each
+// stretch the segment whose d-mers (runs of dictDmer bytes) occur in the
+// most other samples is picked.  A d-mer only counts towards the first
+// segment picked with it.  This is a simplified form of the COVER algorithm
+// used by the zstd command.
+func trainDictionary(id uint32, samples [][]byte) ([]byte, error) {
+	dmer := func(b []byte) uint64 { return binary.LittleEndian.Uint64(b) }
+
+	// How many samples each d-mer occurs in.
+	freq := make(map[uint64]int)
+	seen := make(map[uint64]bool)
+	for _, sample := range samples {
+		clear(seen)
+		for i := 0; i+dictDmer <= len(sample); i++ {
+			if h := dmer(sample[i:]); !seen[h] {
+				seen[h] = true
+				freq[h]++
+			}
+		}
+	}
+	score := func(b []byte) int {
+		if f := freq[dmer(b)]; f > 1 {
+			return f
+		}
+		return 0
+	}
+
+	type segment struct {
+		data  []byte
+		score int
+	}
+	var segments []segment
+	data := bytes.Join(samples, nil)
+	stretch := max(len(data)/(dictSize/dictSegment), dictSegment)
+	const dmers = dictSegment - dictDmer + 1 // Per segment
+	for start := 0; start+dictSegment <= len(data); start += stretch {
+		end := min(start+stretch, len(data))
+		sum := 0
+		for j := start; j < start+dmers; j++ {
+			sum += score(data[j:])
+		}
+		best, bestAt := sum, start
+		for i := start + 1; i+dictSegment <= end; i++ {
+			sum += score(data[i+dmers-1:]) - score(data[i-1:])
+			if sum > best {
+				best, bestAt = sum, i
+			}
+		}
+		if best == 0 {
+			continue
+		}
+		seg := data[bestAt : bestAt+dictSegment]
+		for j := 0; j < dmers; j++ {
+			delete(freq, dmer(seg[j:]))
+		}
+		segments = append(segments, segment{seg, best})
+	}
+	if len(segments) == 0 {
+		return nil, errors.New("the pages have too little in common")
+	}
+
+	// The best segments go last, where references to them are shortest.
+	sort.SliceStable(segments, func(i, j int) bool { return segments[i].score < segments[j].score })
+	var history []byte
+	for _, seg := range segments {
+		history = append(history, seg.data...)
+	}
+	return zstd.BuildDict(zstd.BuildDictOptions{
+		ID:       id,
+		Contents: samples,
+		History:  history,
+		Offsets:  [3]int{1, 4, 8},
+		Level:    zstd.SpeedDefault,
+	})
+}